@@ -37,6 +37,12 @@ func MakeHTTPHandler(params *wiring.AppParams) http.Handler {
 	registerAgentRoutes(apiMux, params.AgentController)
 	registerInfraRoutes(apiMux, params.InfraResourceController)
 	registerObservabilityRoutes(apiMux, params.ObservabilityController)
+	registerAPIKeyRoutes(apiMux, params.APIKeyController)
+	registerTraceAnnotationRoutes(apiMux, params.TraceAnnotationController)
+	registerEvalDatasetRoutes(apiMux, params.EvalDatasetController)
+	registerEvalRunRoutes(apiMux, params.EvalRunController)
+	registerJobRunRoutes(apiMux, params.JobRunController)
+	registerAgentConfigRoutes(apiMux, params.AgentConfigController)
 
 	// Apply middleware in reverse order (last middleware is applied first)
 	apiHandler := http.Handler(apiMux)
@@ -48,7 +54,7 @@ func MakeHTTPHandler(params *wiring.AppParams) http.Handler {
 
 	// Create a mux for internal API routes
 	internalApiMux := http.NewServeMux()
-	registerInternalRoutes(internalApiMux, params.BuildCIController)
+	registerInternalRoutes(internalApiMux, params.BuildCIController, params.APIKeyController)
 	internalApiHandler := http.Handler(internalApiMux)
 	internalApiHandler = middleware.APIKeyMiddleware()(internalApiHandler) // Add API key middleware for internal routes
 	internalApiHandler = middleware.AddCorrelationID()(internalApiHandler)
@@ -22,6 +22,8 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 )
 
-func registerInternalRoutes(mux *http.ServeMux, ctrl controllers.BuildCIController) {
+func registerInternalRoutes(mux *http.ServeMux, ctrl controllers.BuildCIController, apiKeyCtrl controllers.APIKeyController) {
 	mux.HandleFunc("POST /builds/callback", ctrl.HandleBuildCallback)
+	mux.HandleFunc("POST /builds/schema-validation", ctrl.ValidateBuildSchema)
+	mux.HandleFunc("POST /api-keys/verify", apiKeyCtrl.VerifyAPIKey)
 }
@@ -28,4 +28,20 @@ func registerObservabilityRoutes(mux *http.ServeMux, ctrl controllers.Observabil
 	// extracts path parameters from the pattern and validates them
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces", ctrl.ListTraces)
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}", ctrl.GetTrace)
+
+	// Trace export in OTLP JSON or Jaeger format, for a single trace or streamed as NDJSON for a time range
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}/export", ctrl.ExportTrace)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces/export", ctrl.ExportTraces)
+
+	// Live tail of new traces as server-sent events
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces/tail", ctrl.TailTraces)
+
+	// Conversation sessions grouped from traces
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/sessions", ctrl.ListSessions)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/sessions/{sessionId}", ctrl.GetSessionTraces)
+
+	// Token and cost usage reports at org, project and agent level
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/usage", ctrl.GetUsageReport)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/usage", ctrl.GetUsageReport)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/usage", ctrl.GetUsageReport)
 }
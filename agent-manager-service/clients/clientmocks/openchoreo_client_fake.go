@@ -39,9 +39,6 @@ import (
 //			GetAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
 //				panic("mock out the GetAgentComponent method")
 //			},
-//			GetAgentConfigurationsFunc: func(ctx context.Context, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error) {
-//				panic("mock out the GetAgentConfigurations method")
-//			},
 //			GetAgentDeploymentsFunc: func(ctx context.Context, orgName string, pipelineName string, projName string, componentName string) ([]*models.DeploymentResponse, error) {
 //				panic("mock out the GetAgentDeployments method")
 //			},
@@ -54,6 +51,9 @@ import (
 //			GetDataplanesForOrganizationFunc: func(ctx context.Context, orgName string) ([]*models.DataPlaneResponse, error) {
 //				panic("mock out the GetDataplanesForOrganization method")
 //			},
+//			GetDeployedEndpointSchemasFunc: func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.DeployedEndpointSchema, error) {
+//				panic("mock out the GetDeployedEndpointSchemas method")
+//			},
 //			GetDeploymentPipelineFunc: func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
 //				panic("mock out the GetDeploymentPipeline method")
 //			},
@@ -78,6 +78,9 @@ import (
 //			ListComponentWorkflowsFunc: func(ctx context.Context, orgName string, projName string, componentName string) ([]*models.BuildResponse, error) {
 //				panic("mock out the ListComponentWorkflows method")
 //			},
+//			ListJobRunsFunc: func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunsResponse, error) {
+//				panic("mock out the ListJobRuns method")
+//			},
 //			ListOrgEnvironmentsFunc: func(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
 //				panic("mock out the ListOrgEnvironments method")
 //			},
@@ -87,6 +90,18 @@ import (
 //			TriggerBuildFunc: func(ctx context.Context, orgName string, projName string, agentName string, commitId string) (*models.BuildResponse, error) {
 //				panic("mock out the TriggerBuild method")
 //			},
+//			TriggerJobRunFunc: func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error) {
+//				panic("mock out the TriggerJobRun method")
+//			},
+//			UpdateAgentConfigOverridesFunc: func(ctx context.Context, orgName string, projName string, agentName string, environment string, overrides []models.EnvVars) error {
+//				panic("mock out the UpdateAgentConfigOverrides method")
+//			},
+//			UpdateAgentEndpointsFunc: func(ctx context.Context, orgName string, projName string, agentName string, endpoints []spec.AgentEndpoint) error {
+//				panic("mock out the UpdateAgentEndpoints method")
+//			},
+//			UpdateAgentScalingFunc: func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+//				panic("mock out the UpdateAgentScaling method")
+//			},
 //		}
 //
 //		// use mockedOpenChoreoSvcClient in code that requires openchoreosvc.OpenChoreoSvcClient
@@ -115,9 +130,6 @@ type OpenChoreoSvcClientMock struct {
 	// GetAgentComponentFunc mocks the GetAgentComponent method.
 	GetAgentComponentFunc func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error)
 
-	// GetAgentConfigurationsFunc mocks the GetAgentConfigurations method.
-	GetAgentConfigurationsFunc func(ctx context.Context, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error)
-
 	// GetAgentDeploymentsFunc mocks the GetAgentDeployments method.
 	GetAgentDeploymentsFunc func(ctx context.Context, orgName string, pipelineName string, projName string, componentName string) ([]*models.DeploymentResponse, error)
 
@@ -130,6 +142,9 @@ type OpenChoreoSvcClientMock struct {
 	// GetDataplanesForOrganizationFunc mocks the GetDataplanesForOrganization method.
 	GetDataplanesForOrganizationFunc func(ctx context.Context, orgName string) ([]*models.DataPlaneResponse, error)
 
+	// GetDeployedEndpointSchemasFunc mocks the GetDeployedEndpointSchemas method.
+	GetDeployedEndpointSchemasFunc func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.DeployedEndpointSchema, error)
+
 	// GetDeploymentPipelineFunc mocks the GetDeploymentPipeline method.
 	GetDeploymentPipelineFunc func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error)
 
@@ -154,6 +169,9 @@ type OpenChoreoSvcClientMock struct {
 	// ListComponentWorkflowsFunc mocks the ListComponentWorkflows method.
 	ListComponentWorkflowsFunc func(ctx context.Context, orgName string, projName string, componentName string) ([]*models.BuildResponse, error)
 
+	// ListJobRunsFunc mocks the ListJobRuns method.
+	ListJobRunsFunc func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunsResponse, error)
+
 	// ListOrgEnvironmentsFunc mocks the ListOrgEnvironments method.
 	ListOrgEnvironmentsFunc func(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error)
 
@@ -163,6 +181,18 @@ type OpenChoreoSvcClientMock struct {
 	// TriggerBuildFunc mocks the TriggerBuild method.
 	TriggerBuildFunc func(ctx context.Context, orgName string, projName string, agentName string, commitId string) (*models.BuildResponse, error)
 
+	// TriggerJobRunFunc mocks the TriggerJobRun method.
+	TriggerJobRunFunc func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error)
+
+	// UpdateAgentConfigOverridesFunc mocks the UpdateAgentConfigOverrides method.
+	UpdateAgentConfigOverridesFunc func(ctx context.Context, orgName string, projName string, agentName string, environment string, overrides []models.EnvVars) error
+
+	// UpdateAgentEndpointsFunc mocks the UpdateAgentEndpoints method.
+	UpdateAgentEndpointsFunc func(ctx context.Context, orgName string, projName string, agentName string, endpoints []spec.AgentEndpoint) error
+
+	// UpdateAgentScalingFunc mocks the UpdateAgentScaling method.
+	UpdateAgentScalingFunc func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AttachComponentTrait holds details about calls to the AttachComponentTrait method.
@@ -246,19 +276,6 @@ type OpenChoreoSvcClientMock struct {
 			// AgentName is the agentName argument value.
 			AgentName string
 		}
-		// GetAgentConfigurations holds details about calls to the GetAgentConfigurations method.
-		GetAgentConfigurations []struct {
-			// Ctx is the ctx argument value.
-			Ctx context.Context
-			// OrgName is the orgName argument value.
-			OrgName string
-			// ProjectName is the projectName argument value.
-			ProjectName string
-			// AgentName is the agentName argument value.
-			AgentName string
-			// Environment is the environment argument value.
-			Environment string
-		}
 		// GetAgentDeployments holds details about calls to the GetAgentDeployments method.
 		GetAgentDeployments []struct {
 			// Ctx is the ctx argument value.
@@ -305,6 +322,19 @@ type OpenChoreoSvcClientMock struct {
 			// OrgName is the orgName argument value.
 			OrgName string
 		}
+		// GetDeployedEndpointSchemas holds details about calls to the GetDeployedEndpointSchemas method.
+		GetDeployedEndpointSchemas []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Environment is the environment argument value.
+			Environment string
+		}
 		// GetDeploymentPipeline holds details about calls to the GetDeploymentPipeline method.
 		GetDeploymentPipeline []struct {
 			// Ctx is the ctx argument value.
@@ -377,6 +407,19 @@ type OpenChoreoSvcClientMock struct {
 			// ComponentName is the componentName argument value.
 			ComponentName string
 		}
+		// ListJobRuns holds details about calls to the ListJobRuns method.
+		ListJobRuns []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Environment is the environment argument value.
+			Environment string
+		}
 		// ListOrgEnvironments holds details about calls to the ListOrgEnvironments method.
 		ListOrgEnvironments []struct {
 			// Ctx is the ctx argument value.
@@ -404,6 +447,66 @@ type OpenChoreoSvcClientMock struct {
 			// CommitId is the commitId argument value.
 			CommitId string
 		}
+		// TriggerJobRun holds details about calls to the TriggerJobRun method.
+		TriggerJobRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Environment is the environment argument value.
+			Environment string
+		}
+		// UpdateAgentConfigOverrides holds details about calls to the UpdateAgentConfigOverrides method.
+		UpdateAgentConfigOverrides []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Environment is the environment argument value.
+			Environment string
+			// Overrides is the overrides argument value.
+			Overrides []models.EnvVars
+		}
+		// UpdateAgentEndpoints holds details about calls to the UpdateAgentEndpoints method.
+		UpdateAgentEndpoints []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Endpoints is the endpoints argument value.
+			Endpoints []spec.AgentEndpoint
+		}
+		// UpdateAgentScaling holds details about calls to the UpdateAgentScaling method.
+		UpdateAgentScaling []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Environment is the environment argument value.
+			Environment string
+			// Resources is the resources argument value.
+			Resources *spec.ResourceRequirements
+			// Replicas is the replicas argument value.
+			Replicas *int32
+			// Autoscaling is the autoscaling argument value.
+			Autoscaling *spec.AutoscalingConfig
+		}
 	}
 	lockAttachComponentTrait                  sync.RWMutex
 	lockCreateAgentComponent                  sync.RWMutex
@@ -412,11 +515,11 @@ type OpenChoreoSvcClientMock struct {
 	lockDeleteProject                         sync.RWMutex
 	lockDeployAgentComponent                  sync.RWMutex
 	lockGetAgentComponent                     sync.RWMutex
-	lockGetAgentConfigurations                sync.RWMutex
 	lockGetAgentDeployments                   sync.RWMutex
 	lockGetAgentEndpoints                     sync.RWMutex
 	lockGetComponentWorkflow                  sync.RWMutex
 	lockGetDataplanesForOrganization          sync.RWMutex
+	lockGetDeployedEndpointSchemas            sync.RWMutex
 	lockGetDeploymentPipeline                 sync.RWMutex
 	lockGetDeploymentPipelinesForOrganization sync.RWMutex
 	lockGetEnvironment                        sync.RWMutex
@@ -425,9 +528,14 @@ type OpenChoreoSvcClientMock struct {
 	lockIsAgentComponentExists                sync.RWMutex
 	lockListAgentComponents                   sync.RWMutex
 	lockListComponentWorkflows                sync.RWMutex
+	lockListJobRuns                           sync.RWMutex
 	lockListOrgEnvironments                   sync.RWMutex
 	lockListProjects                          sync.RWMutex
 	lockTriggerBuild                          sync.RWMutex
+	lockTriggerJobRun                         sync.RWMutex
+	lockUpdateAgentConfigOverrides            sync.RWMutex
+	lockUpdateAgentEndpoints                  sync.RWMutex
+	lockUpdateAgentScaling                    sync.RWMutex
 }
 
 // AttachComponentTrait calls AttachComponentTraitFunc.
@@ -746,54 +854,6 @@ func (mock *OpenChoreoSvcClientMock) GetAgentComponentCalls() []struct {
 	return calls
 }
 
-// GetAgentConfigurations calls GetAgentConfigurationsFunc.
-func (mock *OpenChoreoSvcClientMock) GetAgentConfigurations(ctx context.Context, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error) {
-	if mock.GetAgentConfigurationsFunc == nil {
-		panic("OpenChoreoSvcClientMock.GetAgentConfigurationsFunc: method is nil but OpenChoreoSvcClient.GetAgentConfigurations was just called")
-	}
-	callInfo := struct {
-		Ctx         context.Context
-		OrgName     string
-		ProjectName string
-		AgentName   string
-		Environment string
-	}{
-		Ctx:         ctx,
-		OrgName:     orgName,
-		ProjectName: projectName,
-		AgentName:   agentName,
-		Environment: environment,
-	}
-	mock.lockGetAgentConfigurations.Lock()
-	mock.calls.GetAgentConfigurations = append(mock.calls.GetAgentConfigurations, callInfo)
-	mock.lockGetAgentConfigurations.Unlock()
-	return mock.GetAgentConfigurationsFunc(ctx, orgName, projectName, agentName, environment)
-}
-
-// GetAgentConfigurationsCalls gets all the calls that were made to GetAgentConfigurations.
-// Check the length with:
-//
-//	len(mockedOpenChoreoSvcClient.GetAgentConfigurationsCalls())
-func (mock *OpenChoreoSvcClientMock) GetAgentConfigurationsCalls() []struct {
-	Ctx         context.Context
-	OrgName     string
-	ProjectName string
-	AgentName   string
-	Environment string
-} {
-	var calls []struct {
-		Ctx         context.Context
-		OrgName     string
-		ProjectName string
-		AgentName   string
-		Environment string
-	}
-	mock.lockGetAgentConfigurations.RLock()
-	calls = mock.calls.GetAgentConfigurations
-	mock.lockGetAgentConfigurations.RUnlock()
-	return calls
-}
-
 // GetAgentDeployments calls GetAgentDeploymentsFunc.
 func (mock *OpenChoreoSvcClientMock) GetAgentDeployments(ctx context.Context, orgName string, pipelineName string, projName string, componentName string) ([]*models.DeploymentResponse, error) {
 	if mock.GetAgentDeploymentsFunc == nil {
@@ -974,6 +1034,54 @@ func (mock *OpenChoreoSvcClientMock) GetDataplanesForOrganizationCalls() []struc
 	return calls
 }
 
+// GetDeployedEndpointSchemas calls GetDeployedEndpointSchemasFunc.
+func (mock *OpenChoreoSvcClientMock) GetDeployedEndpointSchemas(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.DeployedEndpointSchema, error) {
+	if mock.GetDeployedEndpointSchemasFunc == nil {
+		panic("OpenChoreoSvcClientMock.GetDeployedEndpointSchemasFunc: method is nil but OpenChoreoSvcClient.GetDeployedEndpointSchemas was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+	}{
+		Ctx:         ctx,
+		OrgName:     orgName,
+		ProjName:    projName,
+		AgentName:   agentName,
+		Environment: environment,
+	}
+	mock.lockGetDeployedEndpointSchemas.Lock()
+	mock.calls.GetDeployedEndpointSchemas = append(mock.calls.GetDeployedEndpointSchemas, callInfo)
+	mock.lockGetDeployedEndpointSchemas.Unlock()
+	return mock.GetDeployedEndpointSchemasFunc(ctx, orgName, projName, agentName, environment)
+}
+
+// GetDeployedEndpointSchemasCalls gets all the calls that were made to GetDeployedEndpointSchemas.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.GetDeployedEndpointSchemasCalls())
+func (mock *OpenChoreoSvcClientMock) GetDeployedEndpointSchemasCalls() []struct {
+	Ctx         context.Context
+	OrgName     string
+	ProjName    string
+	AgentName   string
+	Environment string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+	}
+	mock.lockGetDeployedEndpointSchemas.RLock()
+	calls = mock.calls.GetDeployedEndpointSchemas
+	mock.lockGetDeployedEndpointSchemas.RUnlock()
+	return calls
+}
+
 // GetDeploymentPipeline calls GetDeploymentPipelineFunc.
 func (mock *OpenChoreoSvcClientMock) GetDeploymentPipeline(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
 	if mock.GetDeploymentPipelineFunc == nil {
@@ -1294,6 +1402,54 @@ func (mock *OpenChoreoSvcClientMock) ListComponentWorkflowsCalls() []struct {
 	return calls
 }
 
+// ListJobRuns calls ListJobRunsFunc.
+func (mock *OpenChoreoSvcClientMock) ListJobRuns(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunsResponse, error) {
+	if mock.ListJobRunsFunc == nil {
+		panic("OpenChoreoSvcClientMock.ListJobRunsFunc: method is nil but OpenChoreoSvcClient.ListJobRuns was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+	}{
+		Ctx:         ctx,
+		OrgName:     orgName,
+		ProjName:    projName,
+		AgentName:   agentName,
+		Environment: environment,
+	}
+	mock.lockListJobRuns.Lock()
+	mock.calls.ListJobRuns = append(mock.calls.ListJobRuns, callInfo)
+	mock.lockListJobRuns.Unlock()
+	return mock.ListJobRunsFunc(ctx, orgName, projName, agentName, environment)
+}
+
+// ListJobRunsCalls gets all the calls that were made to ListJobRuns.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.ListJobRunsCalls())
+func (mock *OpenChoreoSvcClientMock) ListJobRunsCalls() []struct {
+	Ctx         context.Context
+	OrgName     string
+	ProjName    string
+	AgentName   string
+	Environment string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+	}
+	mock.lockListJobRuns.RLock()
+	calls = mock.calls.ListJobRuns
+	mock.lockListJobRuns.RUnlock()
+	return calls
+}
+
 // ListOrgEnvironments calls ListOrgEnvironmentsFunc.
 func (mock *OpenChoreoSvcClientMock) ListOrgEnvironments(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
 	if mock.ListOrgEnvironmentsFunc == nil {
@@ -1413,3 +1569,211 @@ func (mock *OpenChoreoSvcClientMock) TriggerBuildCalls() []struct {
 	mock.lockTriggerBuild.RUnlock()
 	return calls
 }
+
+// TriggerJobRun calls TriggerJobRunFunc.
+func (mock *OpenChoreoSvcClientMock) TriggerJobRun(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error) {
+	if mock.TriggerJobRunFunc == nil {
+		panic("OpenChoreoSvcClientMock.TriggerJobRunFunc: method is nil but OpenChoreoSvcClient.TriggerJobRun was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+	}{
+		Ctx:         ctx,
+		OrgName:     orgName,
+		ProjName:    projName,
+		AgentName:   agentName,
+		Environment: environment,
+	}
+	mock.lockTriggerJobRun.Lock()
+	mock.calls.TriggerJobRun = append(mock.calls.TriggerJobRun, callInfo)
+	mock.lockTriggerJobRun.Unlock()
+	return mock.TriggerJobRunFunc(ctx, orgName, projName, agentName, environment)
+}
+
+// TriggerJobRunCalls gets all the calls that were made to TriggerJobRun.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.TriggerJobRunCalls())
+func (mock *OpenChoreoSvcClientMock) TriggerJobRunCalls() []struct {
+	Ctx         context.Context
+	OrgName     string
+	ProjName    string
+	AgentName   string
+	Environment string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+	}
+	mock.lockTriggerJobRun.RLock()
+	calls = mock.calls.TriggerJobRun
+	mock.lockTriggerJobRun.RUnlock()
+	return calls
+}
+
+// UpdateAgentConfigOverrides calls UpdateAgentConfigOverridesFunc.
+func (mock *OpenChoreoSvcClientMock) UpdateAgentConfigOverrides(ctx context.Context, orgName string, projName string, agentName string, environment string, overrides []models.EnvVars) error {
+	if mock.UpdateAgentConfigOverridesFunc == nil {
+		panic("OpenChoreoSvcClientMock.UpdateAgentConfigOverridesFunc: method is nil but OpenChoreoSvcClient.UpdateAgentConfigOverrides was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+		Overrides   []models.EnvVars
+	}{
+		Ctx:         ctx,
+		OrgName:     orgName,
+		ProjName:    projName,
+		AgentName:   agentName,
+		Environment: environment,
+		Overrides:   overrides,
+	}
+	mock.lockUpdateAgentConfigOverrides.Lock()
+	mock.calls.UpdateAgentConfigOverrides = append(mock.calls.UpdateAgentConfigOverrides, callInfo)
+	mock.lockUpdateAgentConfigOverrides.Unlock()
+	return mock.UpdateAgentConfigOverridesFunc(ctx, orgName, projName, agentName, environment, overrides)
+}
+
+// UpdateAgentConfigOverridesCalls gets all the calls that were made to UpdateAgentConfigOverrides.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.UpdateAgentConfigOverridesCalls())
+func (mock *OpenChoreoSvcClientMock) UpdateAgentConfigOverridesCalls() []struct {
+	Ctx         context.Context
+	OrgName     string
+	ProjName    string
+	AgentName   string
+	Environment string
+	Overrides   []models.EnvVars
+} {
+	var calls []struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+		Overrides   []models.EnvVars
+	}
+	mock.lockUpdateAgentConfigOverrides.RLock()
+	calls = mock.calls.UpdateAgentConfigOverrides
+	mock.lockUpdateAgentConfigOverrides.RUnlock()
+	return calls
+}
+
+// UpdateAgentEndpoints calls UpdateAgentEndpointsFunc.
+func (mock *OpenChoreoSvcClientMock) UpdateAgentEndpoints(ctx context.Context, orgName string, projName string, agentName string, endpoints []spec.AgentEndpoint) error {
+	if mock.UpdateAgentEndpointsFunc == nil {
+		panic("OpenChoreoSvcClientMock.UpdateAgentEndpointsFunc: method is nil but OpenChoreoSvcClient.UpdateAgentEndpoints was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Endpoints []spec.AgentEndpoint
+	}{
+		Ctx:       ctx,
+		OrgName:   orgName,
+		ProjName:  projName,
+		AgentName: agentName,
+		Endpoints: endpoints,
+	}
+	mock.lockUpdateAgentEndpoints.Lock()
+	mock.calls.UpdateAgentEndpoints = append(mock.calls.UpdateAgentEndpoints, callInfo)
+	mock.lockUpdateAgentEndpoints.Unlock()
+	return mock.UpdateAgentEndpointsFunc(ctx, orgName, projName, agentName, endpoints)
+}
+
+// UpdateAgentEndpointsCalls gets all the calls that were made to UpdateAgentEndpoints.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.UpdateAgentEndpointsCalls())
+func (mock *OpenChoreoSvcClientMock) UpdateAgentEndpointsCalls() []struct {
+	Ctx       context.Context
+	OrgName   string
+	ProjName  string
+	AgentName string
+	Endpoints []spec.AgentEndpoint
+} {
+	var calls []struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Endpoints []spec.AgentEndpoint
+	}
+	mock.lockUpdateAgentEndpoints.RLock()
+	calls = mock.calls.UpdateAgentEndpoints
+	mock.lockUpdateAgentEndpoints.RUnlock()
+	return calls
+}
+
+// UpdateAgentScaling calls UpdateAgentScalingFunc.
+func (mock *OpenChoreoSvcClientMock) UpdateAgentScaling(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+	if mock.UpdateAgentScalingFunc == nil {
+		panic("OpenChoreoSvcClientMock.UpdateAgentScalingFunc: method is nil but OpenChoreoSvcClient.UpdateAgentScaling was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+		Resources   *spec.ResourceRequirements
+		Replicas    *int32
+		Autoscaling *spec.AutoscalingConfig
+	}{
+		Ctx:         ctx,
+		OrgName:     orgName,
+		ProjName:    projName,
+		AgentName:   agentName,
+		Environment: environment,
+		Resources:   resources,
+		Replicas:    replicas,
+		Autoscaling: autoscaling,
+	}
+	mock.lockUpdateAgentScaling.Lock()
+	mock.calls.UpdateAgentScaling = append(mock.calls.UpdateAgentScaling, callInfo)
+	mock.lockUpdateAgentScaling.Unlock()
+	return mock.UpdateAgentScalingFunc(ctx, orgName, projName, agentName, environment, resources, replicas, autoscaling)
+}
+
+// UpdateAgentScalingCalls gets all the calls that were made to UpdateAgentScaling.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.UpdateAgentScalingCalls())
+func (mock *OpenChoreoSvcClientMock) UpdateAgentScalingCalls() []struct {
+	Ctx         context.Context
+	OrgName     string
+	ProjName    string
+	AgentName   string
+	Environment string
+	Resources   *spec.ResourceRequirements
+	Replicas    *int32
+	Autoscaling *spec.AutoscalingConfig
+} {
+	var calls []struct {
+		Ctx         context.Context
+		OrgName     string
+		ProjName    string
+		AgentName   string
+		Environment string
+		Resources   *spec.ResourceRequirements
+		Replicas    *int32
+		Autoscaling *spec.AutoscalingConfig
+	}
+	mock.lockUpdateAgentScaling.RLock()
+	calls = mock.calls.UpdateAgentScaling
+	mock.lockUpdateAgentScaling.RUnlock()
+	return calls
+}
@@ -18,6 +18,9 @@ package clientmocks
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"strings"
 	"sync"
 
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
@@ -42,6 +45,62 @@ type TraceObserverClientMock struct {
 		Ctx    context.Context
 		Params traceobserversvc.TraceDetailsByIdParams
 	}
+
+	// GetTokenUsage
+	GetTokenUsageFunc  func(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error)
+	getTokenUsageMutex sync.RWMutex
+	getTokenUsageCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.TokenUsageParams
+	}
+
+	// ListSessions
+	ListSessionsFunc  func(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionOverviewResponse, error)
+	listSessionsMutex sync.RWMutex
+	listSessionsCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.ListSessionsParams
+	}
+
+	// GetSessionTraces
+	GetSessionTracesFunc  func(ctx context.Context, params traceobserversvc.SessionTracesParams) (*traceobserversvc.SessionTracesResponse, error)
+	getSessionTracesMutex sync.RWMutex
+	getSessionTracesCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.SessionTracesParams
+	}
+
+	// ExportTrace
+	ExportTraceFunc  func(ctx context.Context, params traceobserversvc.ExportTraceParams) (json.RawMessage, error)
+	exportTraceMutex sync.RWMutex
+	exportTraceCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.ExportTraceParams
+	}
+
+	// ExportTraces
+	ExportTracesFunc  func(ctx context.Context, params traceobserversvc.ExportTracesParams) (io.ReadCloser, error)
+	exportTracesMutex sync.RWMutex
+	exportTracesCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.ExportTracesParams
+	}
+
+	// GetTraceTree
+	GetTraceTreeFunc  func(ctx context.Context, params traceobserversvc.TraceTreeParams) (*traceobserversvc.TraceTreeResponse, error)
+	getTraceTreeMutex sync.RWMutex
+	getTraceTreeCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.TraceTreeParams
+	}
+
+	// TailTraces
+	TailTracesFunc  func(ctx context.Context, params traceobserversvc.TailTracesParams) (io.ReadCloser, error)
+	tailTracesMutex sync.RWMutex
+	tailTracesCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.TailTracesParams
+	}
 }
 
 func (m *TraceObserverClientMock) ListTraces(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
@@ -97,3 +156,192 @@ func (m *TraceObserverClientMock) TraceDetailsByIdCalls() []struct {
 	defer m.traceDetailsByIdMutex.RUnlock()
 	return m.traceDetailsByIdCalls
 }
+
+func (m *TraceObserverClientMock) GetTokenUsage(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error) {
+	m.getTokenUsageMutex.Lock()
+	m.getTokenUsageCalls = append(m.getTokenUsageCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.TokenUsageParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getTokenUsageMutex.Unlock()
+
+	if m.GetTokenUsageFunc != nil {
+		return m.GetTokenUsageFunc(ctx, params)
+	}
+
+	return &traceobserversvc.TokenUsageResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetTokenUsageCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.TokenUsageParams
+} {
+	m.getTokenUsageMutex.RLock()
+	defer m.getTokenUsageMutex.RUnlock()
+	return m.getTokenUsageCalls
+}
+
+func (m *TraceObserverClientMock) ListSessions(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionOverviewResponse, error) {
+	m.listSessionsMutex.Lock()
+	m.listSessionsCalls = append(m.listSessionsCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.ListSessionsParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.listSessionsMutex.Unlock()
+
+	if m.ListSessionsFunc != nil {
+		return m.ListSessionsFunc(ctx, params)
+	}
+
+	return &traceobserversvc.SessionOverviewResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) ListSessionsCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.ListSessionsParams
+} {
+	m.listSessionsMutex.RLock()
+	defer m.listSessionsMutex.RUnlock()
+	return m.listSessionsCalls
+}
+
+func (m *TraceObserverClientMock) GetSessionTraces(ctx context.Context, params traceobserversvc.SessionTracesParams) (*traceobserversvc.SessionTracesResponse, error) {
+	m.getSessionTracesMutex.Lock()
+	m.getSessionTracesCalls = append(m.getSessionTracesCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.SessionTracesParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getSessionTracesMutex.Unlock()
+
+	if m.GetSessionTracesFunc != nil {
+		return m.GetSessionTracesFunc(ctx, params)
+	}
+
+	return &traceobserversvc.SessionTracesResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetSessionTracesCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.SessionTracesParams
+} {
+	m.getSessionTracesMutex.RLock()
+	defer m.getSessionTracesMutex.RUnlock()
+	return m.getSessionTracesCalls
+}
+
+func (m *TraceObserverClientMock) ExportTrace(ctx context.Context, params traceobserversvc.ExportTraceParams) (json.RawMessage, error) {
+	m.exportTraceMutex.Lock()
+	m.exportTraceCalls = append(m.exportTraceCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.ExportTraceParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.exportTraceMutex.Unlock()
+
+	if m.ExportTraceFunc != nil {
+		return m.ExportTraceFunc(ctx, params)
+	}
+
+	return json.RawMessage("{}"), nil
+}
+
+func (m *TraceObserverClientMock) ExportTraceCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.ExportTraceParams
+} {
+	m.exportTraceMutex.RLock()
+	defer m.exportTraceMutex.RUnlock()
+	return m.exportTraceCalls
+}
+
+func (m *TraceObserverClientMock) ExportTraces(ctx context.Context, params traceobserversvc.ExportTracesParams) (io.ReadCloser, error) {
+	m.exportTracesMutex.Lock()
+	m.exportTracesCalls = append(m.exportTracesCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.ExportTracesParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.exportTracesMutex.Unlock()
+
+	if m.ExportTracesFunc != nil {
+		return m.ExportTracesFunc(ctx, params)
+	}
+
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (m *TraceObserverClientMock) ExportTracesCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.ExportTracesParams
+} {
+	m.exportTracesMutex.RLock()
+	defer m.exportTracesMutex.RUnlock()
+	return m.exportTracesCalls
+}
+
+func (m *TraceObserverClientMock) GetTraceTree(ctx context.Context, params traceobserversvc.TraceTreeParams) (*traceobserversvc.TraceTreeResponse, error) {
+	m.getTraceTreeMutex.Lock()
+	m.getTraceTreeCalls = append(m.getTraceTreeCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.TraceTreeParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getTraceTreeMutex.Unlock()
+
+	if m.GetTraceTreeFunc != nil {
+		return m.GetTraceTreeFunc(ctx, params)
+	}
+
+	return &traceobserversvc.TraceTreeResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetTraceTreeCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.TraceTreeParams
+} {
+	m.getTraceTreeMutex.RLock()
+	defer m.getTraceTreeMutex.RUnlock()
+	return m.getTraceTreeCalls
+}
+
+func (m *TraceObserverClientMock) TailTraces(ctx context.Context, params traceobserversvc.TailTracesParams) (io.ReadCloser, error) {
+	m.tailTracesMutex.Lock()
+	m.tailTracesCalls = append(m.tailTracesCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.TailTracesParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.tailTracesMutex.Unlock()
+
+	if m.TailTracesFunc != nil {
+		return m.TailTracesFunc(ctx, params)
+	}
+
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (m *TraceObserverClientMock) TailTracesCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.TailTracesParams
+} {
+	m.tailTracesMutex.RLock()
+	defer m.tailTracesMutex.RUnlock()
+	return m.tailTracesCalls
+}
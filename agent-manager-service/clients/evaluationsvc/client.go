@@ -0,0 +1,160 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluationsvc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/requests"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+var ErrModelEndpointNotConfigured = errors.New("model endpoint is not configured")
+
+// Agent replies are stored with the run results, so larger replies are truncated
+const maxAgentResponseBytes = 1 << 20
+
+// EvaluationClient sends dataset inputs to deployed agents and calls the model endpoints that evaluators score outputs with
+type EvaluationClient interface {
+	InvokeAgent(ctx context.Context, params InvokeAgentParams) (string, error)
+	CreateEmbeddings(ctx context.Context, texts []string) ([][]float64, error)
+	CompleteChat(ctx context.Context, messages []ChatMessage) (string, error)
+}
+
+type evaluationClient struct {
+	httpClient requests.HttpClient
+}
+
+func NewEvaluationClient() EvaluationClient {
+	// Agent and model calls are bounded by per-request attempt timeouts instead of a client timeout
+	return &evaluationClient{
+		httpClient: &http.Client{},
+	}
+}
+
+// InvokeAgent sends a message to the chat endpoint of an agent and returns its reply. The reply is the
+// "response" field of the default chat API when present, otherwise the raw response body.
+// Agent calls are not idempotent, so unlike model calls they are sent once without retries.
+func (c *evaluationClient) InvokeAgent(ctx context.Context, params InvokeAgentParams) (string, error) {
+	payload, err := json.Marshal(chatRequest{Message: params.Message, SessionID: params.SessionID})
+	if err != nil {
+		return "", fmt.Errorf("evaluation.InvokeAgent: failed to encode request body: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.GetConfig().Evaluation.AgentRequestTimeoutSeconds)*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, params.URL, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("evaluation.InvokeAgent: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("evaluation.InvokeAgent: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxAgentResponseBytes))
+	if err != nil {
+		return "", fmt.Errorf("evaluation.InvokeAgent: failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("evaluation.InvokeAgent: %w", &requests.HttpError{StatusCode: resp.StatusCode, Body: string(body)})
+	}
+
+	var chatResponse struct {
+		Response *string `json:"response"`
+	}
+	if err := json.Unmarshal(body, &chatResponse); err == nil && chatResponse.Response != nil {
+		return *chatResponse.Response, nil
+	}
+	var text string
+	if err := json.Unmarshal(body, &text); err == nil {
+		return text, nil
+	}
+	return string(body), nil
+}
+
+// CreateEmbeddings returns an embedding for each of the given texts, in order, from the configured embeddings endpoint
+func (c *evaluationClient) CreateEmbeddings(ctx context.Context, texts []string) ([][]float64, error) {
+	endpoint := config.GetConfig().Evaluation.Embedding
+	if endpoint.URL == "" {
+		return nil, fmt.Errorf("evaluation.CreateEmbeddings: %w", ErrModelEndpointNotConfigured)
+	}
+	req := newModelRequest("evaluation.CreateEmbeddings", endpoint)
+	req.SetJson(embeddingRequest{Model: endpoint.Model, Input: texts})
+
+	var response embeddingResponse
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("evaluation.CreateEmbeddings: %w", err)
+	}
+	embeddings := make([][]float64, len(texts))
+	for _, data := range response.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, fmt.Errorf("evaluation.CreateEmbeddings: unexpected embedding index %d", data.Index)
+		}
+		embeddings[data.Index] = data.Embedding
+	}
+	for i, embedding := range embeddings {
+		if len(embedding) == 0 {
+			return nil, fmt.Errorf("evaluation.CreateEmbeddings: no embedding returned for input %d", i)
+		}
+	}
+	return embeddings, nil
+}
+
+// CompleteChat sends a conversation to the configured judge model, asking for a JSON object reply, and returns the reply content
+func (c *evaluationClient) CompleteChat(ctx context.Context, messages []ChatMessage) (string, error) {
+	endpoint := config.GetConfig().Evaluation.Judge
+	if endpoint.URL == "" {
+		return "", fmt.Errorf("evaluation.CompleteChat: %w", ErrModelEndpointNotConfigured)
+	}
+	req := newModelRequest("evaluation.CompleteChat", endpoint)
+	req.SetJson(chatCompletionRequest{
+		Model:          endpoint.Model,
+		Messages:       messages,
+		Temperature:    0,
+		ResponseFormat: &chatCompletionFormat{Type: "json_object"},
+	})
+
+	var response chatCompletionResponse
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		return "", fmt.Errorf("evaluation.CompleteChat: %w", err)
+	}
+	if len(response.Choices) == 0 {
+		return "", fmt.Errorf("evaluation.CompleteChat: no choices returned")
+	}
+	return response.Choices[0].Message.Content, nil
+}
+
+func newModelRequest(name string, endpoint config.ModelEndpointConfig) *requests.HttpRequest {
+	req := &requests.HttpRequest{
+		Name:   name,
+		URL:    endpoint.URL,
+		Method: http.MethodPost,
+	}
+	if endpoint.APIKey != "" {
+		req.SetHeader("Authorization", "Bearer "+endpoint.APIKey)
+	}
+	return req
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluationsvc
+
+// InvokeAgentParams holds the parameters for sending a dataset input to a deployed agent
+type InvokeAgentParams struct {
+	URL       string
+	Message   string
+	SessionID string
+}
+
+// chatRequest is the request body of the default chat API of an agent
+type chatRequest struct {
+	Message   string `json:"message"`
+	SessionID string `json:"session_id"`
+}
+
+// ChatMessage is a message of an OpenAI compatible chat completion request
+type ChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model          string                `json:"model,omitempty"`
+	Messages       []ChatMessage         `json:"messages"`
+	Temperature    float64               `json:"temperature"`
+	ResponseFormat *chatCompletionFormat `json:"response_format,omitempty"`
+}
+
+type chatCompletionFormat struct {
+	Type string `json:"type"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message ChatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+type embeddingRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float64 `json:"embedding"`
+	} `json:"data"`
+}
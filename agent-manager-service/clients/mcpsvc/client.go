@@ -0,0 +1,427 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mcpsvc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/requests"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// Oldest protocol version with the streamable HTTP transport; servers answer with the version they settle on
+	protocolVersion = "2025-03-26"
+	headerSessionID = "Mcp-Session-Id"
+	// Tool lists carry input schemas, so messages can be large
+	maxMessageBytes = 4 << 20
+	// Upper bound on the pages requested per list, in case a server keeps returning cursors
+	maxListPages = 20
+)
+
+// MCPClient lists the tools, resources and prompts of deployed MCP servers
+type MCPClient interface {
+	Introspect(ctx context.Context, params IntrospectParams) (*models.MCPServerDetails, error)
+}
+
+type mcpClient struct {
+	httpClient requests.HttpClient
+}
+
+func NewMCPClient() MCPClient {
+	// Calls are bounded by the introspection timeout instead of a client timeout, which would also cut off SSE streams
+	return &mcpClient{
+		httpClient: &http.Client{},
+	}
+}
+
+// session is an initialized connection to an MCP server over one of the supported transports
+type session interface {
+	call(ctx context.Context, method string, params interface{}, result interface{}) error
+	notify(ctx context.Context, method string) error
+	close(ctx context.Context)
+}
+
+// Introspect connects to the MCP server at the given URL as a client, and returns the server info and
+// everything the server lists for the tools, resources and prompts capabilities it advertises
+func (c *mcpClient) Introspect(ctx context.Context, params IntrospectParams) (*models.MCPServerDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.GetConfig().MCPServer.IntrospectionTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	var s session
+	switch params.Transport {
+	case utils.MCPTransportStreamableHTTP:
+		s = &streamableHTTPSession{httpClient: c.httpClient, url: params.URL}
+	case utils.MCPTransportSSE:
+		sseSession, err := openSSESession(ctx, c.httpClient, params.URL)
+		if err != nil {
+			return nil, fmt.Errorf("mcp.Introspect: %w", err)
+		}
+		s = sseSession
+	default:
+		return nil, fmt.Errorf("mcp.Introspect: unsupported transport %q", params.Transport)
+	}
+	defer s.close(ctx)
+
+	details, err := introspect(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("mcp.Introspect: %w", err)
+	}
+	details.URL = params.URL
+	details.Transport = string(params.Transport)
+	return details, nil
+}
+
+func introspect(ctx context.Context, s session) (*models.MCPServerDetails, error) {
+	var initResult initializeResult
+	err := s.call(ctx, "initialize", initializeParams{
+		ProtocolVersion: protocolVersion,
+		Capabilities:    map[string]interface{}{},
+		ClientInfo:      models.MCPServerInfo{Name: "agent-manager-service", Version: "1.0.0"},
+	}, &initResult)
+	if err != nil {
+		return nil, fmt.Errorf("initialize: %w", err)
+	}
+	if err := s.notify(ctx, "notifications/initialized"); err != nil {
+		return nil, fmt.Errorf("notifications/initialized: %w", err)
+	}
+
+	details := &models.MCPServerDetails{
+		ProtocolVersion: initResult.ProtocolVersion,
+		ServerInfo:      initResult.ServerInfo,
+		Tools:           []models.MCPTool{},
+		Resources:       []models.MCPResource{},
+		Prompts:         []models.MCPPrompt{},
+	}
+	if hasCapability(initResult.Capabilities.Tools) {
+		if details.Tools, err = listAll[models.MCPTool](ctx, s, "tools/list", "tools"); err != nil {
+			return nil, err
+		}
+	}
+	if hasCapability(initResult.Capabilities.Resources) {
+		if details.Resources, err = listAll[models.MCPResource](ctx, s, "resources/list", "resources"); err != nil {
+			return nil, err
+		}
+	}
+	if hasCapability(initResult.Capabilities.Prompts) {
+		if details.Prompts, err = listAll[models.MCPPrompt](ctx, s, "prompts/list", "prompts"); err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
+
+func hasCapability(capability json.RawMessage) bool {
+	return len(capability) > 0 && string(capability) != "null"
+}
+
+// listAll calls a paginated list method until the server stops returning a cursor, and collects the items in the given result field
+func listAll[T any](ctx context.Context, s session, method string, field string) ([]T, error) {
+	items := []T{}
+	cursor := ""
+	for page := 0; page < maxListPages; page++ {
+		var result map[string]json.RawMessage
+		if err := s.call(ctx, method, listParams{Cursor: cursor}, &result); err != nil {
+			return nil, fmt.Errorf("%s: %w", method, err)
+		}
+		var pageItems []T
+		if raw, ok := result[field]; ok && hasCapability(raw) {
+			if err := json.Unmarshal(raw, &pageItems); err != nil {
+				return nil, fmt.Errorf("%s: failed to decode %s: %w", method, field, err)
+			}
+		}
+		items = append(items, pageItems...)
+
+		var nextCursor string
+		if raw, ok := result["nextCursor"]; ok {
+			_ = json.Unmarshal(raw, &nextCursor)
+		}
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+	return items, nil
+}
+
+// streamableHTTPSession talks to a server over the streamable HTTP transport, where every message is POSTed to the
+// MCP endpoint and the reply comes back either as a JSON body or on an event stream opened for that request
+type streamableHTTPSession struct {
+	httpClient requests.HttpClient
+	url        string
+	sessionID  string
+	nextID     int64
+}
+
+func (s *streamableHTTPSession) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	s.nextID++
+	id := s.nextID
+	resp, err := postMessage(ctx, s.httpClient, s.url, s.sessionID, jsonRPCRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if sessionID := resp.Header.Get(headerSessionID); sessionID != "" {
+		s.sessionID = sessionID
+	}
+
+	var rpcResponse *jsonRPCResponse
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		rpcResponse, err = readStreamResponse(resp.Body, id)
+	} else {
+		rpcResponse = &jsonRPCResponse{}
+		if decodeErr := json.NewDecoder(io.LimitReader(resp.Body, maxMessageBytes)).Decode(rpcResponse); decodeErr != nil {
+			err = fmt.Errorf("failed to decode response: %w", decodeErr)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return rpcResponse.decodeResult(result)
+}
+
+func (s *streamableHTTPSession) notify(ctx context.Context, method string) error {
+	resp, err := postMessage(ctx, s.httpClient, s.url, s.sessionID, jsonRPCRequest{JSONRPC: "2.0", Method: method})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// close ends the server side session, if the server started one. Servers that do not allow clients to end
+// sessions answer with 405, and the session expires on its own, so failures are ignored.
+func (s *streamableHTTPSession) close(ctx context.Context) {
+	if s.sessionID == "" {
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.url, nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set(headerSessionID, s.sessionID)
+	if resp, err := s.httpClient.Do(req); err == nil {
+		resp.Body.Close()
+	}
+}
+
+// sseSession talks to a server over the HTTP+SSE transport, where the client keeps an event stream open, POSTs
+// messages to the endpoint announced on the stream, and receives the replies on the stream
+type sseSession struct {
+	httpClient   requests.HttpClient
+	endpoint     string
+	stream       io.ReadCloser
+	cancelStream context.CancelFunc
+	messages     chan json.RawMessage
+	done         chan struct{}
+	// Set by the stream reader before it closes messages
+	streamErr error
+	nextID    int64
+}
+
+func openSSESession(ctx context.Context, httpClient requests.HttpClient, rawURL string) (*sseSession, error) {
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		cancelStream()
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		cancelStream()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		resp.Body.Close()
+		cancelStream()
+		return nil, &requests.HttpError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	s := &sseSession{
+		httpClient:   httpClient,
+		stream:       resp.Body,
+		cancelStream: cancelStream,
+		messages:     make(chan json.RawMessage),
+		done:         make(chan struct{}),
+	}
+	endpoints := make(chan string, 1)
+	go s.readStream(endpoints)
+
+	select {
+	case endpoint, ok := <-endpoints:
+		if !ok {
+			s.close(ctx)
+			return nil, fmt.Errorf("event stream closed before the server announced its message endpoint")
+		}
+		endpointURL, err := req.URL.Parse(endpoint)
+		if err != nil {
+			s.close(ctx)
+			return nil, fmt.Errorf("invalid message endpoint %q: %w", endpoint, err)
+		}
+		s.endpoint = endpointURL.String()
+		return s, nil
+	case <-ctx.Done():
+		s.close(ctx)
+		return nil, ctx.Err()
+	}
+}
+
+func (s *sseSession) readStream(endpoints chan<- string) {
+	defer close(s.messages)
+	defer close(endpoints)
+	announced := false
+	s.streamErr = readSSEEvents(s.stream, func(event sseEvent) bool {
+		switch event.name {
+		case "endpoint":
+			if !announced {
+				endpoints <- event.data
+				announced = true
+			}
+		case "message":
+			select {
+			case s.messages <- json.RawMessage(event.data):
+			case <-s.done:
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (s *sseSession) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	s.nextID++
+	id := s.nextID
+	resp, err := postMessage(ctx, s.httpClient, s.endpoint, "", jsonRPCRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	for {
+		select {
+		case message, ok := <-s.messages:
+			if !ok {
+				if s.streamErr != nil {
+					return fmt.Errorf("event stream closed before the response was received: %w", s.streamErr)
+				}
+				return fmt.Errorf("event stream closed before the response was received")
+			}
+			var rpcResponse jsonRPCResponse
+			if err := json.Unmarshal(message, &rpcResponse); err != nil || !rpcResponse.isResponseTo(id) {
+				continue
+			}
+			return rpcResponse.decodeResult(result)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *sseSession) notify(ctx context.Context, method string) error {
+	resp, err := postMessage(ctx, s.httpClient, s.endpoint, "", jsonRPCRequest{JSONRPC: "2.0", Method: method})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *sseSession) close(ctx context.Context) {
+	close(s.done)
+	s.cancelStream()
+	s.stream.Close()
+}
+
+// Error responses are only kept for the error message
+const maxErrorBodyBytes = 4096
+
+// postMessage POSTs a JSON-RPC message and returns the response of a 2xx status; the caller closes the body
+func postMessage(ctx context.Context, httpClient requests.HttpClient, url string, sessionID string, message jsonRPCRequest) (*http.Response, error) {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s request: %w", message.Method, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if sessionID != "" {
+		req.Header.Set(headerSessionID, sessionID)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return nil, &requests.HttpError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	return resp, nil
+}
+
+// readStreamResponse reads the event stream a streamable HTTP server opened for a request until the response to it arrives
+func readStreamResponse(body io.Reader, id int64) (*jsonRPCResponse, error) {
+	var response *jsonRPCResponse
+	err := readSSEEvents(body, func(event sseEvent) bool {
+		if event.name != "message" {
+			return true
+		}
+		var rpcResponse jsonRPCResponse
+		if err := json.Unmarshal([]byte(event.data), &rpcResponse); err != nil || !rpcResponse.isResponseTo(id) {
+			return true
+		}
+		response = &rpcResponse
+		return false
+	})
+	if response != nil {
+		return response, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event stream: %w", err)
+	}
+	return nil, fmt.Errorf("event stream ended before the response was received")
+}
+
+func (r *jsonRPCResponse) isResponseTo(id int64) bool {
+	return r.Method == "" && strings.Trim(string(bytes.TrimSpace(r.ID)), `"`) == strconv.FormatInt(id, 10)
+}
+
+func (r *jsonRPCResponse) decodeResult(result interface{}) error {
+	if r.Error != nil {
+		return r.Error
+	}
+	if err := json.Unmarshal(r.Result, result); err != nil {
+		return fmt.Errorf("failed to decode result: %w", err)
+	}
+	return nil
+}
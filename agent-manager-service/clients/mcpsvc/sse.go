@@ -0,0 +1,60 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mcpsvc
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+type sseEvent struct {
+	name string
+	data string
+}
+
+// readSSEEvents parses a text/event-stream body and calls yield for each event until yield returns false
+// or the stream ends. Events without a name are reported as "message" events.
+func readSSEEvents(r io.Reader, yield func(sseEvent) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageBytes)
+
+	var name string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				if name == "" {
+					name = "message"
+				}
+				if !yield(sseEvent{name: name, data: strings.Join(data, "\n")}) {
+					return nil
+				}
+			}
+			name, data = "", nil
+		case strings.HasPrefix(line, ":"):
+			// comment, used by servers as keep-alive
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	return scanner.Err()
+}
@@ -33,8 +33,20 @@ const (
 	BuildLogTypeBuild = "BUILD"
 )
 
+// ComponentLogsParams selects the logs of a component in an environment
+type ComponentLogsParams struct {
+	EnvironmentId string
+	Namespace     string
+	StartTime     time.Time
+	EndTime       time.Time
+	Limit         int
+}
+
+//go:generate moq -rm -fmt goimports -skip-ensure -pkg clientmocks -out ../clientmocks/observability_client_fake.go . ObservabilitySvcClient:ObservabilitySvcClientMock
+
 type ObservabilitySvcClient interface {
 	GetBuildLogs(ctx context.Context, buildName string) (*models.BuildLogsResponse, error)
+	GetComponentLogs(ctx context.Context, componentId string, params ComponentLogsParams) (*models.ComponentLogsResponse, error)
 }
 
 type observabilitySvcClient struct {
@@ -83,3 +95,33 @@ func (o *observabilitySvcClient) GetBuildLogs(ctx context.Context, buildName str
 
 	return &logsResponse, nil
 }
+
+// GetComponentLogs retrieves the logs of a component in an environment from the observer service
+func (o *observabilitySvcClient) GetComponentLogs(ctx context.Context, componentId string, params ComponentLogsParams) (*models.ComponentLogsResponse, error) {
+	baseURL := config.GetConfig().Observer.URL
+	logsURL := fmt.Sprintf("%s/api/logs/component/%s", baseURL, componentId)
+
+	requestBody := map[string]interface{}{
+		"startTime":     params.StartTime.Format(time.RFC3339),
+		"endTime":       params.EndTime.Format(time.RFC3339),
+		"environmentId": params.EnvironmentId,
+		"namespace":     params.Namespace,
+		"limit":         params.Limit,
+		"sortOrder":     "asc",
+	}
+
+	req := &requests.HttpRequest{
+		Name:   "observabilitysvc.GetComponentLogs",
+		URL:    logsURL,
+		Method: http.MethodPost,
+	}
+	req.SetHeader("Accept", "application/json")
+	req.SetJson(requestBody)
+
+	var logsResponse models.ComponentLogsResponse
+	if err := requests.SendRequest(ctx, o.httpClient, req).ScanResponse(&logsResponse, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("observabilitysvc.GetComponentLogs: %w", err)
+	}
+
+	return &logsResponse, nil
+}
@@ -71,8 +71,13 @@ type OpenChoreoSvcClient interface {
 	GetEnvironment(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error)
 	IsAgentComponentExists(ctx context.Context, orgName string, projName string, agentName string) (bool, error)
 	GetAgentEndpoints(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.EndpointsResponse, error)
-	GetAgentConfigurations(ctx context.Context, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error)
+	GetDeployedEndpointSchemas(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.DeployedEndpointSchema, error)
+	UpdateAgentConfigOverrides(ctx context.Context, orgName string, projName string, agentName string, environment string, overrides []models.EnvVars) error
+	UpdateAgentScaling(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error
 	GetDataplanesForOrganization(ctx context.Context, orgName string) ([]*models.DataPlaneResponse, error)
+	TriggerJobRun(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error)
+	ListJobRuns(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunsResponse, error)
+	UpdateAgentEndpoints(ctx context.Context, orgName string, projName string, agentName string, endpoints []spec.AgentEndpoint) error
 }
 
 type openChoreoSvcClient struct {
@@ -296,7 +301,8 @@ func (k *openChoreoSvcClient) CreateAgentComponent(ctx context.Context, orgName
 			return fmt.Errorf("failed to create component: %w", err)
 		}
 		// Add OpenTelemetry instrumentation trait for Python agents
-		if req.AgentType.Type == string(utils.AgentTypeAPI) && req.RuntimeConfigs.Language == string(utils.LanguagePython) {
+		if (req.AgentType.Type == string(utils.AgentTypeAPI) || req.AgentType.Type == string(utils.AgentTypeMCPServer) ||
+			req.AgentType.Type == string(utils.AgentTypeEventDriven)) && req.RuntimeConfigs.Language == string(utils.LanguagePython) {
 			err := k.AttachComponentTrait(ctx, orgName, projName, req.Name)
 			if err != nil {
 				return fmt.Errorf("error attaching OTEL instrumentation trait: %w", err)
@@ -490,6 +496,12 @@ func (k *openChoreoSvcClient) DeployAgentComponent(ctx context.Context, orgName
 	if !exists {
 		return fmt.Errorf("agent component %s does not exist in open choreo %s", componentName, projName)
 	}
+	// Resources and scaling are set before the workload, so that the release the workload update triggers has them
+	if req.Resources != nil || req.Replicas != nil || req.Autoscaling != nil {
+		if err := k.updateComponentScaling(ctx, orgName, componentName, req.Resources, req.Replicas, req.Autoscaling); err != nil {
+			return err
+		}
+	}
 	componentWorkload, err := k.getComponentWorkload(ctx, orgName, projName, componentName)
 	if err != nil {
 		return fmt.Errorf("failed to get component workload: %w", err)
@@ -688,7 +700,8 @@ func (k *openChoreoSvcClient) GetAgentDeployments(ctx context.Context, orgName s
 	for _, envName := range environmentOrder {
 		// Find promotion target environment for this environment
 		promotionTargetEnv := findPromotionTargetEnvironment(envName, pipeline.PromotionPaths, environmentMap)
-		if releaseBinding, exists := releaseBindingMap[envName]; exists {
+		// Release bindings are created ahead of the first deployment to an environment to hold its scaling
+		if releaseBinding, exists := releaseBindingMap[envName]; exists && releaseBinding.Spec.ReleaseName != "" {
 			// Ensure corresponding release exists
 			if _, releaseExists := releaseMap[envName]; !releaseExists {
 				return nil, fmt.Errorf("release not found for environment %s", envName)
@@ -721,7 +734,7 @@ func (k *openChoreoSvcClient) GetAgentEndpoints(ctx context.Context, orgName str
 		return nil, fmt.Errorf("failed to check agent component existence: %w", err)
 	}
 	if !exists {
-		return nil, fmt.Errorf("agent component %s does not exist in open choreo %s", agentName, projName)
+		return nil, fmt.Errorf("%w: component %s does not exist in project %s", utils.ErrAgentNotFound, agentName, projName)
 	}
 	componentWorkload, err := k.getComponentWorkload(ctx, orgName, projName, agentName)
 	if err != nil {
@@ -745,7 +758,7 @@ func (k *openChoreoSvcClient) GetAgentEndpoints(ctx context.Context, orgName str
 		return nil, fmt.Errorf("failed to list release: %w", err)
 	}
 	if len(releaseList.Items) == 0 {
-		return nil, fmt.Errorf("no release found")
+		return nil, utils.ErrAgentNotDeployed
 	}
 
 	// Get the first matching Release (there should only be one per component/environment)
@@ -755,7 +768,23 @@ func (k *openChoreoSvcClient) GetAgentEndpoints(ctx context.Context, orgName str
 		return nil, fmt.Errorf("failed to extract endpoint URLs from release: %w", err)
 	}
 	if len(endpointURLs) == 0 {
-		return nil, fmt.Errorf("no endpoint URLs found in release")
+		return nil, fmt.Errorf("%w: no endpoint URLs found in release", utils.ErrAgentEndpointNotFound)
+	}
+
+	routedEndpoints := make(map[string]models.Endpoint, len(endpointURLs))
+	for _, endpointURL := range endpointURLs {
+		routedEndpoints[endpointURL.Name] = endpointURL
+	}
+	serviceHost, err := extractServiceHostFromEnvRelease(release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract service from release: %w", err)
+	}
+	primaryEndpointName := fmt.Sprintf("%s-endpoint", agentName)
+	// Agents created before endpoints were named have a single endpoint served by the primary route
+	if len(componentWorkload.Spec.Endpoints) == 1 {
+		for endpointName := range componentWorkload.Spec.Endpoints {
+			primaryEndpointName = endpointName
+		}
 	}
 
 	// Extract endpoint details from workload spec
@@ -766,9 +795,13 @@ func (k *openChoreoSvcClient) GetAgentEndpoints(ctx context.Context, orgName str
 		endpointResp := models.EndpointsResponse{}
 		endpointResp.Name = endpointName
 
-		// Assuming the first URL corresponds to this endpoint since we have a single endpoint
-		endpointResp.URL = endpointURLs[0].URL
-		endpointResp.Visibility = endpointURLs[0].Visibility
+		endpointURL, found := resolveEndpointURL(endpointName, endpoint, primaryEndpointName, routedEndpoints, serviceHost)
+		if !found {
+			continue
+		}
+		endpointResp.URL = endpointURL.URL
+		endpointResp.Visibility = endpointURL.Visibility
+		endpointResp.Type = getAgentEndpointType(endpoint.Type)
 
 		// Get schema content from workload endpoint
 		if endpoint.Schema != nil {
@@ -860,79 +893,6 @@ func (k *openChoreoSvcClient) GetDeploymentPipeline(ctx context.Context, orgName
 	return dpResponse, nil
 }
 
-func (k *openChoreoSvcClient) GetAgentConfigurations(ctx context.Context, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error) {
-	// Check if agent component exists
-	exists, err := k.IsAgentComponentExists(ctx, orgName, projectName, agentName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check agent component existence: %w", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("agent component %s does not exist in open choreo %s", agentName, projectName)
-	}
-
-	// Get the workload to extract base environment variables
-	componentWorkload, err := k.getComponentWorkload(ctx, orgName, projectName, agentName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get component workload: %w", err)
-	}
-
-	// Create a map to store environment variables (for easy merging)
-	envVarMap := make(map[string]string)
-
-	// Extract base environment variables from workload
-	if componentWorkload.Spec.Containers != nil {
-		if mainContainer, exists := componentWorkload.Spec.Containers["main"]; exists {
-			for _, envVar := range mainContainer.Env {
-				envVarMap[envVar.Key] = envVar.Value
-			}
-		}
-	}
-
-	// Get the ReleaseBinding for the specified environment
-	releaseBindingList := &v1alpha1.ReleaseBindingList{}
-	listOpts := []client.ListOption{
-		client.InNamespace(orgName),
-		client.MatchingLabels{
-			string(LabelKeyOrganizationName): orgName,
-			string(LabelKeyProjectName):      projectName,
-			string(LabelKeyComponentName):    agentName,
-			string(LabelKeyEnvironmentName):  environment,
-		},
-	}
-	err = k.retryK8sOperation(ctx, "ListReleaseBindings", func() error {
-		return k.client.List(ctx, releaseBindingList, listOpts...)
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list release bindings: %w", err)
-	}
-
-	// If a ReleaseBinding exists for this environment, merge its workload overrides
-	if len(releaseBindingList.Items) > 0 {
-		releaseBinding := &releaseBindingList.Items[0]
-
-		// Override with environment-specific variables from ReleaseBinding
-		if releaseBinding.Spec.WorkloadOverrides.Containers != nil {
-			if mainContainer, exists := releaseBinding.Spec.WorkloadOverrides.Containers["main"]; exists {
-				for _, envVar := range mainContainer.Env {
-					// Override or add environment variables
-					envVarMap[envVar.Key] = envVar.Value
-				}
-			}
-		}
-	}
-
-	// Convert map back to slice
-	var envVars []models.EnvVars
-	for key, value := range envVarMap {
-		envVars = append(envVars, models.EnvVars{
-			Key:   key,
-			Value: value,
-		})
-	}
-
-	return envVars, nil
-}
-
 func (k *openChoreoSvcClient) GetDeploymentPipelinesForOrganization(ctx context.Context, orgName string) ([]*models.DeploymentPipelineResponse, error) {
 	deploymentPipelineList := &v1alpha1.DeploymentPipelineList{}
 	err := k.retryK8sOperation(ctx, "ListDeploymentPipelines", func() error {
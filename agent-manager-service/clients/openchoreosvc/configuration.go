@@ -0,0 +1,82 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// UpdateAgentConfigOverrides replaces the environment variables the release binding of an environment overrides in the
+// agent container. OpenChoreo merges them over the env of the released workload by key and re-renders the bound
+// release, and the config hash annotation of the component types then restarts the pods without a new build.
+func (k *openChoreoSvcClient) UpdateAgentConfigOverrides(ctx context.Context, orgName string, projName string, agentName string, environment string, overrides []models.EnvVars) error {
+	releaseBinding, err := k.getEnvironmentReleaseBinding(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		return err
+	}
+
+	env := make([]v1alpha1.EnvVar, 0, len(overrides))
+	for _, override := range overrides {
+		env = append(env, v1alpha1.EnvVar{
+			Key:   override.Key,
+			Value: override.Value,
+		})
+	}
+	if releaseBinding.Spec.WorkloadOverrides == nil {
+		releaseBinding.Spec.WorkloadOverrides = &v1alpha1.WorkloadOverrideTemplateSpec{}
+	}
+	if releaseBinding.Spec.WorkloadOverrides.Containers == nil {
+		releaseBinding.Spec.WorkloadOverrides.Containers = map[string]v1alpha1.ContainerOverride{}
+	}
+	mainContainer := releaseBinding.Spec.WorkloadOverrides.Containers[MainContainerName]
+	mainContainer.Env = env
+	releaseBinding.Spec.WorkloadOverrides.Containers[MainContainerName] = mainContainer
+
+	err = k.retryK8sOperation(ctx, "UpdateReleaseBinding", func() error {
+		return k.client.Update(ctx, releaseBinding)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update release binding %s: %w", releaseBinding.Name, err)
+	}
+	return nil
+}
+
+// getEnvironmentReleaseBinding returns the release binding that deploys the agent to an environment
+func (k *openChoreoSvcClient) getEnvironmentReleaseBinding(ctx context.Context, orgName string, projName string, agentName string, environment string) (*v1alpha1.ReleaseBinding, error) {
+	releaseBindingList := &v1alpha1.ReleaseBindingList{}
+	err := k.retryK8sOperation(ctx, "ListReleaseBindings", func() error {
+		return k.client.List(ctx, releaseBindingList, client.InNamespace(orgName), client.MatchingLabels{
+			string(LabelKeyProjectName):     projName,
+			string(LabelKeyComponentName):   agentName,
+			string(LabelKeyEnvironmentName): environment,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+	if len(releaseBindingList.Items) > 0 {
+		return &releaseBindingList.Items[0], nil
+	}
+	return nil, utils.ErrAgentNotDeployed
+}
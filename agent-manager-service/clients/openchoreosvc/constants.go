@@ -27,6 +27,9 @@ const (
 	LabelKeyAgentLanguage        LabelKeys = "openchoreo.dev/agent-language"
 	LabelKeyAgentLanguageVersion LabelKeys = "openchoreo.dev/agent-language-version"
 	LabelKeyProvisioningType     LabelKeys = "openchoreo.dev/provisioning-type"
+	// Set on the releases of manually triggered job runs, which are not component releases
+	LabelKeyJobRunComponent LabelKeys = "openchoreo.dev/job-run-component"
+	LabelKeyJobRunTrigger   LabelKeys = "openchoreo.dev/job-run-trigger"
 )
 
 type AnnotationKeys string
@@ -34,6 +37,8 @@ type AnnotationKeys string
 const (
 	AnnotationKeyDisplayName AnnotationKeys = "openchoreo.dev/display-name"
 	AnnotationKeyDescription AnnotationKeys = "openchoreo.dev/description"
+	// Set on the routes of the endpoints an api agent exposes besides its primary endpoint
+	AnnotationKeyEndpointName AnnotationKeys = "openchoreo.dev/endpoint-name"
 )
 
 type TraceAttributeKeys string
@@ -95,6 +100,29 @@ const (
 	DeploymentStatusNotReady    = "not-ready"
 )
 
+// Progressing condition reasons of a Deployment
+const (
+	// The latest replica set is available
+	DeploymentReasonNewReplicaSetAvailable = "NewReplicaSetAvailable"
+	// The latest replica set is rolling out
+	DeploymentReasonReplicaSetUpdated = "ReplicaSetUpdated"
+	// The rollout made no progress within the progress deadline of the Deployment
+	DeploymentReasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// Kubernetes defaults of the probe settings the component types leave unset
+const (
+	DefaultProbePeriodSeconds    = 10
+	DefaultProbeFailureThreshold = 3
+)
+
+// Probe types of the probes component parameter
+const (
+	ProbeTypeNone = "none"
+	ProbeTypeHTTP = "http"
+	ProbeTypeExec = "exec"
+)
+
 const (
 	EndpointTypeDefault = "DEFAULT"
 	EndpointTypeCustom  = "CUSTOM"
@@ -116,3 +144,12 @@ const (
 	DefaultMemoryLimit   = "512Mi"
 	DefaultReplicaCount  = 1
 )
+
+// Job agent constants
+const (
+	// Schedule of job agents without a schedule. Their CronJob is suspended, so it never fires.
+	JobManualOnlySchedule = "0 0 31 2 *"
+	JobResourceID         = "job"
+	// Label Kubernetes sets on the pods of a Job
+	JobNameLabel = "job-name"
+)
@@ -0,0 +1,120 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// UpdateAgentEndpoints replaces the endpoints an api agent exposes besides its primary endpoint. The service ports
+// and routes follow the component parameters right away, while the endpoints of the workload are regenerated by the
+// next build of the agent.
+func (k *openChoreoSvcClient) UpdateAgentEndpoints(ctx context.Context, orgName string, projName string, agentName string, endpoints []spec.AgentEndpoint) error {
+	component := &v1alpha1.Component{}
+	key := client.ObjectKey{
+		Name:      agentName,
+		Namespace: orgName,
+	}
+	err := k.retryK8sOperation(ctx, "GetComponentForEndpointUpdate", func() error {
+		return k.client.Get(ctx, key, component)
+	})
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to get component for endpoint update: %w", err)
+	}
+	if component.Spec.Owner.ProjectName != projName {
+		return utils.ErrAgentNotFound
+	}
+	if component.Spec.ComponentType != string(ComponentTypeInternalAgentAPI) {
+		return utils.ErrAgentEndpointsUnsupported
+	}
+
+	parameters := map[string]interface{}{}
+	if component.Spec.Parameters != nil && len(component.Spec.Parameters.Raw) > 0 {
+		if err := json.Unmarshal(component.Spec.Parameters.Raw, &parameters); err != nil {
+			return fmt.Errorf("error unmarshalling component parameters: %w", err)
+		}
+	}
+	parameters["endpoints"] = getEndpointParameters(endpoints)
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		return fmt.Errorf("error marshalling component parameters: %w", err)
+	}
+	component.Spec.Parameters = &runtime.RawExtension{Raw: parametersJSON}
+
+	err = k.retryK8sOperation(ctx, "UpdateComponentEndpoints", func() error {
+		return k.client.Update(ctx, component)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update component endpoints: %w", err)
+	}
+	return nil
+}
+
+// resolveEndpointURL finds the URL of a workload endpoint. Public endpoints are matched with the route carrying
+// their name, and the primary endpoint with the route that carries none. Endpoints without a route are private and
+// are reached through the agent's service.
+func resolveEndpointURL(endpointName string, endpoint v1alpha1.WorkloadEndpoint, primaryEndpointName string, routedEndpoints map[string]models.Endpoint, serviceHost string) (models.Endpoint, bool) {
+	if routed, ok := routedEndpoints[endpointName]; ok {
+		return routed, true
+	}
+	if endpointName == primaryEndpointName {
+		routed, ok := routedEndpoints[""]
+		return routed, ok
+	}
+	if serviceHost == "" {
+		return models.Endpoint{}, false
+	}
+	return models.Endpoint{
+		URL:        fmt.Sprintf("http://%s:%d", serviceHost, endpoint.Port),
+		Visibility: string(utils.EndpointVisibilityPrivate),
+	}, true
+}
+
+// extractServiceHostFromEnvRelease returns the in-cluster host name of the service released for a component, or an
+// empty string when the release has no service
+func extractServiceHostFromEnvRelease(envRelease *v1alpha1.Release) (string, error) {
+	if envRelease == nil {
+		return "", nil
+	}
+	for _, resource := range envRelease.Spec.Resources {
+		if resource.Object == nil || len(resource.Object.Raw) == 0 {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal(resource.Object.Raw, &obj); err != nil {
+			return "", fmt.Errorf("error unmarshalling resource: %w", err)
+		}
+		if obj.GetKind() == "Service" {
+			return fmt.Sprintf("%s.%s.svc.cluster.local", obj.GetName(), obj.GetNamespace()), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,353 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Job names are used as pod label values, which are limited to 63 characters
+const maxJobRunNamePrefixLength = 45
+
+// TriggerJobRun runs a job agent once in an environment. The run is applied to the data plane as a release holding
+// a Job created from the job template of the agent's CronJob, so it runs with the image and configuration deployed
+// to that environment. The run release is owned by the environment release and goes away with it.
+func (k *openChoreoSvcClient) TriggerJobRun(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error) {
+	envRelease, err := k.getEnvironmentRelease(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	cronJob, _, err := findCronJob(envRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	jobTemplate := cronJob.Spec.JobTemplate
+	runName := fmt.Sprintf("%s-manual-%s", truncateName(cronJob.Name, maxJobRunNamePrefixLength), strconv.FormatInt(time.Now().Unix(), 36))
+	jobLabels := map[string]string{}
+	for key, value := range jobTemplate.Labels {
+		jobLabels[key] = value
+	}
+	jobLabels[string(LabelKeyJobRunTrigger)] = string(utils.JobRunTriggerManual)
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      runName,
+			Namespace: cronJob.Namespace,
+			Labels:    jobLabels,
+		},
+		Spec: jobTemplate.Spec,
+	}
+	jobJSON, err := json.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling job: %w", err)
+	}
+
+	release := &v1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      runName,
+			Namespace: orgName,
+			Labels: map[string]string{
+				string(LabelKeyOrganizationName): orgName,
+				string(LabelKeyProjectName):      projName,
+				string(LabelKeyEnvironmentName):  environment,
+				string(LabelKeyJobRunComponent):  agentName,
+				string(LabelKeyJobRunTrigger):    string(utils.JobRunTriggerManual),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: v1alpha1.GroupVersion.String(),
+					Kind:       "Release",
+					Name:       envRelease.Name,
+					UID:        envRelease.UID,
+				},
+			},
+		},
+		Spec: v1alpha1.ReleaseSpec{
+			Owner: v1alpha1.ReleaseOwner{
+				ProjectName:   projName,
+				ComponentName: agentName,
+			},
+			EnvironmentName: environment,
+			Resources: []v1alpha1.Resource{
+				{
+					ID:     JobResourceID,
+					Object: &runtime.RawExtension{Raw: jobJSON},
+				},
+			},
+		},
+	}
+	err = k.retryK8sOperation(ctx, "CreateJobRunRelease", func() error {
+		return k.client.Create(ctx, release)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job run release: %w", err)
+	}
+
+	return &models.JobRunResponse{
+		Name:        runName,
+		Environment: environment,
+		Trigger:     string(utils.JobRunTriggerManual),
+		Status:      string(utils.JobRunStatusPending),
+		Namespace:   cronJob.Namespace,
+	}, nil
+}
+
+// ListJobRuns lists the runs of a job agent in an environment, latest first. Runs are read from the Jobs created
+// from the job template of the agent's CronJob, selected by the labels of the template. Manual runs whose Job has not
+// been created yet are read from their releases.
+func (k *openChoreoSvcClient) ListJobRuns(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunsResponse, error) {
+	envRelease, err := k.getEnvironmentRelease(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	cronJob, cronJobStatus, err := findCronJob(envRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.JobRunsResponse{
+		Schedule: models.JobScheduleResponse{
+			Schedule:  cronJob.Spec.Schedule,
+			Suspended: cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		},
+		Runs: []models.JobRunResponse{},
+	}
+	if cronJobStatus != nil {
+		response.Schedule.LastScheduleTime = toTimePointer(cronJobStatus.LastScheduleTime)
+		response.Schedule.LastSuccessfulTime = toTimePointer(cronJobStatus.LastSuccessfulTime)
+	}
+
+	runs := []jobRun{}
+	jobNames := map[string]bool{}
+	// Without labels the selector would match every Job in the namespace
+	if len(cronJob.Spec.JobTemplate.Labels) > 0 {
+		jobs := &batchv1.JobList{}
+		err = k.retryK8sOperation(ctx, "ListJobs", func() error {
+			return k.client.List(ctx, jobs, client.InNamespace(cronJob.Namespace), client.MatchingLabels(cronJob.Spec.JobTemplate.Labels))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+		for i := range jobs.Items {
+			job := &jobs.Items[i]
+			jobNames[job.Name] = true
+			runs = append(runs, jobRun{run: toJobRun(job, environment), createdAt: job.CreationTimestamp.Time})
+		}
+	}
+
+	runReleases := &v1alpha1.ReleaseList{}
+	err = k.retryK8sOperation(ctx, "ListJobRunReleases", func() error {
+		return k.client.List(ctx, runReleases, client.InNamespace(orgName), client.MatchingLabels{
+			string(LabelKeyProjectName):     projName,
+			string(LabelKeyEnvironmentName): environment,
+			string(LabelKeyJobRunComponent): agentName,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job run releases: %w", err)
+	}
+	for i := range runReleases.Items {
+		release := &runReleases.Items[i]
+		if jobNames[release.Name] {
+			continue
+		}
+		run, err := toManualJobRun(release, cronJob.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, jobRun{run: *run, createdAt: release.CreationTimestamp.Time})
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].createdAt.After(runs[j].createdAt)
+	})
+	for _, run := range runs {
+		response.Runs = append(response.Runs, run.run)
+	}
+	response.Total = len(response.Runs)
+	return response, nil
+}
+
+// jobRun is a run along with the creation time of the Job or release it was read from, which orders the runs
+type jobRun struct {
+	run       models.JobRunResponse
+	createdAt time.Time
+}
+
+// getEnvironmentRelease returns the release of a component in an environment
+func (k *openChoreoSvcClient) getEnvironmentRelease(ctx context.Context, orgName string, projName string, componentName string, environment string) (*v1alpha1.Release, error) {
+	releaseList := &v1alpha1.ReleaseList{}
+	err := k.retryK8sOperation(ctx, "ListRelease", func() error {
+		return k.client.List(ctx, releaseList, client.InNamespace(orgName), client.MatchingLabels{
+			string(LabelKeyOrganizationName): orgName,
+			string(LabelKeyProjectName):      projName,
+			string(LabelKeyComponentName):    componentName,
+			string(LabelKeyEnvironmentName):  environment,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release: %w", err)
+	}
+	if len(releaseList.Items) == 0 {
+		return nil, utils.ErrAgentNotDeployed
+	}
+	return &releaseList.Items[0], nil
+}
+
+// findCronJob returns the CronJob of a job agent release along with its status in the data plane, which is nil
+// until the release has been applied
+func findCronJob(release *v1alpha1.Release) (*batchv1.CronJob, *batchv1.CronJobStatus, error) {
+	for _, resource := range release.Spec.Resources {
+		if resource.Object == nil || len(resource.Object.Raw) == 0 {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal(resource.Object.Raw, &obj); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshalling resource: %w", err)
+		}
+		if obj.GetKind() != "CronJob" {
+			continue
+		}
+		cronJob := &batchv1.CronJob{}
+		if err := json.Unmarshal(resource.Object.Raw, cronJob); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshalling cron job: %w", err)
+		}
+		for _, resourceStatus := range release.Status.Resources {
+			if resourceStatus.ID != resource.ID || resourceStatus.Status == nil || len(resourceStatus.Status.Raw) == 0 {
+				continue
+			}
+			cronJobStatus := &batchv1.CronJobStatus{}
+			if err := json.Unmarshal(resourceStatus.Status.Raw, cronJobStatus); err != nil {
+				return nil, nil, fmt.Errorf("error unmarshalling cron job status: %w", err)
+			}
+			return cronJob, cronJobStatus, nil
+		}
+		return cronJob, nil, nil
+	}
+	return nil, nil, utils.ErrAgentNotJob
+}
+
+// toJobRun converts a Job of a job agent to a job run. Manual runs carry the trigger label set when they were
+// triggered, and every other Job was created by the CronJob.
+func toJobRun(job *batchv1.Job, environment string) models.JobRunResponse {
+	trigger := utils.JobRunTriggerScheduled
+	if job.Labels[string(LabelKeyJobRunTrigger)] == string(utils.JobRunTriggerManual) {
+		trigger = utils.JobRunTriggerManual
+	}
+	run := models.JobRunResponse{
+		Name:        job.Name,
+		Environment: environment,
+		Trigger:     string(trigger),
+		Status:      string(utils.JobRunStatusPending),
+		Namespace:   job.Namespace,
+	}
+	applyJobStatus(&run, &job.Status)
+	return run
+}
+
+// toManualJobRun converts the release of a manually triggered run to a job run
+func toManualJobRun(release *v1alpha1.Release, namespace string) (*models.JobRunResponse, error) {
+	createdAt := release.CreationTimestamp.Time
+	run := &models.JobRunResponse{
+		Name:        release.Name,
+		Environment: release.Spec.EnvironmentName,
+		Trigger:     string(utils.JobRunTriggerManual),
+		Status:      string(utils.JobRunStatusPending),
+		StartedAt:   &createdAt,
+		Namespace:   namespace,
+	}
+	for _, resourceStatus := range release.Status.Resources {
+		if resourceStatus.ID != JobResourceID || resourceStatus.Status == nil || len(resourceStatus.Status.Raw) == 0 {
+			continue
+		}
+		jobStatus := &batchv1.JobStatus{}
+		if err := json.Unmarshal(resourceStatus.Status.Raw, jobStatus); err != nil {
+			return nil, fmt.Errorf("error unmarshalling job status of run %s: %w", release.Name, err)
+		}
+		if resourceStatus.Namespace != "" {
+			run.Namespace = resourceStatus.Namespace
+		}
+		applyJobStatus(run, jobStatus)
+	}
+	return run, nil
+}
+
+// applyJobStatus sets the status, timestamps and failure message of a run from the status of its Job
+func applyJobStatus(run *models.JobRunResponse, jobStatus *batchv1.JobStatus) {
+	if jobStatus.StartTime != nil {
+		run.StartedAt = toTimePointer(jobStatus.StartTime)
+	}
+	run.CompletedAt = toTimePointer(jobStatus.CompletionTime)
+	if jobStatus.Active > 0 {
+		run.Status = string(utils.JobRunStatusRunning)
+	}
+	for _, condition := range jobStatus.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			run.Status = string(utils.JobRunStatusSucceeded)
+		case batchv1.JobFailed:
+			run.Status = string(utils.JobRunStatusFailed)
+			run.Message = condition.Message
+			if run.Message == "" {
+				run.Message = condition.Reason
+			}
+			if run.CompletedAt == nil {
+				completedAt := condition.LastTransitionTime.Time
+				run.CompletedAt = &completedAt
+			}
+		}
+	}
+}
+
+func toTimePointer(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.Time
+	return &value
+}
+
+func truncateName(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+	return strings.TrimRight(name[:maxLength], "-")
+}
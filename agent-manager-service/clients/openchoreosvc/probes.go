@@ -0,0 +1,182 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+)
+
+// getProbeParameters returns the probes component parameter. Probes that are not set are passed with type none so
+// that they are left out of the container.
+func getProbeParameters(probes *spec.AgentProbes) map[string]interface{} {
+	var liveness, readiness, startup *spec.Probe
+	if probes != nil {
+		liveness, readiness, startup = probes.Liveness, probes.Readiness, probes.Startup
+	}
+	return map[string]interface{}{
+		"liveness":  getProbeParameter(liveness),
+		"readiness": getProbeParameter(readiness),
+		"startup":   getProbeParameter(startup),
+	}
+}
+
+// getProbeParameter returns the parameter of a single probe. Thresholds and periods that are not set are left to the
+// defaults of the component type, which match those of Kubernetes.
+func getProbeParameter(probe *spec.Probe) map[string]interface{} {
+	if probe == nil {
+		return map[string]interface{}{
+			"type": ProbeTypeNone,
+		}
+	}
+	parameter := map[string]interface{}{}
+	if probe.HttpGet != nil {
+		parameter["type"] = ProbeTypeHTTP
+		parameter["path"] = probe.HttpGet.Path
+		parameter["port"] = probe.HttpGet.Port
+	} else {
+		parameter["type"] = ProbeTypeExec
+		parameter["command"] = probe.Exec.GetCommand()
+	}
+	for name, value := range map[string]*int32{
+		"initialDelaySeconds": probe.InitialDelaySeconds,
+		"periodSeconds":       probe.PeriodSeconds,
+		"timeoutSeconds":      probe.TimeoutSeconds,
+		"failureThreshold":    probe.FailureThreshold,
+		"successThreshold":    probe.SuccessThreshold,
+	} {
+		if value != nil {
+			parameter[name] = *value
+		}
+	}
+	return parameter
+}
+
+// hasFailingProbes reports whether replicas of a Deployment that is no longer making progress fail the startup,
+// readiness or liveness probes of the agent container. A replica that fails its readiness probe stops receiving traffic
+// and one that fails its startup or liveness probe is restarted, so both leave the Deployment with fewer ready replicas
+// than it runs. Rollouts that hang on such replicas are covered as well as rollouts that have completed.
+func hasFailingProbes(envRelease *v1alpha1.Release, now time.Time) bool {
+	if envRelease == nil {
+		return false
+	}
+	for _, releaseResource := range envRelease.Spec.Resources {
+		if releaseResource.Object == nil || len(releaseResource.Object.Raw) == 0 {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal(releaseResource.Object.Raw, &obj); err != nil || obj.GetKind() != "Deployment" {
+			continue
+		}
+		container := findMainContainer(obj.Object)
+		if container == nil || !hasProbes(container) {
+			return false
+		}
+		status := findDeploymentStatus(envRelease, releaseResource.ID)
+		if status == nil || status.UpdatedReplicas == 0 || status.ReadyReplicas >= status.Replicas {
+			return false
+		}
+		return isRolloutSettled(status, getProbeStartupWindow(container), now)
+	}
+	return false
+}
+
+// findMainContainer returns the agent container of a Deployment
+func findMainContainer(deployment map[string]interface{}) map[string]interface{} {
+	containers, _, _ := unstructured.NestedSlice(deployment, "spec", "template", "spec", "containers")
+	for _, container := range containers {
+		containerMap, ok := container.(map[string]interface{})
+		if ok && containerMap["name"] == MainContainerName {
+			return containerMap
+		}
+	}
+	return nil
+}
+
+// hasProbes reports whether a container has a startup, readiness or liveness probe
+func hasProbes(container map[string]interface{}) bool {
+	_, hasStartup := container["startupProbe"]
+	_, hasReadiness := container["readinessProbe"]
+	_, hasLiveness := container["livenessProbe"]
+	return hasStartup || hasReadiness || hasLiveness
+}
+
+// getProbeStartupWindow returns how long the probes of a container allow it to take to become ready. The readiness and
+// liveness probes only start once the startup probe has succeeded.
+func getProbeStartupWindow(container map[string]interface{}) time.Duration {
+	return getProbeWindow(container, "startupProbe") +
+		max(getProbeWindow(container, "readinessProbe"), getProbeWindow(container, "livenessProbe"))
+}
+
+// getProbeWindow returns the time a probe of a container takes to fail from the start of the container
+func getProbeWindow(container map[string]interface{}, probeName string) time.Duration {
+	probe, ok := container[probeName].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	initialDelaySeconds, _, _ := unstructured.NestedInt64(probe, "initialDelaySeconds")
+	periodSeconds, found, _ := unstructured.NestedInt64(probe, "periodSeconds")
+	if !found {
+		periodSeconds = DefaultProbePeriodSeconds
+	}
+	failureThreshold, found, _ := unstructured.NestedInt64(probe, "failureThreshold")
+	if !found {
+		failureThreshold = DefaultProbeFailureThreshold
+	}
+	return time.Duration(initialDelaySeconds+periodSeconds*failureThreshold) * time.Second
+}
+
+// findDeploymentStatus returns the status the release observed for a Deployment in the data plane
+func findDeploymentStatus(envRelease *v1alpha1.Release, resourceID string) *appsv1.DeploymentStatus {
+	for _, resourceStatus := range envRelease.Status.Resources {
+		if resourceStatus.ID != resourceID || resourceStatus.Status == nil || len(resourceStatus.Status.Raw) == 0 {
+			continue
+		}
+		status := &appsv1.DeploymentStatus{}
+		if err := json.Unmarshal(resourceStatus.Status.Raw, status); err != nil {
+			return nil
+		}
+		return status
+	}
+	return nil
+}
+
+// isRolloutSettled reports whether a Deployment has stopped making progress, so that replicas that are not ready by
+// then fail rather than are still starting, which may take a while for agents that load models. That is the case once
+// the latest replica set is available or the progress deadline is exceeded, or when a rollout has not progressed for
+// longer than the probes allow a container to take to become ready.
+func isRolloutSettled(status *appsv1.DeploymentStatus, startupWindow time.Duration, now time.Time) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type != appsv1.DeploymentProgressing {
+			continue
+		}
+		switch condition.Reason {
+		case DeploymentReasonNewReplicaSetAvailable, DeploymentReasonProgressDeadlineExceeded:
+			return true
+		case DeploymentReasonReplicaSetUpdated:
+			return now.Sub(condition.LastUpdateTime.Time) > startupWindow
+		}
+		return false
+	}
+	return false
+}
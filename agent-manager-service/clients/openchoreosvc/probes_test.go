@@ -0,0 +1,198 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func TestComponentProbeParameters(t *testing.T) {
+	periodSeconds, failureThreshold := int32(10), int32(60)
+	req := &spec.CreateAgentRequest{
+		Name:        "probes-agent",
+		DisplayName: "Probes Agent",
+		Provisioning: spec.Provisioning{
+			Type: string(utils.InternalAgent),
+			Repository: &spec.RepositoryConfig{
+				Url:     "https://github.com/test/probes-agent",
+				Branch:  "main",
+				AppPath: "agent",
+			},
+		},
+		AgentType: spec.AgentType{Type: string(utils.AgentTypeAPI), SubType: spec.PtrString(string(utils.AgentSubTypeChatAPI))},
+		RuntimeConfigs: &spec.RuntimeConfiguration{
+			Language:        "python",
+			LanguageVersion: spec.PtrString("3.11"),
+			RunCommand:      spec.PtrString("python main.py"),
+			Probes: &spec.AgentProbes{
+				Readiness: &spec.Probe{Exec: &spec.ProbeExecAction{Command: []string{"cat", "/tmp/ready"}}},
+				Startup: &spec.Probe{
+					HttpGet:          &spec.ProbeHTTPGetAction{Path: "/healthz", Port: 8000},
+					PeriodSeconds:    &periodSeconds,
+					FailureThreshold: &failureThreshold,
+				},
+			},
+		},
+	}
+
+	component, err := createComponentCRForInternalAgents("probes-org", "probes-project", req)
+	require.NoError(t, err)
+
+	// The v1alpha1 Container of a workload has no probe fields, so probes are rendered by the component type from
+	// the component parameters
+	var parameters map[string]interface{}
+	require.NoError(t, json.Unmarshal(component.Spec.Parameters.Raw, &parameters))
+	require.Equal(t, map[string]interface{}{
+		"liveness": map[string]interface{}{"type": ProbeTypeNone},
+		"readiness": map[string]interface{}{
+			"type":    ProbeTypeExec,
+			"command": []interface{}{"cat", "/tmp/ready"},
+		},
+		"startup": map[string]interface{}{
+			"type":             ProbeTypeHTTP,
+			"path":             "/healthz",
+			"port":             float64(8000),
+			"periodSeconds":    float64(10),
+			"failureThreshold": float64(60),
+		},
+	}, parameters["probes"])
+}
+
+func TestHasFailingProbes(t *testing.T) {
+	now := time.Date(2025, time.January, 10, 12, 0, 0, 0, time.UTC)
+	readinessProbe := map[string]interface{}{"httpGet": map[string]interface{}{"path": "/ready", "port": 8000}}
+	// Allows the container ten minutes to start before the readiness probe takes over
+	startupProbe := map[string]interface{}{"httpGet": map[string]interface{}{"path": "/healthz", "port": 8000}, "periodSeconds": 10, "failureThreshold": 60}
+
+	tests := []struct {
+		name       string
+		probes     map[string]interface{}
+		status     appsv1.DeploymentStatus
+		wantFailed bool
+	}{
+		{
+			name:       "rolled out replicas that are not ready",
+			probes:     map[string]interface{}{"readinessProbe": readinessProbe},
+			status:     deploymentStatus(2, 2, 1, DeploymentReasonNewReplicaSetAvailable, now),
+			wantFailed: true,
+		},
+		{
+			name:       "rolled out replicas that are all ready",
+			probes:     map[string]interface{}{"readinessProbe": readinessProbe},
+			status:     deploymentStatus(2, 2, 2, DeploymentReasonNewReplicaSetAvailable, now),
+			wantFailed: false,
+		},
+		{
+			name:       "rollout past its progress deadline",
+			probes:     map[string]interface{}{"readinessProbe": readinessProbe},
+			status:     deploymentStatus(2, 1, 1, DeploymentReasonProgressDeadlineExceeded, now.Add(-15*time.Minute)),
+			wantFailed: true,
+		},
+		{
+			name:       "rollout that has not progressed for longer than the probes allow",
+			probes:     map[string]interface{}{"readinessProbe": readinessProbe},
+			status:     deploymentStatus(2, 1, 1, DeploymentReasonReplicaSetUpdated, now.Add(-time.Minute)),
+			wantFailed: true,
+		},
+		{
+			name:       "rollout that is still within the startup probe window",
+			probes:     map[string]interface{}{"startupProbe": startupProbe, "readinessProbe": readinessProbe},
+			status:     deploymentStatus(2, 1, 1, DeploymentReasonReplicaSetUpdated, now.Add(-5*time.Minute)),
+			wantFailed: false,
+		},
+		{
+			name:       "rolled out replicas that fail their startup probe",
+			probes:     map[string]interface{}{"startupProbe": startupProbe},
+			status:     deploymentStatus(1, 1, 0, DeploymentReasonNewReplicaSetAvailable, now),
+			wantFailed: true,
+		},
+		{
+			name:       "replicas that are not ready without probes",
+			probes:     map[string]interface{}{},
+			status:     deploymentStatus(1, 1, 0, DeploymentReasonProgressDeadlineExceeded, now),
+			wantFailed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantFailed, hasFailingProbes(newDeploymentRelease(t, tt.probes, tt.status), now))
+		})
+	}
+}
+
+// deploymentStatus returns the status of a Deployment whose Progressing condition was last updated at the given time
+func deploymentStatus(replicas int32, updatedReplicas int32, readyReplicas int32, progressingReason string, lastUpdateTime time.Time) appsv1.DeploymentStatus {
+	return appsv1.DeploymentStatus{
+		Replicas:        replicas,
+		UpdatedReplicas: updatedReplicas,
+		ReadyReplicas:   readyReplicas,
+		Conditions: []appsv1.DeploymentCondition{
+			{
+				Type:           appsv1.DeploymentProgressing,
+				Reason:         progressingReason,
+				LastUpdateTime: metav1.NewTime(lastUpdateTime),
+			},
+		},
+	}
+}
+
+// newDeploymentRelease returns a release of a Deployment whose agent container has the given probes, along with the
+// status observed for it
+func newDeploymentRelease(t *testing.T, probes map[string]interface{}, status appsv1.DeploymentStatus) *v1alpha1.Release {
+	container := map[string]interface{}{"name": MainContainerName, "image": "registry.local/probes-agent:latest"}
+	for name, probe := range probes {
+		container[name] = probe
+	}
+	deploymentJSON, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "probes-agent"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{container}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	statusJSON, err := json.Marshal(status)
+	require.NoError(t, err)
+
+	return &v1alpha1.Release{
+		Spec: v1alpha1.ReleaseSpec{
+			Resources: []v1alpha1.Resource{
+				{ID: "deployment", Object: &runtime.RawExtension{Raw: deploymentJSON}},
+			},
+		},
+		Status: v1alpha1.ReleaseStatus{
+			Resources: []v1alpha1.ResourceStatus{
+				{ID: "deployment", Kind: "Deployment", Status: &runtime.RawExtension{Raw: statusJSON}},
+			},
+		},
+	}
+}
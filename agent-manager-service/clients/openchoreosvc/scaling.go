@@ -0,0 +1,319 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// getResourceParameters returns the resources component parameter, falling back to the defaults for the quantities
+// that are not set
+func getResourceParameters(resources *spec.ResourceRequirements) map[string]interface{} {
+	var requests, limits *spec.ResourceQuantity
+	if resources != nil {
+		requests, limits = resources.Requests, resources.Limits
+	}
+	cpuRequest, cpuLimit := resolveResourceQuantities(requests.GetCpu(), limits.GetCpu(), DefaultCPURequest, DefaultCPULimit)
+	memoryRequest, memoryLimit := resolveResourceQuantities(requests.GetMemory(), limits.GetMemory(), DefaultMemoryRequest, DefaultMemoryLimit)
+	return map[string]interface{}{
+		"requests": map[string]string{
+			"cpu":    cpuRequest,
+			"memory": memoryRequest,
+		},
+		"limits": map[string]string{
+			"cpu":    cpuLimit,
+			"memory": memoryLimit,
+		},
+	}
+}
+
+// resolveResourceQuantities fills in the default request and limit of a resource. A default is moved to the value
+// that is set when it would otherwise leave the request above the limit.
+func resolveResourceQuantities(request string, limit string, defaultRequest string, defaultLimit string) (string, string) {
+	switch {
+	case request == "" && limit == "":
+		return defaultRequest, defaultLimit
+	case request == "":
+		if compareQuantities(defaultRequest, limit) > 0 {
+			return limit, limit
+		}
+		return defaultRequest, limit
+	case limit == "":
+		if compareQuantities(request, defaultLimit) > 0 {
+			return request, request
+		}
+		return request, defaultLimit
+	}
+	return request, limit
+}
+
+// compareQuantities compares two resource quantities, treating ones that cannot be parsed as equal
+func compareQuantities(a string, b string) int {
+	quantityA, err := resource.ParseQuantity(a)
+	if err != nil {
+		return 0
+	}
+	quantityB, err := resource.ParseQuantity(b)
+	if err != nil {
+		return 0
+	}
+	return quantityA.Cmp(quantityB)
+}
+
+// getAutoscalingParameters returns the autoscaling component parameter, which is disabled when autoscaling is not set.
+// Targets that are not set are passed as 0 so that the autoscaler does not scale on them.
+func getAutoscalingParameters(autoscaling *spec.AutoscalingConfig) map[string]interface{} {
+	if autoscaling == nil {
+		return map[string]interface{}{
+			"enabled": false,
+		}
+	}
+	return map[string]interface{}{
+		"enabled":                        true,
+		"minReplicas":                    autoscaling.MinReplicas,
+		"maxReplicas":                    autoscaling.MaxReplicas,
+		"targetCPUUtilizationPercentage": autoscaling.GetTargetCPUUtilizationPercentage(),
+		"targetConcurrency":              autoscaling.GetTargetConcurrency(),
+		"concurrencyMetric":              config.GetConfig().AgentScaling.ConcurrencyMetric,
+	}
+}
+
+// UpdateAgentScaling writes resources and scaling into the component type overrides of the release binding of an
+// environment, so that each environment is sized on its own rather than through the component parameters that are
+// promoted through all of them. Settings that are not given keep their current values. Returns ErrAgentNotDeployed
+// when the agent has no release binding in the environment yet.
+func (k *openChoreoSvcClient) UpdateAgentScaling(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+	if resources == nil && replicas == nil && autoscaling == nil {
+		return nil
+	}
+	component := &v1alpha1.Component{}
+	key := client.ObjectKey{
+		Name:      agentName,
+		Namespace: orgName,
+	}
+	err := k.retryK8sOperation(ctx, "GetComponentForScalingUpdate", func() error {
+		return k.client.Get(ctx, key, component)
+	})
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to get component for scaling update: %w", err)
+	}
+	if component.Spec.Owner.ProjectName != projName {
+		return utils.ErrAgentNotFound
+	}
+	if component.Spec.ComponentType == string(ComponentTypeInternalAgentJob) && (replicas != nil || autoscaling != nil) {
+		return fmt.Errorf("%w: replicas and autoscaling are not supported for %s agents", utils.ErrInvalidAgentScaling, utils.AgentTypeJob)
+	}
+
+	releaseBinding, err := k.getEnvironmentReleaseBinding(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		return err
+	}
+
+	overrides := map[string]interface{}{}
+	if releaseBinding.Spec.ComponentTypeEnvOverrides != nil && len(releaseBinding.Spec.ComponentTypeEnvOverrides.Raw) > 0 {
+		if err := json.Unmarshal(releaseBinding.Spec.ComponentTypeEnvOverrides.Raw, &overrides); err != nil {
+			return fmt.Errorf("error unmarshalling component type overrides: %w", err)
+		}
+	}
+	setScalingParameters(overrides, resources, replicas, autoscaling)
+	overridesJSON, err := json.Marshal(overrides)
+	if err != nil {
+		return fmt.Errorf("error marshalling component type overrides: %w", err)
+	}
+	releaseBinding.Spec.ComponentTypeEnvOverrides = &runtime.RawExtension{Raw: overridesJSON}
+
+	err = k.retryK8sOperation(ctx, "UpdateReleaseBinding", func() error {
+		return k.client.Update(ctx, releaseBinding)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update scaling of release binding %s: %w", releaseBinding.Name, err)
+	}
+	return nil
+}
+
+// updateComponentScaling writes resources and scaling into the component parameters, which an environment is
+// released with until its release binding overrides them
+func (k *openChoreoSvcClient) updateComponentScaling(ctx context.Context, orgName string, componentName string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+	component := &v1alpha1.Component{}
+	key := client.ObjectKey{
+		Name:      componentName,
+		Namespace: orgName,
+	}
+	err := k.retryK8sOperation(ctx, "GetComponentForScalingUpdate", func() error {
+		return k.client.Get(ctx, key, component)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get component for scaling update: %w", err)
+	}
+	if component.Spec.ComponentType == string(ComponentTypeInternalAgentJob) && (replicas != nil || autoscaling != nil) {
+		return fmt.Errorf("%w: replicas and autoscaling are not supported for %s agents", utils.ErrInvalidAgentScaling, utils.AgentTypeJob)
+	}
+
+	parameters := map[string]interface{}{}
+	if component.Spec.Parameters != nil && len(component.Spec.Parameters.Raw) > 0 {
+		if err := json.Unmarshal(component.Spec.Parameters.Raw, &parameters); err != nil {
+			return fmt.Errorf("error unmarshalling component parameters: %w", err)
+		}
+	}
+	setScalingParameters(parameters, resources, replicas, autoscaling)
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		return fmt.Errorf("error marshalling component parameters: %w", err)
+	}
+	component.Spec.Parameters = &runtime.RawExtension{Raw: parametersJSON}
+
+	err = k.retryK8sOperation(ctx, "UpdateComponentScaling", func() error {
+		return k.client.Update(ctx, component)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update component scaling: %w", err)
+	}
+	return nil
+}
+
+// setScalingParameters sets the given resources and scaling in component parameters or overrides. Settings that are
+// not given are left as they are.
+func setScalingParameters(parameters map[string]interface{}, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) {
+	if resources != nil {
+		parameters["resources"] = getResourceParameters(resources)
+	}
+	// A fixed replica count and autoscaling replace each other
+	if replicas != nil {
+		parameters["replicas"] = *replicas
+		parameters["autoscaling"] = getAutoscalingParameters(nil)
+	}
+	if autoscaling != nil {
+		parameters["autoscaling"] = getAutoscalingParameters(autoscaling)
+	}
+}
+
+// extractScalingFromEnvRelease reads the resources of the main container and the fixed replica count or autoscaling
+// an agent was released to an environment with. Jobs only have resources.
+func extractScalingFromEnvRelease(envRelease *v1alpha1.Release) (*models.ResourceRequirements, *int32, *models.AutoscalingConfig) {
+	if envRelease == nil {
+		return nil, nil, nil
+	}
+	var resources *models.ResourceRequirements
+	var replicas *int32
+	var autoscaling *models.AutoscalingConfig
+	for _, releaseResource := range envRelease.Spec.Resources {
+		if releaseResource.Object == nil || len(releaseResource.Object.Raw) == 0 {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal(releaseResource.Object.Raw, &obj); err != nil {
+			continue
+		}
+		switch obj.GetKind() {
+		case "Deployment":
+			resources = findMainContainerResources(obj.Object, "spec", "template", "spec", "containers")
+			if count, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas"); err == nil && found {
+				value := int32(count)
+				replicas = &value
+			}
+		case "CronJob":
+			resources = findMainContainerResources(obj.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+		case "HorizontalPodAutoscaler":
+			autoscaling = toAutoscalingConfig(obj.Object)
+		}
+	}
+	if autoscaling != nil {
+		replicas = nil
+	}
+	return resources, replicas, autoscaling
+}
+
+func findMainContainerResources(obj map[string]interface{}, containersPath ...string) *models.ResourceRequirements {
+	containers, found, err := unstructured.NestedSlice(obj, containersPath...)
+	if err != nil || !found {
+		return nil
+	}
+	for _, container := range containers {
+		containerMap, ok := container.(map[string]interface{})
+		if !ok || containerMap["name"] != MainContainerName {
+			continue
+		}
+		return &models.ResourceRequirements{
+			Requests: toResourceQuantity(containerMap, "resources", "requests"),
+			Limits:   toResourceQuantity(containerMap, "resources", "limits"),
+		}
+	}
+	return nil
+}
+
+func toResourceQuantity(obj map[string]interface{}, fields ...string) models.ResourceQuantity {
+	quantities, _, _ := unstructured.NestedMap(obj, fields...)
+	quantity := models.ResourceQuantity{}
+	if cpu, ok := quantities[string(utils.ResourceCPU)]; ok {
+		quantity.CPU = fmt.Sprint(cpu)
+	}
+	if memory, ok := quantities[string(utils.ResourceMemory)]; ok {
+		quantity.Memory = fmt.Sprint(memory)
+	}
+	return quantity
+}
+
+// toAutoscalingConfig reads the replica bounds and the CPU or concurrency targets of a HorizontalPodAutoscaler
+func toAutoscalingConfig(hpa map[string]interface{}) *models.AutoscalingConfig {
+	autoscaling := &models.AutoscalingConfig{}
+	if minReplicas, found, err := unstructured.NestedInt64(hpa, "spec", "minReplicas"); err == nil && found {
+		autoscaling.MinReplicas = int32(minReplicas)
+	}
+	if maxReplicas, found, err := unstructured.NestedInt64(hpa, "spec", "maxReplicas"); err == nil && found {
+		autoscaling.MaxReplicas = int32(maxReplicas)
+	}
+	metrics, _, _ := unstructured.NestedSlice(hpa, "spec", "metrics")
+	for _, metric := range metrics {
+		metricMap, ok := metric.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch metricMap["type"] {
+		case "Resource":
+			if utilization, found, err := unstructured.NestedInt64(metricMap, "resource", "target", "averageUtilization"); err == nil && found {
+				target := int32(utilization)
+				autoscaling.TargetCPUUtilizationPercentage = &target
+			}
+		case "Pods":
+			averageValue, found, err := unstructured.NestedFieldNoCopy(metricMap, "pods", "target", "averageValue")
+			if err != nil || !found {
+				continue
+			}
+			if quantity, err := resource.ParseQuantity(fmt.Sprint(averageValue)); err == nil {
+				target := int32(quantity.Value())
+				autoscaling.TargetConcurrency = &target
+			}
+		}
+	}
+	return autoscaling
+}
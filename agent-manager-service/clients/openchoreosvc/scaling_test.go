@@ -0,0 +1,121 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openchoreosvc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func TestComponentScalingParameters(t *testing.T) {
+	replicas := int32(3)
+	resources := &spec.ResourceRequirements{
+		Requests: &spec.ResourceQuantity{Cpu: spec.PtrString("250m"), Memory: spec.PtrString("1Gi")},
+		Limits:   &spec.ResourceQuantity{Cpu: spec.PtrString("1"), Memory: spec.PtrString("2Gi")},
+	}
+
+	tests := []struct {
+		name            string
+		agentType       spec.AgentType
+		runtimeConfigs  spec.RuntimeConfiguration
+		wantParameters  map[string]interface{}
+		wantNoParameter []string
+	}{
+		{
+			name:      "api agent with resources and replicas",
+			agentType: spec.AgentType{Type: string(utils.AgentTypeAPI), SubType: spec.PtrString(string(utils.AgentSubTypeChatAPI))},
+			runtimeConfigs: spec.RuntimeConfiguration{
+				Resources: resources,
+				Replicas:  &replicas,
+			},
+			wantParameters: map[string]interface{}{
+				"replicas":    float64(3),
+				"autoscaling": map[string]interface{}{"enabled": false},
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": "250m", "memory": "1Gi"},
+					"limits":   map[string]interface{}{"cpu": "1", "memory": "2Gi"},
+				},
+			},
+		},
+		{
+			name:      "api agent without resources and scaling",
+			agentType: spec.AgentType{Type: string(utils.AgentTypeAPI), SubType: spec.PtrString(string(utils.AgentSubTypeChatAPI))},
+			wantParameters: map[string]interface{}{
+				"replicas":    float64(DefaultReplicaCount),
+				"autoscaling": map[string]interface{}{"enabled": false},
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": DefaultCPURequest, "memory": DefaultMemoryRequest},
+					"limits":   map[string]interface{}{"cpu": DefaultCPULimit, "memory": DefaultMemoryLimit},
+				},
+			},
+		},
+		{
+			name:      "job agent with resources",
+			agentType: spec.AgentType{Type: string(utils.AgentTypeJob)},
+			runtimeConfigs: spec.RuntimeConfiguration{
+				Resources: resources,
+			},
+			wantParameters: map[string]interface{}{
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": "250m", "memory": "1Gi"},
+					"limits":   map[string]interface{}{"cpu": "1", "memory": "2Gi"},
+				},
+			},
+			wantNoParameter: []string{"replicas", "autoscaling"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runtimeConfigs := tt.runtimeConfigs
+			runtimeConfigs.Language = "python"
+			runtimeConfigs.LanguageVersion = spec.PtrString("3.11")
+			runtimeConfigs.RunCommand = spec.PtrString("python main.py")
+			req := &spec.CreateAgentRequest{
+				Name:        "scaling-agent",
+				DisplayName: "Scaling Agent",
+				Provisioning: spec.Provisioning{
+					Type: string(utils.InternalAgent),
+					Repository: &spec.RepositoryConfig{
+						Url:     "https://github.com/test/scaling-agent",
+						Branch:  "main",
+						AppPath: "agent",
+					},
+				},
+				AgentType:      tt.agentType,
+				RuntimeConfigs: &runtimeConfigs,
+			}
+
+			component, err := createComponentCRForInternalAgents("scaling-org", "scaling-project", req)
+			require.NoError(t, err)
+
+			var parameters map[string]interface{}
+			require.NoError(t, json.Unmarshal(component.Spec.Parameters.Raw, &parameters))
+			for name, want := range tt.wantParameters {
+				require.Equal(t, want, parameters[name], name)
+			}
+			for _, name := range tt.wantNoParameter {
+				require.NotContains(t, parameters, name)
+			}
+		})
+	}
+}
@@ -17,8 +17,15 @@
 package openchoreosvc
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
+
+	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 //go:embed default-openapi-schema.yaml
@@ -31,3 +38,38 @@ func GetDefaultChatAPISchema() (string, error) {
 	}
 	return defaultChatAPISchema, nil
 }
+
+// GetDeployedEndpointSchemas returns the schemas of the endpoints of an agent as released to an environment. They are
+// read from the component release bound to the environment, since the workload of the component itself always holds
+// the schemas of the latest build.
+func (k *openChoreoSvcClient) GetDeployedEndpointSchemas(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.DeployedEndpointSchema, error) {
+	releaseBinding, err := k.getEnvironmentReleaseBinding(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	releaseName := releaseBinding.Spec.ReleaseName
+	if releaseName == "" {
+		return nil, utils.ErrAgentNotDeployed
+	}
+
+	componentRelease := &v1alpha1.ComponentRelease{}
+	err = k.retryK8sOperation(ctx, "GetComponentRelease", func() error {
+		return k.client.Get(ctx, client.ObjectKey{Namespace: orgName, Name: releaseName}, componentRelease)
+	})
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, utils.ErrAgentNotDeployed
+		}
+		return nil, fmt.Errorf("failed to get component release %s: %w", releaseName, err)
+	}
+
+	schemas := make(map[string]models.DeployedEndpointSchema, len(componentRelease.Spec.Workload.Endpoints))
+	for endpointName, endpoint := range componentRelease.Spec.Workload.Endpoints {
+		schema := models.DeployedEndpointSchema{Type: getAgentEndpointType(endpoint.Type)}
+		if endpoint.Schema != nil {
+			schema.Content = endpoint.Schema.Content
+		}
+		schemas[endpointName] = schema
+	}
+	return schemas, nil
+}
@@ -21,8 +21,12 @@ import "time"
 type ComponentType string
 
 const (
-	ComponentTypeInternalAgentAPI ComponentType = "deployment/agent-api"
-	ComponentTypeExternalAgentAPI ComponentType = "proxy/external-agent-api"
+	ComponentTypeInternalAgentAPI  ComponentType = "deployment/agent-api"
+	ComponentTypeInternalMCPServer ComponentType = "deployment/mcp-server"
+	ComponentTypeInternalAgentJob  ComponentType = "cronjob/agent-job"
+	ComponentTypeExternalAgentAPI  ComponentType = "proxy/external-agent-api"
+	// Queue consumers run like agent APIs but are not exposed
+	ComponentTypeInternalQueueConsumer ComponentType = "deployment/agent-queue-consumer"
 )
 
 type TraitType string
@@ -50,6 +50,15 @@ func getOpenChoreoComponentType(provisioningType string, agentType string) Compo
 	if provisioningType == string(utils.InternalAgent) && agentType == string(utils.AgentTypeAPI) {
 		return ComponentTypeInternalAgentAPI
 	}
+	if provisioningType == string(utils.InternalAgent) && agentType == string(utils.AgentTypeMCPServer) {
+		return ComponentTypeInternalMCPServer
+	}
+	if provisioningType == string(utils.InternalAgent) && agentType == string(utils.AgentTypeJob) {
+		return ComponentTypeInternalAgentJob
+	}
+	if provisioningType == string(utils.InternalAgent) && agentType == string(utils.AgentTypeEventDriven) {
+		return ComponentTypeInternalQueueConsumer
+	}
 	// agent type is already validated in controller layer
 	return ""
 }
@@ -79,13 +88,106 @@ func isGoogleBuildpack(language string) bool {
 }
 
 func getInputInterfaceConfig(req *spec.CreateAgentRequest) (int32, string) {
+	// Jobs and event-driven agents do not serve requests
+	if req.AgentType.Type == string(utils.AgentTypeJob) || req.AgentType.Type == string(utils.AgentTypeEventDriven) {
+		return 0, ""
+	}
 	agentSubType := utils.StrPointerAsStr(req.AgentType.SubType, "")
 	if req.AgentType.Type == string(utils.AgentTypeAPI) && agentSubType == string(utils.AgentSubTypeChatAPI) {
 		return int32(config.GetConfig().DefaultChatAPI.DefaultHTTPPort), config.GetConfig().DefaultChatAPI.DefaultBasePath
 	}
+	if req.AgentType.Type == string(utils.AgentTypeMCPServer) {
+		port, basePath := req.InputInterface.Port, req.InputInterface.BasePath
+		if port == 0 {
+			port = config.GetConfig().MCPServer.DefaultHTTPPort
+		}
+		if basePath == "" {
+			basePath = "/"
+		}
+		return port, basePath
+	}
+	if agentSubType == string(utils.AgentSubTypeA2A) && req.InputInterface.BasePath == "" {
+		return req.InputInterface.Port, "/"
+	}
 	return req.InputInterface.Port, req.InputInterface.BasePath
 }
 
+// GetMCPTransportConfig returns the transport and the endpoint path, relative to the base path, of an MCP server agent.
+// Streamable HTTP is used when the request does not set a transport.
+func GetMCPTransportConfig(req *spec.CreateAgentRequest) (utils.MCPTransport, string) {
+	transport := utils.MCPTransportStreamableHTTP
+	path := ""
+	if req.InputInterface != nil && req.InputInterface.Transport != nil {
+		transport = utils.MCPTransport(req.InputInterface.Transport.Type)
+		path = utils.StrPointerAsStr(req.InputInterface.Transport.Path, "")
+	}
+	if path == "" {
+		path = config.GetConfig().MCPServer.DefaultStreamableHTTPPath
+		if transport == utils.MCPTransportSSE {
+			path = config.GetConfig().MCPServer.DefaultSSEPath
+		}
+	}
+	return transport, path
+}
+
+// GetQueueConfig returns the queue an event-driven agent consumes from, with the consumer group defaulting to the
+// agent name. It returns nil for agents that do not consume from a queue.
+func GetQueueConfig(req *spec.CreateAgentRequest) *models.QueueConfig {
+	if req.InputInterface == nil || req.InputInterface.Queue == nil {
+		return nil
+	}
+	queue := req.InputInterface.Queue
+	concurrency := int32(utils.DefaultQueueConcurrency)
+	if queue.HasConcurrency() {
+		concurrency = queue.GetConcurrency()
+	}
+	return &models.QueueConfig{
+		Broker:        queue.Broker,
+		URL:           queue.Url,
+		Topic:         queue.Topic,
+		ConsumerGroup: utils.StrPointerAsStr(queue.ConsumerGroup, req.Name),
+		Concurrency:   concurrency,
+	}
+}
+
+// getJobParameters returns the component parameters that control how a job agent runs, falling back to the
+// configured defaults. Jobs without a schedule are suspended so that they only run when triggered.
+func getJobParameters(jobConfig *spec.JobConfig) map[string]interface{} {
+	defaults := config.GetConfig().JobAgent
+	schedule, suspend := jobConfig.GetSchedule(), false
+	if schedule == "" {
+		schedule, suspend = JobManualOnlySchedule, true
+	}
+	timeoutSeconds := defaults.DefaultTimeoutSeconds
+	if jobConfig.HasTimeoutSeconds() {
+		timeoutSeconds = jobConfig.GetTimeoutSeconds()
+	}
+	retries := defaults.DefaultRetries
+	if jobConfig.HasRetries() {
+		retries = jobConfig.GetRetries()
+	}
+	concurrencyPolicy := defaults.DefaultConcurrencyPolicy
+	if jobConfig.HasConcurrencyPolicy() {
+		concurrencyPolicy = jobConfig.GetConcurrencyPolicy()
+	}
+	return map[string]interface{}{
+		"schedule":          schedule,
+		"suspend":           suspend,
+		"timeoutSeconds":    timeoutSeconds,
+		"retries":           retries,
+		"concurrencyPolicy": concurrencyPolicy,
+	}
+}
+
+// getSchemaFilePath returns the path of the OpenAPI or proto file describing the agent, which agents without an
+// input interface do not have
+func getSchemaFilePath(req *spec.CreateAgentRequest) string {
+	if req.InputInterface == nil {
+		return ""
+	}
+	return req.InputInterface.Schema.Path
+}
+
 func getComponentWorkflowParametersForGoogleBuildPack(req *spec.CreateAgentRequest) map[string]interface{} {
 	return map[string]interface{}{
 		"buildpackConfigs": map[string]interface{}{
@@ -93,13 +195,13 @@ func getComponentWorkflowParametersForGoogleBuildPack(req *spec.CreateAgentReque
 			"languageVersion":    req.RuntimeConfigs.LanguageVersion,
 			"languageVersionKey": getLanguageVersionEnvVariable(req.RuntimeConfigs.Language),
 		},
-		"schemaFilePath": req.InputInterface.Schema.Path,
+		"schemaFilePath": getSchemaFilePath(req),
 	}
 }
 
 func getComponentWorkflowParametersForBallerinaBuildPack(req *spec.CreateAgentRequest) map[string]interface{} {
 	return map[string]interface{}{
-		"schemaFilePath": req.InputInterface.Schema.Path,
+		"schemaFilePath": getSchemaFilePath(req),
 	}
 }
 
@@ -149,22 +251,42 @@ func createComponentCRForInternalAgents(orgName, projectName string, req *spec.C
 	componentWorkflow := getOpenChoreoComponentWorkflow(req.RuntimeConfigs.Language)
 	containerPort, basePath := getInputInterfaceConfig(req)
 
-	// Create parameters as RawExtension
+	// Create parameters as RawExtension. The resources and scaling of the request are the values the agent is first
+	// released with; each environment then overrides them in its release binding.
 	parameters := map[string]interface{}{
-		"exposed":  true,
-		"replicas": DefaultReplicaCount,
-		"port":     containerPort,
-		"resources": map[string]interface{}{
-			"requests": map[string]string{
-				"cpu":    DefaultCPURequest,
-				"memory": DefaultMemoryRequest,
-			},
-			"limits": map[string]string{
-				"cpu":    DefaultCPULimit,
-				"memory": DefaultMemoryLimit,
-			},
-		},
-		"basePath": basePath,
+		"exposed":     true,
+		"replicas":    DefaultReplicaCount,
+		"autoscaling": getAutoscalingParameters(nil),
+		"port":        containerPort,
+		"resources":   getResourceParameters(nil),
+		"probes":      getProbeParameters(req.RuntimeConfigs.Probes),
+		"basePath":    basePath,
+	}
+	setScalingParameters(parameters, req.RuntimeConfigs.Resources, req.RuntimeConfigs.Replicas, req.RuntimeConfigs.Autoscaling)
+	// Endpoints of api agents besides the primary one, each with its own service port and route
+	if req.AgentType.Type == string(utils.AgentTypeAPI) {
+		parameters["endpointType"] = getPrimaryEndpointType(req)
+		parameters["endpoints"] = getEndpointParameters(req.Endpoints)
+	}
+	if req.AgentType.Type == string(utils.AgentTypeMCPServer) {
+		mcpTransport, mcpPath := GetMCPTransportConfig(req)
+		parameters["mcpTransport"] = string(mcpTransport)
+		parameters["mcpPath"] = mcpPath
+	}
+	// Queue consumers are replicated like agent APIs but have no port to expose
+	if req.AgentType.Type == string(utils.AgentTypeEventDriven) {
+		parameters = map[string]interface{}{
+			"replicas":    parameters["replicas"],
+			"autoscaling": parameters["autoscaling"],
+			"resources":   parameters["resources"],
+			"probes":      parameters["probes"],
+		}
+	}
+	// Jobs are neither exposed nor replicated, so they only take the resources and the job settings
+	if req.AgentType.Type == string(utils.AgentTypeJob) {
+		jobParameters := getJobParameters(req.JobConfig)
+		jobParameters["resources"] = parameters["resources"]
+		parameters = jobParameters
 	}
 
 	var componentWorkflowParameters map[string]interface{}
@@ -223,6 +345,54 @@ func createComponentCRForInternalAgents(orgName, projectName string, req *spec.C
 	return componentCR, nil
 }
 
+// getEndpointParameters returns the component parameters of the endpoints an api agent exposes besides its primary
+// endpoint. Only public endpoints are routed through the gateway.
+func getEndpointParameters(endpoints []spec.AgentEndpoint) []map[string]interface{} {
+	parameters := make([]map[string]interface{}, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		visibility := utils.StrPointerAsStr(endpoint.Visibility, string(utils.EndpointVisibilityPublic))
+		parameters = append(parameters, map[string]interface{}{
+			"name":     endpoint.Name,
+			"port":     endpoint.Port,
+			"type":     endpoint.Type,
+			"basePath": utils.StrPointerAsStr(endpoint.BasePath, "/"),
+			"exposed":  visibility == string(utils.EndpointVisibilityPublic),
+		})
+	}
+	return parameters
+}
+
+// getPrimaryEndpointType returns the type of the endpoint described by the input interface of an api agent.
+// Chat agents are always served over HTTP.
+func getPrimaryEndpointType(req *spec.CreateAgentRequest) string {
+	if req.InputInterface == nil || req.InputInterface.Type == "" {
+		return string(utils.InputInterfaceTypeHTTP)
+	}
+	return req.InputInterface.Type
+}
+
+// GetWorkloadEndpointType returns the OpenChoreo endpoint type an agent endpoint of the given type is served as
+func GetWorkloadEndpointType(endpointType string) v1alpha1.EndpointType {
+	switch utils.InputInterfaceType(endpointType) {
+	case utils.InputInterfaceTypeGRPC:
+		return v1alpha1.EndpointTypeGRPC
+	case utils.InputInterfaceTypeWebSocket:
+		return v1alpha1.EndpointTypeWebsocket
+	}
+	return v1alpha1.EndpointType(endpointType)
+}
+
+// getAgentEndpointType returns the agent endpoint type of an OpenChoreo workload endpoint
+func getAgentEndpointType(endpointType v1alpha1.EndpointType) string {
+	switch endpointType {
+	case v1alpha1.EndpointTypeGRPC:
+		return string(utils.InputInterfaceTypeGRPC)
+	case v1alpha1.EndpointTypeWebsocket:
+		return string(utils.InputInterfaceTypeWebSocket)
+	}
+	return string(utils.InputInterfaceTypeHTTP)
+}
+
 func createOTELInstrumentationTrait(ocAgentComponent *v1alpha1.Component, envUUID, projectUUID string) (*v1alpha1.ComponentTrait, error) {
 	traitParameters := map[string]interface{}{
 		"instrumentationImage":  getInstrumentationImage(ocAgentComponent.Labels[string(LabelKeyAgentLanguageVersion)]),
@@ -323,21 +493,23 @@ func toComponentResponse(component *v1alpha1.Component) *AgentComponent {
 }
 
 func updateWorkloadSpec(existingWorkload *v1alpha1.Workload, req *spec.DeployAgentRequest) {
-	var envs []v1alpha1.EnvVar
+	// The env is only replaced when the request sets it, so that a redeploy of a new image keeps the configuration
+	envs := existingWorkload.Spec.Containers[MainContainerName].Env
+	if req.Env != nil {
+		envs = nil
+		for _, env := range req.Env {
+			envs = append(envs, v1alpha1.EnvVar{
+				Key:   env.Key,
+				Value: env.Value,
+			})
+		}
+	}
 
 	// Keep existing endpoints and just update container spec
 	existingWorkload.Spec.Containers = map[string]v1alpha1.Container{
-		"main": {
+		MainContainerName: {
 			Image: req.ImageId,
-			Env: func() []v1alpha1.EnvVar {
-				for _, env := range req.Env {
-					envs = append(envs, v1alpha1.EnvVar{
-						Key:   env.Key,
-						Value: env.Value,
-					})
-				}
-				return envs
-			}(),
+			Env:   envs,
 		},
 	}
 }
@@ -446,7 +618,7 @@ func toDeploymentDetailsResponse(binding *v1alpha1.ReleaseBinding, envRelease *v
 	}
 
 	// Extract deployment status from Release Binding
-	status := determineReleaseBindingStatus(binding)
+	status := determineReleaseBindingStatus(binding, envRelease)
 
 	// Extract last deployed time from conditions
 	var lastDeployedTime time.Time
@@ -461,6 +633,7 @@ func toDeploymentDetailsResponse(binding *v1alpha1.ReleaseBinding, envRelease *v
 	}
 	// Extract deployed image from EnvRelease status
 	deployedImage := findDeployedImageFromEnvRelease(envRelease)
+	resources, replicas, autoscaling := extractScalingFromEnvRelease(envRelease)
 
 	environment := binding.Spec.Environment
 	// Get environment display name
@@ -477,10 +650,13 @@ func toDeploymentDetailsResponse(binding *v1alpha1.ReleaseBinding, envRelease *v
 		PromotionTargetEnvironment: promotionTargetEnv,
 		LastDeployedAt:             lastDeployedTime,
 		Endpoints:                  endpoints,
+		Resources:                  resources,
+		Replicas:                   replicas,
+		Autoscaling:                autoscaling,
 	}, nil
 }
 
-func determineReleaseBindingStatus(binding *v1alpha1.ReleaseBinding) string {
+func determineReleaseBindingStatus(binding *v1alpha1.ReleaseBinding, envRelease *v1alpha1.Release) string {
 	if len(binding.Status.Conditions) == 0 {
 		return DeploymentStatusNotDeployed
 	}
@@ -504,6 +680,10 @@ func determineReleaseBindingStatus(binding *v1alpha1.ReleaseBinding) string {
 	// Check if any condition has Status == False with ResourcesDegraded reason
 	for i := range conditionsForGeneration {
 		if conditionsForGeneration[i].Status == metav1.ConditionFalse && conditionsForGeneration[i].Reason == "ResourcesDegraded" {
+			// Replicas that fail their probes are not ready rather than failed
+			if hasFailingProbes(envRelease, time.Now()) {
+				return DeploymentStatusNotReady
+			}
 			return DeploymentStatusFailed
 		}
 	}
@@ -511,6 +691,10 @@ func determineReleaseBindingStatus(binding *v1alpha1.ReleaseBinding) string {
 	// Check if any condition has Status == False with ResourcesProgressing reason
 	for i := range conditionsForGeneration {
 		if conditionsForGeneration[i].Status == metav1.ConditionFalse && conditionsForGeneration[i].Reason == "ResourcesProgressing" {
+			// A deployment whose replicas fail their probes is reported as progressing, whether or not it has rolled out
+			if hasFailingProbes(envRelease, time.Now()) {
+				return DeploymentStatusNotReady
+			}
 			return DeploymentStatusInProgress
 		}
 	}
@@ -567,7 +751,9 @@ func extractEndpointURLFromEnvRelease(envRelease *v1alpha1.Release) ([]models.En
 			url = fmt.Sprintf("http://%s:%d%s", hostname, port, pathValue)
 		}
 
+		// Routes of additional endpoints carry the endpoint name, the route of the primary endpoint does not
 		endpoints = append(endpoints, models.Endpoint{
+			Name:       obj.GetAnnotations()[string(AnnotationKeyEndpointName)],
 			URL:        url,
 			Visibility: "Public",
 		})
@@ -0,0 +1,60 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queuesvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// QueueClient reads the state of the consumer groups of event-driven agents from their brokers
+type QueueClient interface {
+	GetConsumerLag(ctx context.Context, queue models.QueueConfig) (int64, error)
+}
+
+type queueClient struct{}
+
+func NewQueueClient() QueueClient {
+	return &queueClient{}
+}
+
+// GetConsumerLag returns the number of messages on the topic of a queue that its consumer group has not processed,
+// counting messages that were delivered but not yet acknowledged or committed
+func (c *queueClient) GetConsumerLag(ctx context.Context, queue models.QueueConfig) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.GetConfig().QueueConsumer.LagTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	var lag int64
+	var err error
+	switch utils.QueueBroker(queue.Broker) {
+	case utils.QueueBrokerNATS:
+		lag, err = natsConsumerLag(ctx, queue)
+	case utils.QueueBrokerKafka:
+		lag, err = kafkaConsumerLag(ctx, queue)
+	default:
+		return 0, fmt.Errorf("queuesvc.GetConsumerLag: unsupported broker %q", queue.Broker)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("queuesvc.GetConsumerLag: %w", err)
+	}
+	return lag, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queuesvc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// brokerDialer connects to brokers on the allowed broker hosts only. Every connection goes through it, including the
+// ones to the cluster members a broker advertises, so a queue URL cannot point the service at an arbitrary address.
+type brokerDialer struct {
+	allowedHosts []string
+	dialer       net.Dialer
+}
+
+func newBrokerDialer() *brokerDialer {
+	queueConfig := config.GetConfig().QueueConsumer
+	return &brokerDialer{
+		allowedHosts: queueConfig.AllowedBrokerHosts,
+		dialer:       net.Dialer{Timeout: time.Duration(queueConfig.ConnectTimeoutSeconds) * time.Second},
+	}
+}
+
+// DialContext dials a broker address after checking its host against the allowed broker hosts
+func (d *brokerDialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid broker address %s: %w", address, err)
+	}
+	if !isBrokerHostAllowed(host, d.allowedHosts) {
+		return nil, fmt.Errorf("broker host %s is not allowed", host)
+	}
+	return d.dialer.DialContext(ctx, network, address)
+}
+
+// Dial implements the custom dialer of the NATS client, which does not pass a context
+func (d *brokerDialer) Dial(network string, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// isBrokerHostAllowed reports whether a host matches one of the allowed hosts. A wildcard entry such as
+// *.kafka.svc.cluster.local matches its subdomains but not the domain itself.
+func isBrokerHostAllowed(host string, allowedHosts []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, allowed := range allowedHosts {
+		allowed = strings.ToLower(strings.TrimSuffix(allowed, "."))
+		if domain, ok := strings.CutPrefix(allowed, "*."); ok {
+			if strings.HasSuffix(host, "."+domain) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
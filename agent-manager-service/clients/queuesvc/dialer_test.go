@@ -0,0 +1,61 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queuesvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBrokerHostAllowed(t *testing.T) {
+	allowedHosts := []string{"nats.messaging", "*.kafka.svc.cluster.local", "10.0.0.12"}
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "nats.messaging", want: true},
+		{host: "NATS.Messaging.", want: true},
+		{host: "broker-0.kafka.svc.cluster.local", want: true},
+		{host: "10.0.0.12", want: true},
+		{host: "kafka.svc.cluster.local", want: false},
+		{host: "evilkafka.svc.cluster.local", want: false},
+		{host: "nats.messaging.example.com", want: false},
+		{host: "169.254.169.254", want: false},
+		{host: "localhost", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			require.Equal(t, tt.want, isBrokerHostAllowed(tt.host, allowedHosts))
+		})
+	}
+}
+
+func TestBrokerDialerRefusesHostsThatAreNotAllowed(t *testing.T) {
+	dialer := &brokerDialer{allowedHosts: []string{"nats.messaging"}}
+
+	_, err := dialer.DialContext(context.Background(), "tcp", "169.254.169.254:80")
+	require.EqualError(t, err, "broker host 169.254.169.254 is not allowed")
+
+	// Without an allow-list no broker is connected to
+	dialer = &brokerDialer{}
+	_, err = dialer.Dial("tcp", "nats.messaging:4222")
+	require.EqualError(t, err, "broker host nats.messaging is not allowed")
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queuesvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// kafkaConsumerLag sums, over the partitions of the topic, the distance from the committed offset of the consumer
+// group to the end of the partition. Partitions the group has not committed to count from their first offset.
+func kafkaConsumerLag(ctx context.Context, queue models.QueueConfig) (int64, error) {
+	var brokers []string
+	for _, broker := range strings.Split(queue.URL, ",") {
+		brokers = append(brokers, strings.TrimSpace(broker))
+	}
+	dialer := newBrokerDialer()
+	client := &kafka.Client{
+		Addr:      kafka.TCP(brokers...),
+		Transport: &kafka.Transport{Dial: dialer.DialContext, DialTimeout: dialer.dialer.Timeout},
+	}
+
+	metadata, err := client.Metadata(ctx, &kafka.MetadataRequest{Topics: []string{queue.Topic}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+	if len(metadata.Topics) == 0 {
+		return 0, fmt.Errorf("topic %s does not exist", queue.Topic)
+	}
+	if metadata.Topics[0].Error != nil {
+		return 0, fmt.Errorf("failed to get metadata of topic %s: %w", queue.Topic, metadata.Topics[0].Error)
+	}
+	var partitions []int
+	var offsetRequests []kafka.OffsetRequest
+	for _, partition := range metadata.Topics[0].Partitions {
+		partitions = append(partitions, partition.ID)
+		offsetRequests = append(offsetRequests, kafka.FirstOffsetOf(partition.ID), kafka.LastOffsetOf(partition.ID))
+	}
+
+	offsets, err := client.ListOffsets(ctx, &kafka.ListOffsetsRequest{
+		Topics: map[string][]kafka.OffsetRequest{queue.Topic: offsetRequests},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list offsets of topic %s: %w", queue.Topic, err)
+	}
+	committed, err := client.OffsetFetch(ctx, &kafka.OffsetFetchRequest{
+		GroupID: queue.ConsumerGroup,
+		Topics:  map[string][]int{queue.Topic: partitions},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch offsets of consumer group %s: %w", queue.ConsumerGroup, err)
+	}
+	if committed.Error != nil {
+		return 0, fmt.Errorf("failed to fetch offsets of consumer group %s: %w", queue.ConsumerGroup, committed.Error)
+	}
+	committedOffsets := make(map[int]int64)
+	for _, partition := range committed.Topics[queue.Topic] {
+		if partition.Error != nil {
+			return 0, fmt.Errorf("failed to fetch offset of partition %d: %w", partition.Partition, partition.Error)
+		}
+		committedOffsets[partition.Partition] = partition.CommittedOffset
+	}
+
+	var lag int64
+	for _, partition := range offsets.Topics[queue.Topic] {
+		if partition.Error != nil {
+			return 0, fmt.Errorf("failed to list offsets of partition %d: %w", partition.Partition, partition.Error)
+		}
+		// Offsets are -1 when the group has never committed to the partition
+		start, ok := committedOffsets[partition.Partition]
+		if !ok || start < 0 {
+			start = partition.FirstOffset
+		}
+		if partition.LastOffset > start {
+			lag += partition.LastOffset - start
+		}
+	}
+	return lag, nil
+}
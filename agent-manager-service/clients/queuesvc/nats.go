@@ -0,0 +1,68 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queuesvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// natsConsumerLag reads the lag of the durable JetStream consumer named after the consumer group, on the stream
+// that captures the subject of the queue
+func natsConsumerLag(ctx context.Context, queue models.QueueConfig) (int64, error) {
+	dialer := newBrokerDialer()
+	nc, err := nats.Connect(queue.URL,
+		nats.Name("agent-manager-service"),
+		nats.SetCustomDialer(dialer),
+		nats.Timeout(dialer.dialer.Timeout),
+		nats.NoReconnect(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	defer nc.Close()
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+	stream, err := js.StreamNameBySubject(ctx, queue.Topic)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrStreamNotFound) {
+			return 0, fmt.Errorf("no JetStream stream captures subject %s", queue.Topic)
+		}
+		return 0, fmt.Errorf("failed to find stream for subject %s: %w", queue.Topic, err)
+	}
+	consumer, err := js.Consumer(ctx, stream, queue.ConsumerGroup)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrConsumerNotFound) {
+			return 0, fmt.Errorf("consumer %s does not exist on stream %s", queue.ConsumerGroup, stream)
+		}
+		return 0, fmt.Errorf("failed to get consumer %s: %w", queue.ConsumerGroup, err)
+	}
+	info, err := consumer.Info(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get consumer info: %w", err)
+	}
+	return int64(info.NumPending) + int64(info.NumAckPending), nil
+}
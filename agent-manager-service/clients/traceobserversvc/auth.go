@@ -0,0 +1,91 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traceobserversvc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// queryTokenTTL is how long a query token is valid for. Tokens are minted per request, and streams are only
+// authenticated when they are opened, so the token only has to outlive the request being sent.
+const queryTokenTTL = 2 * time.Minute
+
+// queryScope lists the components and projects a query reads. Callers must only build a scope from UIDs that
+// were resolved for an organization the user is authorized for, as a query token grants access to all of them.
+type queryScope struct {
+	ComponentUIDs []string
+	ProjectUIDs   []string
+}
+
+// queryTokenClaims are the claims the traces-observer-service authorizes queries with
+type queryTokenClaims struct {
+	Issuer        string   `json:"iss"`
+	Audience      string   `json:"aud"`
+	IssuedAt      int64    `json:"iat"`
+	ExpiresAt     int64    `json:"exp"`
+	ComponentUIDs []string `json:"componentUids,omitempty"`
+	ProjectUIDs   []string `json:"projectUids,omitempty"`
+}
+
+// authorizationHeader returns the Authorization header value queries of the given scope are sent with,
+// or an empty string when the traces-observer-service does not require authentication
+func authorizationHeader(cfg config.TraceObserverConfig, scope queryScope) (string, error) {
+	switch cfg.AuthMode {
+	case config.TraceObserverAuthModeSharedSecret:
+		return "Bearer " + cfg.SharedSecret, nil
+	case config.TraceObserverAuthModeJWT:
+		now := time.Now()
+		token, err := signQueryToken(queryTokenClaims{
+			Issuer:        "agent-manager",
+			Audience:      cfg.JWTAudience,
+			IssuedAt:      now.Unix(),
+			ExpiresAt:     now.Add(queryTokenTTL).Unix(),
+			ComponentUIDs: scope.ComponentUIDs,
+			ProjectUIDs:   scope.ProjectUIDs,
+		}, []byte(cfg.JWTSigningKey))
+		if err != nil {
+			return "", fmt.Errorf("failed to sign query token: %w", err)
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", nil
+	}
+}
+
+// signQueryToken creates an HS256 signed JWT with the given claims
+func signQueryToken(claims queryTokenClaims, signingKey []byte) (string, error) {
+	headerJSON, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -18,24 +18,38 @@ package traceobserversvc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/requests"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // TraceObserverClient interface defines methods for interacting with the traces-observer-service
 type TraceObserverClient interface {
 	ListTraces(ctx context.Context, params ListTracesParams) (*TraceOverviewResponse, error)
 	TraceDetailsById(ctx context.Context, params TraceDetailsByIdParams) (*TraceResponse, error)
+	GetTokenUsage(ctx context.Context, params TokenUsageParams) (*TokenUsageResponse, error)
+	ListSessions(ctx context.Context, params ListSessionsParams) (*SessionOverviewResponse, error)
+	GetSessionTraces(ctx context.Context, params SessionTracesParams) (*SessionTracesResponse, error)
+	GetTraceTree(ctx context.Context, params TraceTreeParams) (*TraceTreeResponse, error)
+	ExportTrace(ctx context.Context, params ExportTraceParams) (json.RawMessage, error)
+	ExportTraces(ctx context.Context, params ExportTracesParams) (io.ReadCloser, error)
+	TailTraces(ctx context.Context, params TailTracesParams) (io.ReadCloser, error)
 }
 
 type traceObserverClient struct {
 	httpClient requests.HttpClient
+	// streamClient has no overall timeout as bulk exports and live tails are streamed for as long as the caller's context allows
+	streamClient requests.HttpClient
 }
 
 // NewTraceObserverClient creates a new trace observer client
@@ -44,7 +58,8 @@ func NewTraceObserverClient() TraceObserverClient {
 		Timeout: time.Second * 15,
 	}
 	return &traceObserverClient{
-		httpClient: httpClient,
+		httpClient:   httpClient,
+		streamClient: &http.Client{},
 	}
 }
 
@@ -72,15 +87,16 @@ func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesP
 	if params.SortOrder != "" {
 		queryParams.Set("sortOrder", params.SortOrder)
 	}
+	if len(params.TraceIDs) > 0 {
+		queryParams.Set("traceIds", strings.Join(params.TraceIDs, ","))
+	}
 
 	fullURL := fmt.Sprintf("%s?%s", tracesURL, queryParams.Encode())
 
-	req := &requests.HttpRequest{
-		Name:   "traceobserver.ListTraces",
-		URL:    fullURL,
-		Method: http.MethodGet,
+	req, err := newQueryRequest("traceobserver.ListTraces", fullURL, queryScope{})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.ListTraces: %w", err)
 	}
-	req.SetHeader("Accept", "application/json")
 
 	var response TraceOverviewResponse
 	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
@@ -102,12 +118,10 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 
 	fullURL := fmt.Sprintf("%s?%s", traceURL, queryParams.Encode())
 
-	req := &requests.HttpRequest{
-		Name:   "traceobserver.TraceDetailsById",
-		URL:    fullURL,
-		Method: http.MethodGet,
+	req, err := newQueryRequest("traceobserver.TraceDetailsById", fullURL, queryScope{})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.TraceDetailsById: %w", err)
 	}
-	req.SetHeader("Accept", "application/json")
 
 	var response TraceResponse
 	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
@@ -116,3 +130,278 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 
 	return &response, nil
 }
+
+// GetTokenUsage retrieves GenAI token usage grouped by project, component and model from the traces-observer-service
+func (c *traceObserverClient) GetTokenUsage(ctx context.Context, params TokenUsageParams) (*TokenUsageResponse, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	usageURL := fmt.Sprintf("%s/api/v1/usage", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("startTime", params.StartTime)
+	queryParams.Set("endTime", params.EndTime)
+	for _, projectUID := range params.ProjectUIDs {
+		queryParams.Add("projectUid", projectUID)
+	}
+	for _, componentUID := range params.ComponentUIDs {
+		queryParams.Add("componentUid", componentUID)
+	}
+	if params.EnvironmentUID != "" {
+		queryParams.Set("environmentUid", params.EnvironmentUID)
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", usageURL, queryParams.Encode())
+
+	req, err := newQueryRequest("traceobserver.GetTokenUsage", fullURL, queryScope{ComponentUIDs: params.ComponentUIDs, ProjectUIDs: params.ProjectUIDs})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.GetTokenUsage: %w", err)
+	}
+
+	var response TokenUsageResponse
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("traceobserver.GetTokenUsage: %w", err)
+	}
+
+	return &response, nil
+}
+
+// ListSessions retrieves the conversations of a component from the traces-observer-service
+func (c *traceObserverClient) ListSessions(ctx context.Context, params ListSessionsParams) (*SessionOverviewResponse, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	sessionsURL := fmt.Sprintf("%s/api/v1/sessions", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("componentUid", params.ComponentUID)
+	queryParams.Set("environmentUid", params.EnvironmentUID)
+	queryParams.Set("startTime", params.StartTime)
+	queryParams.Set("endTime", params.EndTime)
+	if params.Limit > 0 {
+		queryParams.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset > 0 {
+		queryParams.Set("offset", strconv.Itoa(params.Offset))
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", sessionsURL, queryParams.Encode())
+
+	req, err := newQueryRequest("traceobserver.ListSessions", fullURL, queryScope{ComponentUIDs: []string{params.ComponentUID}})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.ListSessions: %w", err)
+	}
+
+	var response SessionOverviewResponse
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("traceobserver.ListSessions: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSessionTraces retrieves the traces of a session in chronological order from the traces-observer-service
+func (c *traceObserverClient) GetSessionTraces(ctx context.Context, params SessionTracesParams) (*SessionTracesResponse, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	sessionURL := fmt.Sprintf("%s/api/v1/session", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("sessionId", params.SessionID)
+	queryParams.Set("componentUid", params.ComponentUID)
+	queryParams.Set("environmentUid", params.EnvironmentUID)
+	if params.StartTime != "" {
+		queryParams.Set("startTime", params.StartTime)
+	}
+	if params.EndTime != "" {
+		queryParams.Set("endTime", params.EndTime)
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", sessionURL, queryParams.Encode())
+
+	req, err := newQueryRequest("traceobserver.GetSessionTraces", fullURL, queryScope{ComponentUIDs: []string{params.ComponentUID}})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.GetSessionTraces: %w", err)
+	}
+
+	var response SessionTracesResponse
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("traceobserver.GetSessionTraces: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetTraceTree retrieves a trace as a span tree from the traces-observer-service
+func (c *traceObserverClient) GetTraceTree(ctx context.Context, params TraceTreeParams) (*TraceTreeResponse, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	traceURL := fmt.Sprintf("%s/api/v1/trace", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("traceId", params.TraceID)
+	queryParams.Set("componentUid", params.ComponentUID)
+	queryParams.Set("environmentUid", params.EnvironmentUID)
+	queryParams.Set("view", "tree")
+
+	fullURL := fmt.Sprintf("%s?%s", traceURL, queryParams.Encode())
+
+	req, err := newQueryRequest("traceobserver.GetTraceTree", fullURL, queryScope{ComponentUIDs: []string{params.ComponentUID}})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.GetTraceTree: %w", err)
+	}
+
+	var response TraceTreeResponse
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("traceobserver.GetTraceTree: %w", err)
+	}
+
+	return &response, nil
+}
+
+// ExportTrace retrieves a trace converted to the requested export format from the traces-observer-service
+func (c *traceObserverClient) ExportTrace(ctx context.Context, params ExportTraceParams) (json.RawMessage, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	exportURL := fmt.Sprintf("%s/api/v1/trace/export", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("traceId", params.TraceID)
+	queryParams.Set("componentUid", params.ComponentUID)
+	queryParams.Set("environmentUid", params.EnvironmentUID)
+	if params.Format != "" {
+		queryParams.Set("format", params.Format)
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", exportURL, queryParams.Encode())
+
+	req, err := newQueryRequest("traceobserver.ExportTrace", fullURL, queryScope{ComponentUIDs: []string{params.ComponentUID}})
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.ExportTrace: %w", err)
+	}
+
+	var response json.RawMessage
+	if err := requests.SendRequest(ctx, c.httpClient, req).ScanResponse(&response, http.StatusOK); err != nil {
+		var httpErr *requests.HttpError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, utils.ErrTraceNotFound
+		}
+		return nil, fmt.Errorf("traceobserver.ExportTrace: %w", err)
+	}
+
+	return response, nil
+}
+
+// ExportTraces opens an NDJSON stream of the traces of a component in a time range from the traces-observer-service.
+// The caller must close the returned reader.
+func (c *traceObserverClient) ExportTraces(ctx context.Context, params ExportTracesParams) (io.ReadCloser, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	exportURL := fmt.Sprintf("%s/api/v1/traces/export", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("componentUid", params.ComponentUID)
+	queryParams.Set("environmentUid", params.EnvironmentUID)
+	queryParams.Set("startTime", params.StartTime)
+	queryParams.Set("endTime", params.EndTime)
+	if params.Format != "" {
+		queryParams.Set("format", params.Format)
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", exportURL, queryParams.Encode())
+
+	// The response is streamed to the caller, so it is not read into memory or retried like other requests
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.ExportTraces: failed to build http request: %w", err)
+	}
+	if err := setAuthorization(httpReq, queryScope{ComponentUIDs: []string{params.ComponentUID}}); err != nil {
+		return nil, fmt.Errorf("traceobserver.ExportTraces: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/x-ndjson")
+
+	resp, err := c.streamClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.ExportTraces: request failed with: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("traceobserver.ExportTraces: %w", &requests.HttpError{
+			StatusCode: resp.StatusCode,
+			Body:       string(body),
+		})
+	}
+
+	return resp.Body, nil
+}
+
+// TailTraces opens a server-sent event stream of new traces of a component from the traces-observer-service.
+// The stream ends when ctx is done. The caller must close the returned reader.
+func (c *traceObserverClient) TailTraces(ctx context.Context, params TailTracesParams) (io.ReadCloser, error) {
+	baseURL := config.GetConfig().TraceObserver.URL
+	tailURL := fmt.Sprintf("%s/api/v1/traces/tail", baseURL)
+
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Set("componentUid", params.ComponentUID)
+	queryParams.Set("environmentUid", params.EnvironmentUID)
+	for _, attribute := range params.Attributes {
+		queryParams.Add("attribute", attribute)
+	}
+
+	fullURL := fmt.Sprintf("%s?%s", tailURL, queryParams.Encode())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.TailTraces: failed to build http request: %w", err)
+	}
+	if err := setAuthorization(httpReq, queryScope{ComponentUIDs: []string{params.ComponentUID}}); err != nil {
+		return nil, fmt.Errorf("traceobserver.TailTraces: %w", err)
+	}
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.streamClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("traceobserver.TailTraces: request failed with: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("traceobserver.TailTraces: %w", &requests.HttpError{
+			StatusCode: resp.StatusCode,
+			Body:       string(body),
+		})
+	}
+
+	return resp.Body, nil
+}
+
+// newQueryRequest builds a JSON query request to the traces-observer-service, authenticated for the given scope
+func newQueryRequest(name string, fullURL string, scope queryScope) (*requests.HttpRequest, error) {
+	authHeader, err := authorizationHeader(config.GetConfig().TraceObserver, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &requests.HttpRequest{
+		Name:   name,
+		URL:    fullURL,
+		Method: http.MethodGet,
+	}
+	req.SetHeader("Accept", "application/json")
+	if authHeader != "" {
+		req.SetHeader("Authorization", authHeader)
+	}
+	return req, nil
+}
+
+// setAuthorization authenticates a streamed request to the traces-observer-service for the given scope
+func setAuthorization(httpReq *http.Request, scope queryScope) error {
+	authHeader, err := authorizationHeader(config.GetConfig().TraceObserver, scope)
+	if err != nil {
+		return err
+	}
+	if authHeader != "" {
+		httpReq.Header.Set("Authorization", authHeader)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ type ListTracesParams struct {
 	Limit       int
 	Offset      int
 	SortOrder   string
+	TraceIDs    []string // Only these traces
 }
 
 // TraceDetailsByIdParams holds parameters for getting trace details by ID
@@ -34,6 +35,65 @@ type TraceDetailsByIdParams struct {
 	ServiceName string
 }
 
+// TraceTreeParams holds parameters for getting a trace as a span tree
+type TraceTreeParams struct {
+	TraceID        string
+	ComponentUID   string
+	EnvironmentUID string
+}
+
+// ExportTraceParams holds parameters for exporting a single trace
+type ExportTraceParams struct {
+	TraceID        string
+	ComponentUID   string
+	EnvironmentUID string
+	Format         string
+}
+
+// ExportTracesParams holds parameters for exporting all traces of a component in a time range
+type ExportTracesParams struct {
+	ComponentUID   string
+	EnvironmentUID string
+	StartTime      string
+	EndTime        string
+	Format         string
+}
+
+// TailTracesParams holds parameters for opening a live tail of the traces of a component
+type TailTracesParams struct {
+	ComponentUID   string
+	EnvironmentUID string
+	Attributes     []string // Root span attribute filters in key=value form
+}
+
+// ListSessionsParams holds parameters for listing sessions of a component
+type ListSessionsParams struct {
+	ComponentUID   string
+	EnvironmentUID string
+	StartTime      string
+	EndTime        string
+	Limit          int
+	Offset         int
+}
+
+// SessionTracesParams holds parameters for getting the traces of a session
+type SessionTracesParams struct {
+	SessionID      string
+	ComponentUID   string
+	EnvironmentUID string
+	StartTime      string
+	EndTime        string
+}
+
+// TokenUsageParams holds parameters for getting GenAI token usage
+type TokenUsageParams struct {
+	ProjectUIDs    []string
+	ComponentUIDs  []string
+	EnvironmentUID string
+	StartTime      string
+	EndTime        string
+}
+
 // TraceOverview represents a single trace overview with root span info
 type TraceOverview struct {
 	TraceID         string `json:"traceId"`
@@ -49,6 +109,35 @@ type TraceOverview struct {
 type TraceOverviewResponse struct {
 	Traces     []TraceOverview `json:"traces"`
 	TotalCount int             `json:"totalCount"`
+	Truncated  bool            `json:"truncated"`
+}
+
+// SessionOverview represents a conversation made up of one trace per turn
+type SessionOverview struct {
+	SessionID       string `json:"sessionId"`
+	TurnCount       int    `json:"turnCount"`
+	SpanCount       int64  `json:"spanCount"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	DurationInNanos int64  `json:"durationInNanos"`
+	InputTokens     int64  `json:"inputTokens"`
+	OutputTokens    int64  `json:"outputTokens"`
+	TotalTokens     int64  `json:"totalTokens"`
+	Truncated       bool   `json:"truncated"`
+}
+
+// SessionOverviewResponse represents the response for session list queries
+type SessionOverviewResponse struct {
+	Sessions   []SessionOverview `json:"sessions"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// SessionTracesResponse represents the traces of a session in chronological order
+type SessionTracesResponse struct {
+	SessionID  string          `json:"sessionId"`
+	Traces     []TraceOverview `json:"traces"`
+	TotalCount int             `json:"totalCount"`
+	Truncated  bool            `json:"truncated"`
 }
 
 // Span represents a single trace span
@@ -63,8 +152,55 @@ type Span struct {
 	DurationInNanos int64                  `json:"durationInNanos"`
 	Kind            string                 `json:"kind,omitempty"`
 	Status          string                 `json:"status,omitempty"`
+	StatusMessage   string                 `json:"statusMessage,omitempty"`
 	Attributes      map[string]interface{} `json:"attributes,omitempty"`
 	Resource        map[string]interface{} `json:"resource,omitempty"`
+	Events          []SpanEvent            `json:"events,omitempty"`
+	Links           []SpanLink             `json:"links,omitempty"`
+}
+
+// SpanEvent represents a timestamped event recorded on a span
+type SpanEvent struct {
+	Name       string                 `json:"name"`
+	Time       time.Time              `json:"time"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Exception  *SpanException         `json:"exception,omitempty"`
+}
+
+// SpanException holds the exception details of an exception event
+type SpanException struct {
+	Type       string `json:"type,omitempty"`
+	Message    string `json:"message,omitempty"`
+	Stacktrace string `json:"stacktrace,omitempty"`
+	Escaped    bool   `json:"escaped,omitempty"`
+}
+
+// SpanLink represents a link from a span to another span
+type SpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	TraceState string                 `json:"traceState,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// SpanNode represents a span placed in the span tree of its trace
+type SpanNode struct {
+	Span
+	Depth           int         `json:"depth"`
+	OffsetInNanos   int64       `json:"offsetInNanos"`
+	SelfTimeInNanos int64       `json:"selfTimeInNanos"`
+	CriticalPath    bool        `json:"criticalPath"`
+	Orphan          bool        `json:"orphan,omitempty"`
+	Children        []*SpanNode `json:"children,omitempty"`
+}
+
+// TraceTreeResponse represents a trace as a tree of spans
+type TraceTreeResponse struct {
+	TraceID         string      `json:"traceId"`
+	StartTime       time.Time   `json:"startTime"`
+	DurationInNanos int64       `json:"durationInNanos"`
+	Roots           []*SpanNode `json:"roots"`
+	TotalCount      int         `json:"totalCount"`
 }
 
 // TraceResponse represents the response for trace queries
@@ -72,3 +208,19 @@ type TraceResponse struct {
 	Spans      []Span `json:"spans"`
 	TotalCount int    `json:"totalCount"`
 }
+
+// TokenUsage represents summed token usage for a project, component and model combination
+type TokenUsage struct {
+	ProjectUID   string `json:"projectUid"`
+	ComponentUID string `json:"componentUid"`
+	Model        string `json:"model"`
+	InputTokens  int64  `json:"inputTokens"`
+	OutputTokens int64  `json:"outputTokens"`
+	TotalTokens  int64  `json:"totalTokens"`
+	SpanCount    int64  `json:"spanCount"`
+}
+
+// TokenUsageResponse represents the response for token usage queries
+type TokenUsageResponse struct {
+	Usage []TokenUsage `json:"usage"`
+}
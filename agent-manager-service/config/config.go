@@ -16,6 +16,8 @@
 
 package config
 
+import "k8s.io/apimachinery/pkg/api/resource"
+
 // Config holds all configuration for the application
 type Config struct {
 	ServerHost          string
@@ -48,11 +50,29 @@ type Config struct {
 	// Trace Observer service configuration (for distributed tracing)
 	TraceObserver TraceObserverConfig
 
+	// LLM token pricing used to estimate cost in usage reports
+	TokenPricing TokenPricingConfig
+
+	// Offline evaluation runs of datasets against deployed agents
+	Evaluation EvaluationConfig
+
 	IsLocalDevEnv bool
 
 	// Default Chat API configuration
 	DefaultChatAPI     DefaultChatAPIConfig
 	DefaultGatewayPort int
+
+	// MCP server agent configuration
+	MCPServer MCPServerConfig
+
+	// Job agent configuration
+	JobAgent JobAgentConfig
+
+	// Event-driven agent configuration
+	QueueConsumer QueueConsumerConfig
+
+	// Caps on the resources and scaling of agents
+	AgentScaling AgentScalingConfig
 }
 
 // OTELConfig holds all OpenTelemetry related configuration
@@ -83,9 +103,53 @@ type ObserverConfig struct {
 	Password string `json:"-"`
 }
 
+// Trace Observer query API authentication modes
+const (
+	TraceObserverAuthModeNone         = "none"
+	TraceObserverAuthModeSharedSecret = "shared-secret"
+	TraceObserverAuthModeJWT          = "jwt"
+)
+
 type TraceObserverConfig struct {
 	// Trace Observer service URL
 	URL string
+	// How queries are authenticated, one of "none", "shared-secret" or "jwt"; must match the Trace Observer
+	AuthMode     string
+	SharedSecret string `json:"-"`
+	// Key per-query tokens are signed with in jwt mode, and the audience they are issued for
+	JWTSigningKey string `json:"-"`
+	JWTAudience   string
+}
+
+type TokenPricingConfig struct {
+	Currency string
+	// Prices keyed by model name as reported in gen_ai.request.model
+	Models map[string]ModelPricing
+}
+
+type ModelPricing struct {
+	// Price per one million tokens
+	InputPerMillion  float64
+	OutputPerMillion float64
+}
+
+type EvaluationConfig struct {
+	// Timeout of a single agent invocation
+	AgentRequestTimeoutSeconds int
+	// Upper bound for the number of dataset items a run sends to the agent at once
+	MaxConcurrency int
+	// Runs that have not finished after this long are stopped and marked as failed
+	RunTimeoutMinutes int
+	// OpenAI compatible embeddings endpoint used by the embedding similarity evaluator
+	Embedding ModelEndpointConfig
+	// OpenAI compatible chat completions endpoint used by the LLM judge evaluator
+	Judge ModelEndpointConfig
+}
+
+type ModelEndpointConfig struct {
+	URL    string
+	APIKey string `json:"-"`
+	Model  string
 }
 
 type POSTGRESQL struct {
@@ -113,3 +177,46 @@ type DefaultChatAPIConfig struct {
 	DefaultHTTPPort int32
 	DefaultBasePath string
 }
+
+type MCPServerConfig struct {
+	// Defaults for mcp-server agents that do not set a port or transport path
+	DefaultHTTPPort           int32
+	DefaultStreamableHTTPPath string
+	DefaultSSEPath            string
+	// Timeout for listing the tools, resources and prompts of a deployed MCP server
+	IntrospectionTimeoutSeconds int
+}
+
+type QueueConsumerConfig struct {
+	// Time allowed for reading the consumer lag of an event-driven agent from its broker
+	LagTimeoutSeconds int
+	// Time allowed for connecting to a broker
+	ConnectTimeoutSeconds int
+	// Hosts of the brokers the consumer lag is read from. Entries are host names or IP addresses, or wildcards
+	// such as *.kafka.svc.cluster.local that match any subdomain. Brokers on other hosts are never connected to.
+	AllowedBrokerHosts []string
+}
+
+type JobAgentConfig struct {
+	// Defaults for job agents that do not set them in their job config
+	DefaultTimeoutSeconds    int32
+	DefaultRetries           int32
+	DefaultConcurrencyPolicy string
+}
+
+type AgentScalingConfig struct {
+	// Caps of environments that have no entry in EnvironmentCaps
+	DefaultCaps ResourceCaps
+	// Caps keyed by environment name
+	EnvironmentCaps map[string]ResourceCaps
+	// Per-pod metric that concurrency targets scale on, which a custom metrics adapter has to serve
+	ConcurrencyMetric string
+}
+
+type ResourceCaps struct {
+	// Upper bounds for the CPU and memory limits of an agent container
+	MaxCPU    resource.Quantity
+	MaxMemory resource.Quantity
+	// Upper bound for the fixed or autoscaled replica count of an agent
+	MaxReplicas int32
+}
@@ -91,6 +91,38 @@ func loadEnvs() {
 		DefaultBasePath: r.readOptionalString("DEFAULT_CHAT_API_BASE_PATH", "/"),
 	}
 
+	config.MCPServer = MCPServerConfig{
+		DefaultHTTPPort:             int32(r.readOptionalInt64("DEFAULT_MCP_SERVER_HTTP_PORT", 8000)),
+		DefaultStreamableHTTPPath:   r.readOptionalString("DEFAULT_MCP_STREAMABLE_HTTP_PATH", "/mcp"),
+		DefaultSSEPath:              r.readOptionalString("DEFAULT_MCP_SSE_PATH", "/sse"),
+		IntrospectionTimeoutSeconds: int(r.readOptionalInt64("MCP_INTROSPECTION_TIMEOUT_SECONDS", 15)),
+	}
+
+	config.JobAgent = JobAgentConfig{
+		DefaultTimeoutSeconds:    int32(r.readOptionalInt64("DEFAULT_JOB_TIMEOUT_SECONDS", 3600)),
+		DefaultRetries:           int32(r.readOptionalInt64("DEFAULT_JOB_RETRIES", 0)),
+		DefaultConcurrencyPolicy: r.readOptionalString("DEFAULT_JOB_CONCURRENCY_POLICY", "Forbid"),
+	}
+
+	// QUEUE_ALLOWED_BROKER_HOSTS is a comma separated list of broker hosts, e.g. nats.messaging,*.kafka.svc.cluster.local
+	config.QueueConsumer = QueueConsumerConfig{
+		LagTimeoutSeconds:     int(r.readOptionalInt64("QUEUE_LAG_TIMEOUT_SECONDS", 5)),
+		ConnectTimeoutSeconds: int(r.readOptionalInt64("QUEUE_CONNECT_TIMEOUT_SECONDS", 2)),
+		AllowedBrokerHosts:    r.readOptionalStringList("QUEUE_ALLOWED_BROKER_HOSTS"),
+	}
+
+	// Agent resource caps - AGENT_ENVIRONMENT_RESOURCE_CAPS is a comma separated list of
+	// <environment>=<max cpu>:<max memory>:<max replicas>, e.g. development=1:2Gi:3,production=4:8Gi:20
+	config.AgentScaling = AgentScalingConfig{
+		DefaultCaps: ResourceCaps{
+			MaxCPU:      r.readOptionalQuantity("AGENT_DEFAULT_MAX_CPU", "2"),
+			MaxMemory:   r.readOptionalQuantity("AGENT_DEFAULT_MAX_MEMORY", "4Gi"),
+			MaxReplicas: int32(r.readOptionalInt64("AGENT_DEFAULT_MAX_REPLICAS", 10)),
+		},
+		EnvironmentCaps:   r.readResourceCaps("AGENT_ENVIRONMENT_RESOURCE_CAPS"),
+		ConcurrencyMetric: r.readOptionalString("AUTOSCALING_CONCURRENCY_METRIC", "http_requests_in_flight"),
+	}
+
 	config.APIKeyHeader = r.readOptionalString("API_KEY_HEADER", "X-API-KEY")
 	config.APIKeyValue = r.readRequiredString("API_KEY_VALUE")
 
@@ -123,7 +155,34 @@ func loadEnvs() {
 
 	// Trace Observer service configuration - for distributed tracing
 	config.TraceObserver = TraceObserverConfig{
-		URL: r.readOptionalString("TRACE_OBSERVER_URL", "http://localhost:9098"),
+		URL:           r.readOptionalString("TRACE_OBSERVER_URL", "http://localhost:9098"),
+		AuthMode:      r.readOptionalString("TRACE_OBSERVER_AUTH_MODE", TraceObserverAuthModeNone),
+		SharedSecret:  r.readOptionalString("TRACE_OBSERVER_SHARED_SECRET", ""),
+		JWTSigningKey: r.readOptionalString("TRACE_OBSERVER_JWT_SIGNING_KEY", ""),
+		JWTAudience:   r.readOptionalString("TRACE_OBSERVER_JWT_AUDIENCE", "traces-observer"),
+	}
+
+	// LLM token pricing - LLM_MODEL_PRICING is a comma separated list of
+	// <model>=<input price per 1M tokens>:<output price per 1M tokens>, e.g. gpt-4o=2.5:10,gpt-4o-mini=0.15:0.6
+	config.TokenPricing = TokenPricingConfig{
+		Currency: r.readOptionalString("LLM_PRICING_CURRENCY", "USD"),
+		Models:   r.readModelPricing("LLM_MODEL_PRICING"),
+	}
+
+	config.Evaluation = EvaluationConfig{
+		AgentRequestTimeoutSeconds: int(r.readOptionalInt64("EVAL_AGENT_REQUEST_TIMEOUT_SECONDS", 60)),
+		MaxConcurrency:             int(r.readOptionalInt64("EVAL_MAX_CONCURRENCY", 16)),
+		RunTimeoutMinutes:          int(r.readOptionalInt64("EVAL_RUN_TIMEOUT_MINUTES", 60)),
+		Embedding: ModelEndpointConfig{
+			URL:    r.readOptionalString("EVAL_EMBEDDING_URL", ""),
+			APIKey: r.readOptionalString("EVAL_EMBEDDING_API_KEY", ""),
+			Model:  r.readOptionalString("EVAL_EMBEDDING_MODEL", "text-embedding-3-small"),
+		},
+		Judge: ModelEndpointConfig{
+			URL:    r.readOptionalString("EVAL_JUDGE_URL", ""),
+			APIKey: r.readOptionalString("EVAL_JUDGE_API_KEY", ""),
+			Model:  r.readOptionalString("EVAL_JUDGE_MODEL", "gpt-4o-mini"),
+		},
 	}
 
 	config.IsLocalDevEnv = r.readOptionalBool("IS_LOCAL_DEV_ENV", false)
@@ -131,12 +190,84 @@ func loadEnvs() {
 
 	// Validate HTTP server configurations
 	validateHTTPServerConfigs(config, r)
+	validateTraceObserverConfigs(config, r)
+	validateEvaluationConfigs(config, r)
+	validateMCPServerConfigs(config, r)
+	validateJobAgentConfigs(config, r)
+	validateQueueConsumerConfigs(config, r)
+	validateAgentScalingConfigs(config, r)
 
 	r.logAndExitIfErrorsFound()
 
 	slog.Info("configReader: configs loaded")
 }
 
+func validateTraceObserverConfigs(cfg *Config, r *configReader) {
+	switch cfg.TraceObserver.AuthMode {
+	case TraceObserverAuthModeNone:
+	case TraceObserverAuthModeSharedSecret:
+		if cfg.TraceObserver.SharedSecret == "" {
+			r.errors = append(r.errors, fmt.Errorf("TRACE_OBSERVER_SHARED_SECRET is required when TRACE_OBSERVER_AUTH_MODE is %s", TraceObserverAuthModeSharedSecret))
+		}
+	case TraceObserverAuthModeJWT:
+		if cfg.TraceObserver.JWTSigningKey == "" {
+			r.errors = append(r.errors, fmt.Errorf("TRACE_OBSERVER_JWT_SIGNING_KEY is required when TRACE_OBSERVER_AUTH_MODE is %s", TraceObserverAuthModeJWT))
+		}
+	default:
+		r.errors = append(r.errors, fmt.Errorf("TRACE_OBSERVER_AUTH_MODE must be one of none, shared-secret or jwt, got %s", cfg.TraceObserver.AuthMode))
+	}
+}
+
+func validateEvaluationConfigs(cfg *Config, r *configReader) {
+	if cfg.Evaluation.AgentRequestTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("EVAL_AGENT_REQUEST_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.Evaluation.AgentRequestTimeoutSeconds))
+	}
+	if cfg.Evaluation.MaxConcurrency <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("EVAL_MAX_CONCURRENCY must be greater than 0, got %d", cfg.Evaluation.MaxConcurrency))
+	}
+	if cfg.Evaluation.RunTimeoutMinutes <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("EVAL_RUN_TIMEOUT_MINUTES must be greater than 0, got %d", cfg.Evaluation.RunTimeoutMinutes))
+	}
+}
+
+func validateMCPServerConfigs(cfg *Config, r *configReader) {
+	if cfg.MCPServer.DefaultHTTPPort <= 0 || cfg.MCPServer.DefaultHTTPPort > 65535 {
+		r.errors = append(r.errors, fmt.Errorf("DEFAULT_MCP_SERVER_HTTP_PORT must be a valid port number (1-65535), got %d", cfg.MCPServer.DefaultHTTPPort))
+	}
+	if cfg.MCPServer.IntrospectionTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("MCP_INTROSPECTION_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.MCPServer.IntrospectionTimeoutSeconds))
+	}
+}
+
+func validateJobAgentConfigs(cfg *Config, r *configReader) {
+	if cfg.JobAgent.DefaultTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("DEFAULT_JOB_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.JobAgent.DefaultTimeoutSeconds))
+	}
+	if cfg.JobAgent.DefaultRetries < 0 {
+		r.errors = append(r.errors, fmt.Errorf("DEFAULT_JOB_RETRIES must not be negative, got %d", cfg.JobAgent.DefaultRetries))
+	}
+	switch cfg.JobAgent.DefaultConcurrencyPolicy {
+	case "Allow", "Forbid", "Replace":
+	default:
+		r.errors = append(r.errors, fmt.Errorf("DEFAULT_JOB_CONCURRENCY_POLICY must be one of Allow, Forbid or Replace, got %s", cfg.JobAgent.DefaultConcurrencyPolicy))
+	}
+}
+
+func validateQueueConsumerConfigs(cfg *Config, r *configReader) {
+	if cfg.QueueConsumer.LagTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("QUEUE_LAG_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.QueueConsumer.LagTimeoutSeconds))
+	}
+	if cfg.QueueConsumer.ConnectTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("QUEUE_CONNECT_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.QueueConsumer.ConnectTimeoutSeconds))
+	}
+}
+
+func validateAgentScalingConfigs(cfg *Config, r *configReader) {
+	if cfg.AgentScaling.DefaultCaps.MaxReplicas <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("AGENT_DEFAULT_MAX_REPLICAS must be greater than 0, got %d", cfg.AgentScaling.DefaultCaps.MaxReplicas))
+	}
+}
+
 func validateHTTPServerConfigs(cfg *Config, r *configReader) {
 	if cfg.ServerPort < 1 || cfg.ServerPort > 65535 {
 		r.errors = append(r.errors, fmt.Errorf("SERVER_PORT must be between 1 and 65535, got %d", cfg.ServerPort))
@@ -21,6 +21,9 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 type configReader struct {
@@ -91,3 +94,103 @@ func (c *configReader) readOptionalBool(envVarName string, defaultValue bool) bo
 	}
 	return value
 }
+
+func (c *configReader) readOptionalStringList(envVarName string) []string {
+	values := []string{}
+	for _, entry := range strings.Split(os.Getenv(envVarName), ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			values = append(values, entry)
+		}
+	}
+	return values
+}
+
+func (c *configReader) readModelPricing(envVarName string) map[string]ModelPricing {
+	pricing := map[string]ModelPricing{}
+	v := os.Getenv(envVarName)
+	if v == "" {
+		return pricing
+	}
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		model, prices, found := strings.Cut(entry, "=")
+		inputPrice, outputPrice, hasOutput := strings.Cut(prices, ":")
+		if !found || !hasOutput || strings.TrimSpace(model) == "" {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid entry %q, expected <model>=<input>:<output>", envVarName, entry))
+			continue
+		}
+		input, err := strconv.ParseFloat(strings.TrimSpace(inputPrice), 64)
+		if err != nil || input < 0 {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid input price for model %s", envVarName, model))
+			continue
+		}
+		output, err := strconv.ParseFloat(strings.TrimSpace(outputPrice), 64)
+		if err != nil || output < 0 {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid output price for model %s", envVarName, model))
+			continue
+		}
+		pricing[strings.TrimSpace(model)] = ModelPricing{
+			InputPerMillion:  input,
+			OutputPerMillion: output,
+		}
+	}
+	return pricing
+}
+
+func (c *configReader) readOptionalQuantity(envVarName string, defaultValue string) resource.Quantity {
+	v := os.Getenv(envVarName)
+	if v == "" {
+		v = defaultValue
+	}
+	value, err := resource.ParseQuantity(v)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("optional environment variable %s is not a valid quantity [%w]", envVarName, err))
+		return resource.Quantity{}
+	}
+	return value
+}
+
+func (c *configReader) readResourceCaps(envVarName string) map[string]ResourceCaps {
+	caps := map[string]ResourceCaps{}
+	v := os.Getenv(envVarName)
+	if v == "" {
+		return caps
+	}
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		environment, limits, found := strings.Cut(entry, "=")
+		parts := strings.Split(limits, ":")
+		if !found || len(parts) != 3 || strings.TrimSpace(environment) == "" {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid entry %q, expected <environment>=<max cpu>:<max memory>:<max replicas>", envVarName, entry))
+			continue
+		}
+		environment = strings.TrimSpace(environment)
+		maxCPU, err := resource.ParseQuantity(strings.TrimSpace(parts[0]))
+		if err != nil {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid max cpu for environment %s", envVarName, environment))
+			continue
+		}
+		maxMemory, err := resource.ParseQuantity(strings.TrimSpace(parts[1]))
+		if err != nil {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid max memory for environment %s", envVarName, environment))
+			continue
+		}
+		maxReplicas, err := strconv.ParseInt(strings.TrimSpace(parts[2]), 10, 32)
+		if err != nil || maxReplicas <= 0 {
+			c.errors = append(c.errors, fmt.Errorf("environment variable %s has an invalid max replicas for environment %s", envVarName, environment))
+			continue
+		}
+		caps[environment] = ResourceCaps{
+			MaxCPU:      maxCPU,
+			MaxMemory:   maxMemory,
+			MaxReplicas: int32(maxReplicas),
+		}
+	}
+	return caps
+}
@@ -0,0 +1,282 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type AgentConfigController interface {
+	GetAgentConfigurations(w http.ResponseWriter, r *http.Request)
+	ListConfigOverrides(w http.ResponseWriter, r *http.Request)
+	CreateConfigOverride(w http.ResponseWriter, r *http.Request)
+	UpdateConfigOverride(w http.ResponseWriter, r *http.Request)
+	DeleteConfigOverride(w http.ResponseWriter, r *http.Request)
+	RedeployConfigurations(w http.ResponseWriter, r *http.Request)
+}
+
+type agentConfigController struct {
+	agentConfigService services.AgentConfigManagerService
+}
+
+// NewAgentConfigController returns a new AgentConfigController instance.
+func NewAgentConfigController(agentConfigService services.AgentConfigManagerService) AgentConfigController {
+	return &agentConfigController{
+		agentConfigService: agentConfigService,
+	}
+}
+
+func (c *agentConfigController) GetAgentConfigurations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	environment, ok := environmentFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	configurations, err := c.agentConfigService.GetEffectiveConfigurations(ctx, userIdpId, agentRef(r), environment)
+	if err != nil {
+		log.Error("GetAgentConfigurations: failed to get configurations", "environment", environment, "error", err)
+		writeAgentConfigError(w, err, "Failed to get configurations")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, toConfigurationResponse(r, environment, configurations))
+}
+
+func (c *agentConfigController) ListConfigOverrides(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	environment, ok := environmentFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := c.agentConfigService.ListConfigOverrides(ctx, userIdpId, agentRef(r), environment)
+	if err != nil {
+		log.Error("ListConfigOverrides: failed to list configuration overrides", "environment", environment, "error", err)
+		writeAgentConfigError(w, err, "Failed to list configuration overrides")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *agentConfigController) CreateConfigOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	environment, ok := environmentFromQuery(w, r)
+	if !ok {
+		return
+	}
+	redeploy, ok := redeployFromQuery(w, r)
+	if !ok {
+		return
+	}
+	var payload models.CreateConfigOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateConfigOverride: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	payload.Key = strings.TrimSpace(payload.Key)
+
+	response, err := c.agentConfigService.CreateConfigOverride(ctx, userIdpId, agentRef(r), environment, payload, redeploy)
+	if err != nil {
+		log.Error("CreateConfigOverride: failed to create configuration override", "key", payload.Key, "environment", environment, "error", err)
+		writeAgentConfigError(w, err, "Failed to create configuration override")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *agentConfigController) UpdateConfigOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	key := r.PathValue(utils.PathParamConfigKey)
+	environment, ok := environmentFromQuery(w, r)
+	if !ok {
+		return
+	}
+	redeploy, ok := redeployFromQuery(w, r)
+	if !ok {
+		return
+	}
+	var payload models.UpdateConfigOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateConfigOverride: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := c.agentConfigService.UpdateConfigOverride(ctx, userIdpId, agentRef(r), environment, key, payload, redeploy)
+	if err != nil {
+		log.Error("UpdateConfigOverride: failed to update configuration override", "key", key, "environment", environment, "error", err)
+		writeAgentConfigError(w, err, "Failed to update configuration override")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *agentConfigController) DeleteConfigOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	key := r.PathValue(utils.PathParamConfigKey)
+	environment, ok := environmentFromQuery(w, r)
+	if !ok {
+		return
+	}
+	redeploy, ok := redeployFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.agentConfigService.DeleteConfigOverride(ctx, userIdpId, agentRef(r), environment, key, redeploy); err != nil {
+		log.Error("DeleteConfigOverride: failed to delete configuration override", "key", key, "environment", environment, "error", err)
+		writeAgentConfigError(w, err, "Failed to delete configuration override")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+func (c *agentConfigController) RedeployConfigurations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	environment, ok := environmentFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	configurations, err := c.agentConfigService.RedeployConfigurations(ctx, userIdpId, agentRef(r), environment)
+	if err != nil {
+		log.Error("RedeployConfigurations: failed to redeploy configurations", "environment", environment, "error", err)
+		writeAgentConfigError(w, err, "Failed to redeploy configurations")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, toConfigurationResponse(r, environment, configurations))
+}
+
+// environmentFromQuery reads the required environment query parameter, writing a 400 response if it is missing
+func environmentFromQuery(w http.ResponseWriter, r *http.Request) (string, bool) {
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return "", false
+	}
+	return environment, true
+}
+
+// redeployFromQuery reads the optional redeploy query parameter, writing a 400 response if it is not a boolean
+func redeployFromQuery(w http.ResponseWriter, r *http.Request) (bool, bool) {
+	redeployStr := r.URL.Query().Get("redeploy")
+	if redeployStr == "" {
+		return false, true
+	}
+	redeploy, err := strconv.ParseBool(redeployStr)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid redeploy parameter: must be true or false")
+		return false, false
+	}
+	return redeploy, true
+}
+
+func toConfigurationResponse(r *http.Request, environment string, configurations []models.EffectiveConfigItem) spec.ConfigurationResponse {
+	configurationItems := make([]spec.ConfigurationItem, len(configurations))
+	for i, config := range configurations {
+		configurationItems[i] = spec.ConfigurationItem{
+			Key:    config.Key,
+			Value:  config.Value,
+			Source: spec.PtrString(config.Source),
+		}
+	}
+	return spec.ConfigurationResponse{
+		ProjectName:    r.PathValue(utils.PathParamProjName),
+		AgentName:      r.PathValue(utils.PathParamAgentName),
+		Environment:    environment,
+		Configurations: configurationItems,
+	}
+}
+
+func writeAgentConfigError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrEnvironmentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+	case errors.Is(err, utils.ErrConfigOverrideNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Configuration override not found")
+	case errors.Is(err, utils.ErrConfigOverrideExists):
+		utils.WriteErrorResponse(w, http.StatusConflict, "Configuration override already exists")
+	case errors.Is(err, utils.ErrInvalidConfigOverride):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, utils.ErrAgentNotInternal):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Configurations are only supported for agents deployed by the platform")
+	case errors.Is(err, utils.ErrAgentNotDeployed):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Agent is not deployed to the environment")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
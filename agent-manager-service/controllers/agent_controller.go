@@ -26,6 +26,7 @@ import (
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
@@ -41,10 +42,13 @@ type AgentController interface {
 	ListAgentBuilds(w http.ResponseWriter, r *http.Request)
 	GetAgentDeployments(w http.ResponseWriter, r *http.Request)
 	GetAgentEndpoints(w http.ResponseWriter, r *http.Request)
+	UpdateAgentEndpoints(w http.ResponseWriter, r *http.Request)
+	UpdateAgentScaling(w http.ResponseWriter, r *http.Request)
+	GetAgentSchemaDiff(w http.ResponseWriter, r *http.Request)
 	GetBuild(w http.ResponseWriter, r *http.Request)
-	GetAgentConfigurations(w http.ResponseWriter, r *http.Request)
 	GetBuildLogs(w http.ResponseWriter, r *http.Request)
 	GenerateName(w http.ResponseWriter, r *http.Request)
+	ListA2AAgents(w http.ResponseWriter, r *http.Request)
 }
 
 type agentController struct {
@@ -196,6 +200,10 @@ func (c *agentController) CreateAgent(w http.ResponseWriter, r *http.Request) {
 			utils.WriteErrorResponse(w, http.StatusConflict, "Agent already exists")
 			return
 		}
+		if errors.Is(err, utils.ErrInvalidAgentScaling) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create agent")
 		return
 	}
@@ -347,6 +355,11 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if err := utils.ValidateAgentScaling(payload.Resources, payload.Replicas, payload.Autoscaling); err != nil {
+		log.Error("DeployAgent: invalid agent scaling", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	deployedEnv, err := c.agentService.DeployAgent(ctx, userIdpId, orgName, projName, agentName, &payload)
 	if err != nil {
@@ -363,6 +376,10 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
 			return
 		}
+		if errors.Is(err, utils.ErrInvalidAgentScaling) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to deploy agent")
 		return
 	}
@@ -604,6 +621,10 @@ func (c *agentController) GetAgentEndpoints(w http.ResponseWriter, r *http.Reque
 			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
 			return
 		}
+		if errors.Is(err, utils.ErrAgentNotDeployed) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Agent is not deployed to the environment")
+			return
+		}
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get agent endpoints")
 		return
 	}
@@ -612,7 +633,8 @@ func (c *agentController) GetAgentEndpoints(w http.ResponseWriter, r *http.Reque
 	utils.WriteSuccessResponse(w, http.StatusOK, endpointResponses)
 }
 
-func (c *agentController) GetAgentConfigurations(w http.ResponseWriter, r *http.Request) {
+// UpdateAgentEndpoints replaces the additional endpoints exposed by an agent
+func (c *agentController) UpdateAgentEndpoints(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
 
@@ -621,20 +643,21 @@ func (c *agentController) GetAgentConfigurations(w http.ResponseWriter, r *http.
 	projName := r.PathValue(utils.PathParamProjName)
 	agentName := r.PathValue(utils.PathParamAgentName)
 
-	environment := r.URL.Query().Get("environment")
-	if environment == "" {
-		log.Error("GetAgentConfigurations: missing required query parameter 'environment'")
-		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
-		return
-	}
-
 	// Extract user info from JWT token
 	tokenClaims := jwtassertion.GetTokenClaims(ctx)
 	userIdpId := tokenClaims.Sub
 
-	configurations, err := c.agentService.GetAgentConfigurations(ctx, userIdpId, orgName, projName, agentName, environment)
+	// Parse and validate request body
+	var payload spec.UpdateAgentEndpointsRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateAgentEndpoints: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	err := c.agentService.UpdateAgentEndpoints(ctx, userIdpId, orgName, projName, agentName, payload.Endpoints)
 	if err != nil {
-		log.Error("GetAgentConfigurations: failed to get configurations", "error", err)
+		log.Error("UpdateAgentEndpoints: failed to update agent endpoints", "error", err)
 		if errors.Is(err, utils.ErrOrganizationNotFound) {
 			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
 			return
@@ -647,25 +670,158 @@ func (c *agentController) GetAgentConfigurations(w http.ResponseWriter, r *http.
 			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
 			return
 		}
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get configurations")
+		if errors.Is(err, utils.ErrInvalidAgentEndpoints) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotInternal) || errors.Is(err, utils.ErrAgentEndpointsUnsupported) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Additional endpoints are only supported for api agents deployed by the platform")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to update agent endpoints")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, payload)
+}
+
+// UpdateAgentScaling sets the resources and scaling of an agent in an environment
+func (c *agentController) UpdateAgentScaling(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("UpdateAgentScaling: missing required query parameter 'environment'")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	// Parse and validate request body
+	var payload spec.UpdateAgentScalingRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateAgentScaling: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateAgentScaling(payload.Resources, payload.Replicas, payload.Autoscaling); err != nil {
+		log.Error("UpdateAgentScaling: invalid agent scaling", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := c.agentService.UpdateAgentScaling(ctx, userIdpId, orgName, projName, agentName, environment, &payload)
+	if err != nil {
+		log.Error("UpdateAgentScaling: failed to update agent scaling", "error", err)
+		switch {
+		case errors.Is(err, utils.ErrOrganizationNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+		case errors.Is(err, utils.ErrProjectNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+		case errors.Is(err, utils.ErrAgentNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+		case errors.Is(err, utils.ErrEnvironmentNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+		case errors.Is(err, utils.ErrAgentNotInternal):
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Scaling is only supported for agents deployed by the platform")
+		case errors.Is(err, utils.ErrAgentNotDeployed):
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Agent is not deployed to the environment")
+		case errors.Is(err, utils.ErrInvalidAgentScaling):
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to update agent scaling")
+		}
 		return
 	}
 
-	// Convert configurations to response format
-	configurationItems := make([]spec.ConfigurationItem, len(configurations))
-	for i, config := range configurations {
-		configurationItems[i] = spec.ConfigurationItem{
-			Key:   config.Key,
-			Value: config.Value,
+	utils.WriteSuccessResponse(w, http.StatusAccepted, payload)
+}
+
+// GetAgentSchemaDiff compares the endpoint schemas of an agent deployed to an environment with the ones deployed to
+// the environment it is promoted to next
+func (c *agentController) GetAgentSchemaDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetAgentSchemaDiff: missing required query parameter 'environment'")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	diff, err := c.agentService.GetAgentSchemaDiff(ctx, userIdpId, orgName, projName, agentName, environment)
+	if err != nil {
+		log.Error("GetAgentSchemaDiff: failed to get agent schema diff", "error", err)
+		switch {
+		case errors.Is(err, utils.ErrOrganizationNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+		case errors.Is(err, utils.ErrProjectNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+		case errors.Is(err, utils.ErrAgentNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+		case errors.Is(err, utils.ErrEnvironmentNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+		case errors.Is(err, utils.ErrAgentNotInternal):
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Schema diffs are only supported for agents deployed by the platform")
+		case errors.Is(err, utils.ErrNoPromotionTarget):
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Environment has no promotion target")
+		case errors.Is(err, utils.ErrAgentNotDeployed):
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Agent is not deployed to the environment")
+		default:
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get agent schema diff")
 		}
+		return
 	}
 
-	configurationsResponse := spec.ConfigurationResponse{
-		ProjectName:    projName,
-		AgentName:      agentName,
-		Environment:    environment,
-		Configurations: configurationItems,
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToSchemaDiffResponse(diff))
+}
+
+// ListA2AAgents lists the deployed a2a agents of an organization so that agents can discover each other
+func (c *agentController) ListA2AAgents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	environment := r.URL.Query().Get("environment")
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	agents, err := c.agentService.ListA2AAgents(ctx, userIdpId, orgName, environment)
+	if err != nil {
+		log.Error("ListA2AAgents: failed to list a2a agents", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list a2a agents")
+		return
 	}
 
-	utils.WriteSuccessResponse(w, http.StatusOK, configurationsResponse)
+	utils.WriteSuccessResponse(w, http.StatusOK, models.A2AAgentListResponse{
+		Agents: agents,
+		Total:  len(agents),
+	})
 }
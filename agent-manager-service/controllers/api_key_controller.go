@@ -0,0 +1,205 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type APIKeyController interface {
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	ListAPIKeys(w http.ResponseWriter, r *http.Request)
+	RotateAPIKey(w http.ResponseWriter, r *http.Request)
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request)
+	VerifyAPIKey(w http.ResponseWriter, r *http.Request)
+}
+
+type apiKeyController struct {
+	apiKeyService services.APIKeyManagerService
+}
+
+// NewAPIKeyController returns a new APIKeyController instance.
+func NewAPIKeyController(apiKeyService services.APIKeyManagerService) APIKeyController {
+	return &apiKeyController{
+		apiKeyService: apiKeyService,
+	}
+}
+
+func (c *apiKeyController) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload models.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateAPIKey: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	payload.Name = strings.TrimSpace(payload.Name)
+	if payload.Environment == "" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "environment is required")
+		return
+	}
+	if len(payload.Name) > utils.APIKeyMaxNameLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("name must be at most %d characters", utils.APIKeyMaxNameLength))
+		return
+	}
+
+	response, err := c.apiKeyService.CreateAPIKey(ctx, userIdpId, agentRef(r), payload)
+	if err != nil {
+		log.Error("CreateAPIKey: failed to create api key", "error", err)
+		writeAPIKeyError(w, err, "Failed to create API key")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *apiKeyController) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	response, err := c.apiKeyService.ListAPIKeys(ctx, userIdpId, agentRef(r), r.URL.Query().Get("environment"))
+	if err != nil {
+		log.Error("ListAPIKeys: failed to list api keys", "error", err)
+		writeAPIKeyError(w, err, "Failed to list API keys")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *apiKeyController) RotateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	keyId, err := uuid.Parse(r.PathValue(utils.PathParamAPIKeyId))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "API key not found")
+		return
+	}
+
+	response, err := c.apiKeyService.RotateAPIKey(ctx, userIdpId, agentRef(r), keyId)
+	if err != nil {
+		log.Error("RotateAPIKey: failed to rotate api key", "error", err)
+		writeAPIKeyError(w, err, "Failed to rotate API key")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *apiKeyController) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	keyId, err := uuid.Parse(r.PathValue(utils.PathParamAPIKeyId))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "API key not found")
+		return
+	}
+
+	if err := c.apiKeyService.RevokeAPIKey(ctx, userIdpId, agentRef(r), keyId); err != nil {
+		log.Error("RevokeAPIKey: failed to revoke api key", "error", err)
+		writeAPIKeyError(w, err, "Failed to revoke API key")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+// VerifyAPIKey handles the internal endpoint used by the trace ingestion path to verify API keys
+func (c *apiKeyController) VerifyAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	var payload models.VerifyAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("VerifyAPIKey: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := c.apiKeyService.VerifyAPIKey(ctx, payload.Key)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidAPIKey) {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, "Invalid API key")
+			return
+		}
+		log.Error("VerifyAPIKey: failed to verify api key", "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to verify API key")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// agentRef extracts the agent path parameters of a request
+func agentRef(r *http.Request) services.AgentRef {
+	return services.AgentRef{
+		OrgName:     r.PathValue(utils.PathParamOrgName),
+		ProjectName: r.PathValue(utils.PathParamProjName),
+		AgentName:   r.PathValue(utils.PathParamAgentName),
+	}
+}
+
+func writeAPIKeyError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrEnvironmentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+	case errors.Is(err, utils.ErrAPIKeyNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "API key not found")
+	case errors.Is(err, utils.ErrAgentNotExternal):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "API keys can only be issued for external agents")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
@@ -18,12 +18,19 @@ package controllers
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/openapi"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
+// Schema files larger than this are rejected rather than substituted into the Workload CR
+const maxSchemaFileBytes = 5 * 1024 * 1024
+
 type BuildCallbackPayload struct {
 	AgentName   string `json:"agentName"`
 	ProjectName string `json:"projectName"`
@@ -32,6 +39,7 @@ type BuildCallbackPayload struct {
 
 type BuildCIController interface {
 	HandleBuildCallback(w http.ResponseWriter, r *http.Request)
+	ValidateBuildSchema(w http.ResponseWriter, r *http.Request)
 }
 
 type buildCIController struct {
@@ -74,3 +82,51 @@ func (b *buildCIController) HandleBuildCallback(w http.ResponseWriter, r *http.R
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(workloadCR))
 }
+
+// ValidateBuildSchema validates a schema file of an agent during its build. The build fails when a schema is rejected,
+// so that broken schemas never reach the Workload CR.
+func (b *buildCIController) ValidateBuildSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	query := r.URL.Query()
+	orgName := query.Get("orgName")
+	projectName := query.Get("projectName")
+	agentName := query.Get("agentName")
+	schemaPath := query.Get("schemaPath")
+	if orgName == "" || projectName == "" || agentName == "" || schemaPath == "" {
+		writeJSONResponse(w, http.StatusBadRequest, map[string]string{"error": "orgName, projectName, agentName and schemaPath are required"})
+		return
+	}
+
+	content, err := io.ReadAll(io.LimitReader(r.Body, maxSchemaFileBytes+1))
+	if err != nil {
+		log.Error("ValidateBuildSchema: failed to read request body", "error", err)
+		writeJSONResponse(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+		return
+	}
+	if len(content) > maxSchemaFileBytes {
+		writeJSONResponse(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "Schema file is too large"})
+		return
+	}
+
+	err = b.buildCIManagerService.ValidateBuildSchema(ctx, orgName, projectName, agentName, schemaPath, content)
+	if err != nil {
+		var validationErr *openapi.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			writeJSONResponse(w, http.StatusUnprocessableEntity, map[string]interface{}{
+				"error":    "Schema file " + schemaPath + " is not a valid OpenAPI document",
+				"problems": validationErr.Problems,
+			})
+		case errors.Is(err, utils.ErrOrganizationNotFound), errors.Is(err, utils.ErrProjectNotFound),
+			errors.Is(err, utils.ErrAgentNotFound), errors.Is(err, utils.ErrAgentEndpointNotFound):
+			writeJSONResponse(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+		default:
+			log.Error("ValidateBuildSchema: failed to validate schema", "error", err)
+			writeJSONResponse(w, http.StatusInternalServerError, map[string]string{"error": "Failed to validate schema"})
+		}
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, map[string]bool{"valid": true})
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type EvalDatasetController interface {
+	CreateDataset(w http.ResponseWriter, r *http.Request)
+	ListDatasets(w http.ResponseWriter, r *http.Request)
+	GetDataset(w http.ResponseWriter, r *http.Request)
+	UpdateDataset(w http.ResponseWriter, r *http.Request)
+	DeleteDataset(w http.ResponseWriter, r *http.Request)
+	ExportDataset(w http.ResponseWriter, r *http.Request)
+}
+
+type evalDatasetController struct {
+	evalDatasetService services.EvalDatasetManagerService
+}
+
+// NewEvalDatasetController returns a new EvalDatasetController instance.
+func NewEvalDatasetController(evalDatasetService services.EvalDatasetManagerService) EvalDatasetController {
+	return &evalDatasetController{
+		evalDatasetService: evalDatasetService,
+	}
+}
+
+func (c *evalDatasetController) CreateDataset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload models.CreateDatasetRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateDataset: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateResourceName(payload.Name, "dataset"); err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(payload.Description) > utils.EvalDatasetMaxDescriptionLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("description must be at most %d characters", utils.EvalDatasetMaxDescriptionLength))
+		return
+	}
+	if errMsg := validateDatasetTraceSelection(&payload.Traces); errMsg != "" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	response, err := c.evalDatasetService.CreateDataset(ctx, userIdpId, projectRef(r), payload)
+	if err != nil {
+		log.Error("CreateDataset: failed to create dataset", "datasetName", payload.Name, "error", err)
+		writeEvalDatasetError(w, err, "Failed to create dataset")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *evalDatasetController) ListDatasets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	response, err := c.evalDatasetService.ListDatasets(ctx, userIdpId, projectRef(r))
+	if err != nil {
+		log.Error("ListDatasets: failed to list datasets", "error", err)
+		writeEvalDatasetError(w, err, "Failed to list datasets")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *evalDatasetController) GetDataset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	datasetName := r.PathValue(utils.PathParamDatasetName)
+	version, ok := datasetVersionFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := c.evalDatasetService.GetDataset(ctx, userIdpId, projectRef(r), datasetName, version)
+	if err != nil {
+		log.Error("GetDataset: failed to get dataset", "datasetName", datasetName, "version", version, "error", err)
+		writeEvalDatasetError(w, err, "Failed to get dataset")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *evalDatasetController) UpdateDataset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	datasetName := r.PathValue(utils.PathParamDatasetName)
+
+	var payload models.UpdateDatasetRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateDataset: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if payload.Description == nil && payload.Items == nil && payload.AddTraces == nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "At least one of description, items or addTraces is required")
+		return
+	}
+	if payload.Description != nil && len(*payload.Description) > utils.EvalDatasetMaxDescriptionLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("description must be at most %d characters", utils.EvalDatasetMaxDescriptionLength))
+		return
+	}
+	if payload.Items != nil {
+		if len(*payload.Items) > utils.EvalDatasetMaxItems {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("items must have at most %d entries", utils.EvalDatasetMaxItems))
+			return
+		}
+		for i, item := range *payload.Items {
+			if strings.TrimSpace(item.Input) == "" {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("items[%d].input is required", i))
+				return
+			}
+			if len(item.TraceID) > utils.TraceAnnotationMaxTraceIDLength {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("items[%d].traceId must be at most %d characters", i, utils.TraceAnnotationMaxTraceIDLength))
+				return
+			}
+			if len(item.AgentName) > utils.MaxResourceNameLength {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("items[%d].agentName must be at most %d characters", i, utils.MaxResourceNameLength))
+				return
+			}
+		}
+	}
+	if payload.AddTraces != nil {
+		if errMsg := validateDatasetTraceSelection(payload.AddTraces); errMsg != "" {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "addTraces: "+errMsg)
+			return
+		}
+	}
+
+	response, err := c.evalDatasetService.UpdateDataset(ctx, userIdpId, projectRef(r), datasetName, payload)
+	if err != nil {
+		log.Error("UpdateDataset: failed to update dataset", "datasetName", datasetName, "error", err)
+		writeEvalDatasetError(w, err, "Failed to update dataset")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *evalDatasetController) DeleteDataset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	datasetName := r.PathValue(utils.PathParamDatasetName)
+	if err := c.evalDatasetService.DeleteDataset(ctx, userIdpId, projectRef(r), datasetName); err != nil {
+		log.Error("DeleteDataset: failed to delete dataset", "datasetName", datasetName, "error", err)
+		writeEvalDatasetError(w, err, "Failed to delete dataset")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+// ExportDataset writes a version of a dataset as JSONL, one item per line
+func (c *evalDatasetController) ExportDataset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	datasetName := r.PathValue(utils.PathParamDatasetName)
+	version, ok := datasetVersionFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	dataset, err := c.evalDatasetService.GetDataset(ctx, userIdpId, projectRef(r), datasetName, version)
+	if err != nil {
+		log.Error("ExportDataset: failed to get dataset", "datasetName", datasetName, "version", version, "error", err)
+		writeEvalDatasetError(w, err, "Failed to export dataset")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-v%d.jsonl", dataset.Name, dataset.Version)))
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
+	for _, item := range dataset.Items {
+		if err := encoder.Encode(item); err != nil {
+			log.Error("ExportDataset: failed to write dataset item", "datasetName", datasetName, "error", err)
+			return
+		}
+	}
+	log.Info("ExportDataset: successfully exported dataset", "datasetName", datasetName, "version", dataset.Version, "itemCount", dataset.ItemCount)
+}
+
+// projectRef extracts the project path parameters of a request
+func projectRef(r *http.Request) services.ProjectRef {
+	return services.ProjectRef{
+		OrgName:     r.PathValue(utils.PathParamOrgName),
+		ProjectName: r.PathValue(utils.PathParamProjName),
+	}
+}
+
+// datasetVersionFromQuery reads the optional version query parameter, writing a 400 response if it is invalid.
+// Version 0 stands for the latest version.
+func datasetVersionFromQuery(w http.ResponseWriter, r *http.Request) (int, bool) {
+	versionStr := r.URL.Query().Get("version")
+	if versionStr == "" {
+		return 0, true
+	}
+	version, err := strconv.Atoi(versionStr)
+	if err != nil || version < 1 {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid version parameter: must be a positive integer")
+		return 0, false
+	}
+	return version, true
+}
+
+// validateDatasetTraceSelection checks a trace selection and returns a message describing the first problem found
+func validateDatasetTraceSelection(selection *models.DatasetTraceSelection) string {
+	if selection.AgentName == "" {
+		return "agentName is required"
+	}
+	if len(selection.TraceIDs) > utils.EvalDatasetMaxTraceSelection {
+		return fmt.Sprintf("traceIds must have at most %d entries", utils.EvalDatasetMaxTraceSelection)
+	}
+	for _, traceId := range selection.TraceIDs {
+		if traceId == "" || len(traceId) > utils.TraceAnnotationMaxTraceIDLength {
+			return fmt.Sprintf("traceIds must be non-empty and at most %d characters", utils.TraceAnnotationMaxTraceIDLength)
+		}
+	}
+	if len(selection.TraceIDs) > 0 && (selection.StartTime != "" || selection.EndTime != "" || selection.Label != "" || selection.Limit != 0) {
+		return "traceIds cannot be combined with startTime, endTime, label or limit"
+	}
+	if selection.Limit < 0 || selection.Limit > utils.EvalDatasetMaxTraceSelection {
+		return fmt.Sprintf("limit must be between 1 and %d", utils.EvalDatasetMaxTraceSelection)
+	}
+	for name, value := range map[string]string{"startTime": selection.StartTime, "endTime": selection.EndTime} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Sprintf("%s must be in RFC3339 format", name)
+		}
+	}
+	if len(selection.Label) > utils.TraceAnnotationMaxLabelLength {
+		return fmt.Sprintf("label must be at most %d characters", utils.TraceAnnotationMaxLabelLength)
+	}
+	return ""
+}
+
+func writeEvalDatasetError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrEvalDatasetNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Dataset not found")
+	case errors.Is(err, utils.ErrEvalDatasetAlreadyExists):
+		utils.WriteErrorResponse(w, http.StatusConflict, "Dataset already exists")
+	case errors.Is(err, utils.ErrEvalDatasetEmpty):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Dataset must have at least one item. Traces are only added when their root span has a GenAI input.")
+	case errors.Is(err, utils.ErrEvalDatasetTooLarge):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("A dataset can have at most %d items", utils.EvalDatasetMaxItems))
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type EvalRunController interface {
+	CreateEvalRun(w http.ResponseWriter, r *http.Request)
+	ListEvalRuns(w http.ResponseWriter, r *http.Request)
+	GetEvalRun(w http.ResponseWriter, r *http.Request)
+	CompareEvalRuns(w http.ResponseWriter, r *http.Request)
+}
+
+type evalRunController struct {
+	evalRunService services.EvalRunManagerService
+}
+
+// NewEvalRunController returns a new EvalRunController instance.
+func NewEvalRunController(evalRunService services.EvalRunManagerService) EvalRunController {
+	return &evalRunController{
+		evalRunService: evalRunService,
+	}
+}
+
+// CreateEvalRun starts running a dataset against a deployed agent. The run is returned right away and
+// finishes in the background, so clients poll GetEvalRun for its results.
+func (c *evalRunController) CreateEvalRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload models.CreateEvalRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateEvalRun: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if errMsg := validateCreateEvalRunRequest(&payload); errMsg != "" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	response, err := c.evalRunService.CreateEvalRun(ctx, userIdpId, projectRef(r), payload)
+	if err != nil {
+		log.Error("CreateEvalRun: failed to create evaluation run", "datasetName", payload.DatasetName, "agentName", payload.AgentName, "error", err)
+		writeEvalRunError(w, err, "Failed to create evaluation run")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
+
+func (c *evalRunController) ListEvalRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	datasetName := r.URL.Query().Get("datasetName")
+	agentName := r.URL.Query().Get("agentName")
+
+	response, err := c.evalRunService.ListEvalRuns(ctx, userIdpId, projectRef(r), datasetName, agentName)
+	if err != nil {
+		log.Error("ListEvalRuns: failed to list evaluation runs", "error", err)
+		writeEvalRunError(w, err, "Failed to list evaluation runs")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *evalRunController) GetEvalRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	runId, err := uuid.Parse(r.PathValue(utils.PathParamEvalRunId))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid evaluation run id")
+		return
+	}
+
+	response, err := c.evalRunService.GetEvalRun(ctx, userIdpId, projectRef(r), runId)
+	if err != nil {
+		log.Error("GetEvalRun: failed to get evaluation run", "runId", runId, "error", err)
+		writeEvalRunError(w, err, "Failed to get evaluation run")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// CompareEvalRuns compares the run in the path, as the candidate, with the run given by the baseRunId query parameter
+func (c *evalRunController) CompareEvalRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	candidateRunId, err := uuid.Parse(r.PathValue(utils.PathParamEvalRunId))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid evaluation run id")
+		return
+	}
+	baseRunId, err := uuid.Parse(r.URL.Query().Get("baseRunId"))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing or invalid baseRunId query parameter")
+		return
+	}
+
+	response, err := c.evalRunService.CompareEvalRuns(ctx, userIdpId, projectRef(r), baseRunId, candidateRunId)
+	if err != nil {
+		log.Error("CompareEvalRuns: failed to compare evaluation runs", "baseRunId", baseRunId, "candidateRunId", candidateRunId, "error", err)
+		writeEvalRunError(w, err, "Failed to compare evaluation runs")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// validateCreateEvalRunRequest checks a run request and returns a message describing the first problem found.
+// Evaluator specific settings are checked when the evaluators are created.
+func validateCreateEvalRunRequest(req *models.CreateEvalRunRequest) string {
+	if req.DatasetName == "" {
+		return "datasetName is required"
+	}
+	if req.DatasetVersion < 0 {
+		return "datasetVersion must be a positive integer"
+	}
+	if req.AgentName == "" {
+		return "agentName is required"
+	}
+	if req.Environment == "" {
+		return "environment is required"
+	}
+	if req.Path != "" && !strings.HasPrefix(req.Path, "/") {
+		return "path must start with /"
+	}
+	maxConcurrency := config.GetConfig().Evaluation.MaxConcurrency
+	if req.Concurrency < 0 || req.Concurrency > maxConcurrency {
+		return fmt.Sprintf("concurrency must be between 1 and %d", maxConcurrency)
+	}
+	if len(req.Evaluators) == 0 || len(req.Evaluators) > utils.EvalRunMaxEvaluators {
+		return fmt.Sprintf("evaluators must have between 1 and %d entries", utils.EvalRunMaxEvaluators)
+	}
+	for i, evaluator := range req.Evaluators {
+		if evaluator.Type == "" {
+			return fmt.Sprintf("evaluators[%d].type is required", i)
+		}
+		if len(evaluator.Name) > utils.TraceAnnotationMaxLabelLength {
+			return fmt.Sprintf("evaluators[%d].name must be at most %d characters", i, utils.TraceAnnotationMaxLabelLength)
+		}
+	}
+	return ""
+}
+
+func writeEvalRunError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrEvalDatasetNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Dataset not found")
+	case errors.Is(err, utils.ErrEvalRunNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Evaluation run not found")
+	case errors.Is(err, utils.ErrEnvironmentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+	case errors.Is(err, utils.ErrAgentNotInternal):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Evaluation runs are only supported for agents deployed by the platform")
+	case errors.Is(err, utils.ErrAgentEndpointNotFound):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Agent endpoint not found. Set endpointName to one of the agent's endpoints when it has more than one.")
+	case errors.Is(err, utils.ErrInvalidEvaluator):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, utils.ErrEvalRunNotFinished):
+		utils.WriteErrorResponse(w, http.StatusConflict, "Both evaluation runs must have finished to be compared")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type JobRunController interface {
+	TriggerJobRun(w http.ResponseWriter, r *http.Request)
+	ListJobRuns(w http.ResponseWriter, r *http.Request)
+	GetJobRunLogs(w http.ResponseWriter, r *http.Request)
+}
+
+type jobRunController struct {
+	jobRunService services.JobRunManagerService
+}
+
+// NewJobRunController returns a new JobRunController instance.
+func NewJobRunController(jobRunService services.JobRunManagerService) JobRunController {
+	return &jobRunController{
+		jobRunService: jobRunService,
+	}
+}
+
+// TriggerJobRun starts a run of a job agent outside its schedule. The run is returned as soon as it is
+// submitted, so clients poll ListJobRuns for its status.
+func (c *jobRunController) TriggerJobRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload spec.TriggerJobRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("TriggerJobRun: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if payload.Environment == "" {
+		log.Error("TriggerJobRun: environment is required in request body")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "environment is required")
+		return
+	}
+
+	run, err := c.jobRunService.TriggerJobRun(ctx, userIdpId, orgName, projName, agentName, payload.Environment)
+	if err != nil {
+		log.Error("TriggerJobRun: failed to trigger job run", "agentName", agentName, "environment", payload.Environment, "error", err)
+		writeJobRunError(w, err, "Failed to trigger job run")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, run)
+}
+
+func (c *jobRunController) ListJobRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ListJobRuns: missing required query parameter 'environment'")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	runs, err := c.jobRunService.ListJobRuns(ctx, userIdpId, orgName, projName, agentName, environment)
+	if err != nil {
+		log.Error("ListJobRuns: failed to list job runs", "agentName", agentName, "environment", environment, "error", err)
+		writeJobRunError(w, err, "Failed to list job runs")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, runs)
+}
+
+func (c *jobRunController) GetJobRunLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	runName := r.PathValue(utils.PathParamJobRunName)
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetJobRunLogs: missing required query parameter 'environment'")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	logs, err := c.jobRunService.GetJobRunLogs(ctx, userIdpId, orgName, projName, agentName, runName, environment)
+	if err != nil {
+		log.Error("GetJobRunLogs: failed to get job run logs", "agentName", agentName, "runName", runName, "environment", environment, "error", err)
+		writeJobRunError(w, err, "Failed to get job run logs")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, logs)
+}
+
+func writeJobRunError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrEnvironmentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+	case errors.Is(err, utils.ErrJobRunNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Job run not found")
+	case errors.Is(err, utils.ErrAgentNotJob):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Job runs are only supported for job agents")
+	case errors.Is(err, utils.ErrAgentNotDeployed):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Agent is not deployed to the environment")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
@@ -17,10 +17,16 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
@@ -28,6 +34,12 @@ import (
 type ObservabilityController interface {
 	ListTraces(w http.ResponseWriter, r *http.Request)
 	GetTrace(w http.ResponseWriter, r *http.Request)
+	GetUsageReport(w http.ResponseWriter, r *http.Request)
+	ListSessions(w http.ResponseWriter, r *http.Request)
+	GetSessionTraces(w http.ResponseWriter, r *http.Request)
+	ExportTrace(w http.ResponseWriter, r *http.Request)
+	ExportTraces(w http.ResponseWriter, r *http.Request)
+	TailTraces(w http.ResponseWriter, r *http.Request)
 }
 
 type observabilityController struct {
@@ -46,6 +58,8 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 	log := logger.GetLogger(ctx)
 
 	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
 	agentName := r.PathValue(utils.PathParamAgentName)
 
 	// Parse query parameters
@@ -85,22 +99,35 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid sortOrder parameter: must be 'asc' or 'desc'")
 		return
 	}
+	label := strings.TrimSpace(r.URL.Query().Get("label"))
+	if len(label) > utils.TraceAnnotationMaxLabelLength {
+		log.Error("ListTraces: invalid label parameter", "label", label)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid label parameter: must be at most %d characters", utils.TraceAnnotationMaxLabelLength))
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
 
 	// Build parameters for the service
 	params := services.ListTracesRequest{
+		OrgName:     orgName,
+		ProjectName: projName,
 		ServiceName: agentName,
 		StartTime:   startTime,
 		EndTime:     endTime,
 		Limit:       limit,
 		Offset:      offset,
 		SortOrder:   sortOrder,
+		Label:       label,
 	}
 
 	// Call the service
-	response, err := c.observabilityService.ListTraces(ctx, params)
+	response, err := c.observabilityService.ListTraces(ctx, userIdpId, params)
 	if err != nil {
 		log.Error("ListTraces: failed to list traces", "serviceName", agentName, "error", err)
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve traces")
+		writeAgentTraceScopeError(w, err, "Failed to retrieve traces")
 		return
 	}
 
@@ -116,20 +143,567 @@ func (c *observabilityController) GetTrace(w http.ResponseWriter, r *http.Reques
 	agentName := r.PathValue(utils.PathParamAgentName)
 	traceID := r.PathValue(utils.PathParamTraceId)
 
+	// The tree view is built by the trace observer for the agent's component in an environment
+	view := r.URL.Query().Get("view")
+	if view != "" && view != "flat" && view != "tree" {
+		log.Error("GetTrace: invalid view parameter", "view", view)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid view parameter: must be 'flat' or 'tree'")
+		return
+	}
+	if view == "tree" {
+		c.getTraceTree(w, r, traceID)
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
 	// Build parameters for the service
 	params := services.TraceDetailsRequest{
+		OrgName:     r.PathValue(utils.PathParamOrgName),
+		ProjectName: r.PathValue(utils.PathParamProjName),
 		TraceID:     traceID,
 		ServiceName: agentName,
 	}
 
 	// Call the service
-	response, err := c.observabilityService.GetTraceDetails(ctx, params)
+	response, err := c.observabilityService.GetTraceDetails(ctx, userIdpId, params)
 	if err != nil {
 		log.Error("GetTrace: failed to get trace details", "traceId", traceID, "serviceName", agentName, "error", err)
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve trace details")
+		writeAgentTraceScopeError(w, err, "Failed to retrieve trace details")
 		return
 	}
 
 	log.Info("GetTrace: successfully retrieved trace details", "traceId", traceID, "serviceName", agentName, "spanCount", response.TotalCount)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// getTraceTree writes the span tree of a trace for the tree view of GetTrace
+func (c *observabilityController) getTraceTree(w http.ResponseWriter, r *http.Request, traceID string) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetTrace: missing environment parameter for tree view")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	response, err := c.observabilityService.GetTraceTree(ctx, userIdpId, services.TraceTreeRequest{
+		AgentTraceScope: services.AgentTraceScope{
+			OrgName:     orgName,
+			ProjectName: projName,
+			AgentName:   agentName,
+			Environment: environment,
+		},
+		TraceID: traceID,
+	})
+	if err != nil {
+		log.Error("GetTrace: failed to get trace tree", "traceId", traceID, "agentName", agentName, "error", err)
+		writeAgentTraceScopeError(w, err, "Failed to retrieve trace details")
+		return
+	}
+
+	log.Info("GetTrace: successfully retrieved trace tree", "traceId", traceID, "agentName", agentName, "spanCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetUsageReport serves org, project and agent level usage reports depending on the path parameters of the route
+func (c *observabilityController) GetUsageReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters - projName and agentName are empty for the broader scopes
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	// Parse and validate the reporting period
+	startTime := r.URL.Query().Get("startTime")
+	endTime := r.URL.Query().Get("endTime")
+	if startTime == "" || endTime == "" {
+		log.Error("GetUsageReport: missing startTime or endTime", "startTime", startTime, "endTime", endTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameters 'startTime' and 'endTime'")
+		return
+	}
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime parameter: must be in RFC3339 format")
+		return
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime parameter: must be in RFC3339 format")
+		return
+	}
+	if start.After(end) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid time range: startTime must be before endTime")
+		return
+	}
+
+	groupBy, err := utils.ParseUsageGroupBy(r.URL.Query().Get("groupBy"))
+	if err != nil {
+		log.Error("GetUsageReport: invalid groupBy parameter", "groupBy", r.URL.Query().Get("groupBy"), "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid groupBy parameter: %s", err.Error()))
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = utils.UsageReportFormatJSON
+	}
+	if format != utils.UsageReportFormatJSON && format != utils.UsageReportFormatCSV {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid format parameter: must be 'json' or 'csv'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	report, err := c.observabilityService.GetUsageReport(ctx, userIdpId, services.UsageReportRequest{
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		GroupBy:     groupBy,
+	})
+	if err != nil {
+		log.Error("GetUsageReport: failed to get usage report", "orgName", orgName, "projectName", projName, "agentName", agentName, "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve usage report")
+		return
+	}
+
+	if format == utils.UsageReportFormatCSV {
+		utils.WriteCSVResponse(w, http.StatusOK, "usage-report.csv", usageReportToCSV(report))
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// ListSessions lists the conversations of an agent in an environment
+func (c *observabilityController) ListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ListSessions: missing environment parameter")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Parse query parameters
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		limitStr = "10"
+	}
+	offsetStr := r.URL.Query().Get("offset")
+	if offsetStr == "" {
+		offsetStr = "0"
+	}
+
+	// Parse and validate pagination parameters
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < 1 || limit > 100 {
+		log.Error("ListSessions: invalid limit parameter", "limit", limitStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter: must be between 1 and 100")
+		return
+	}
+
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		log.Error("ListSessions: invalid offset parameter", "offset", offsetStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter: must be 0 or greater")
+		return
+	}
+
+	startTime, endTime, errMsg := parseSessionTimeRange(r)
+	if errMsg != "" {
+		log.Error("ListSessions: invalid time range", "startTime", startTime, "endTime", endTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	response, err := c.observabilityService.ListSessions(ctx, userIdpId, services.ListSessionsRequest{
+		AgentTraceScope: services.AgentTraceScope{
+			OrgName:     orgName,
+			ProjectName: projName,
+			AgentName:   agentName,
+			Environment: environment,
+		},
+		StartTime: startTime,
+		EndTime:   endTime,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		log.Error("ListSessions: failed to list sessions", "agentName", agentName, "environment", environment, "error", err)
+		writeAgentTraceScopeError(w, err, "Failed to retrieve sessions")
+		return
+	}
+
+	log.Info("ListSessions: successfully retrieved sessions", "agentName", agentName, "totalCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetSessionTraces returns the traces of a session, one per conversation turn
+func (c *observabilityController) GetSessionTraces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sessionID := r.PathValue(utils.PathParamSessionId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetSessionTraces: missing environment parameter")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	startTime, endTime, errMsg := parseSessionTimeRange(r)
+	if errMsg != "" {
+		log.Error("GetSessionTraces: invalid time range", "startTime", startTime, "endTime", endTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	response, err := c.observabilityService.GetSessionTraces(ctx, userIdpId, services.SessionTracesRequest{
+		AgentTraceScope: services.AgentTraceScope{
+			OrgName:     orgName,
+			ProjectName: projName,
+			AgentName:   agentName,
+			Environment: environment,
+		},
+		SessionID: sessionID,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		log.Error("GetSessionTraces: failed to get session traces", "sessionId", sessionID, "agentName", agentName, "error", err)
+		writeAgentTraceScopeError(w, err, "Failed to retrieve session traces")
+		return
+	}
+
+	log.Info("GetSessionTraces: successfully retrieved session traces", "sessionId", sessionID, "traceCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// ExportTrace downloads a trace in OTLP JSON or Jaeger format
+func (c *observabilityController) ExportTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ExportTrace: missing environment parameter")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	format, errMsg := parseTraceExportFormat(r)
+	if errMsg != "" {
+		log.Error("ExportTrace: invalid format parameter", "format", format)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	exported, err := c.observabilityService.ExportTrace(ctx, userIdpId, services.ExportTraceRequest{
+		AgentTraceScope: services.AgentTraceScope{
+			OrgName:     orgName,
+			ProjectName: projName,
+			AgentName:   agentName,
+			Environment: environment,
+		},
+		TraceID: traceID,
+		Format:  format,
+	})
+	if err != nil {
+		log.Error("ExportTrace: failed to export trace", "traceId", traceID, "agentName", agentName, "error", err)
+		if errors.Is(err, utils.ErrTraceNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Trace not found")
+			return
+		}
+		writeAgentTraceScopeError(w, err, "Failed to export trace")
+		return
+	}
+
+	log.Info("ExportTrace: successfully exported trace", "traceId", traceID, "format", format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("trace-%s.json", traceID)))
+	utils.WriteSuccessResponse(w, http.StatusOK, exported)
+}
+
+// ExportTraces streams all traces of an agent in a time range as NDJSON, one trace per line
+func (c *observabilityController) ExportTraces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ExportTraces: missing environment parameter")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// A bulk export always needs a bounded time range
+	if r.URL.Query().Get("startTime") == "" || r.URL.Query().Get("endTime") == "" {
+		log.Error("ExportTraces: missing startTime or endTime")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameters 'startTime' and 'endTime'")
+		return
+	}
+	startTime, endTime, errMsg := parseSessionTimeRange(r)
+	if errMsg != "" {
+		log.Error("ExportTraces: invalid time range", "startTime", startTime, "endTime", endTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	format, errMsg := parseTraceExportFormat(r)
+	if errMsg != "" {
+		log.Error("ExportTraces: invalid format parameter", "format", format)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	stream, err := c.observabilityService.ExportTraces(ctx, userIdpId, services.ExportTracesRequest{
+		AgentTraceScope: services.AgentTraceScope{
+			OrgName:     orgName,
+			ProjectName: projName,
+			AgentName:   agentName,
+			Environment: environment,
+		},
+		StartTime: startTime,
+		EndTime:   endTime,
+		Format:    format,
+	})
+	if err != nil {
+		log.Error("ExportTraces: failed to export traces", "agentName", agentName, "environment", environment, "error", err)
+		writeAgentTraceScopeError(w, err, "Failed to export traces")
+		return
+	}
+	defer stream.Close()
+
+	// Large exports outlive the server write timeout, so lift it for this response only
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Warn("ExportTraces: failed to clear write deadline", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-traces.ndjson", agentName)))
+	w.WriteHeader(http.StatusOK)
+
+	if err := utils.CopyAndFlush(rc, w, stream); err != nil {
+		log.Error("ExportTraces: failed to stream traces", "agentName", agentName, "error", err)
+		return
+	}
+	log.Info("ExportTraces: successfully exported traces", "agentName", agentName, "format", format)
+}
+
+func (c *observabilityController) TailTraces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("TailTraces: missing environment parameter")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing required query parameter 'environment'")
+		return
+	}
+
+	// Root span attribute filters, passed through to the observer as key=value pairs
+	attributes := r.URL.Query()["attribute"]
+	for _, attribute := range attributes {
+		if key, _, ok := strings.Cut(attribute, "="); !ok || key == "" {
+			log.Error("TailTraces: invalid attribute parameter", "attribute", attribute)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid attribute parameter: must be in the form key=value")
+			return
+		}
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	stream, err := c.observabilityService.TailTraces(ctx, userIdpId, services.TailTracesRequest{
+		AgentTraceScope: services.AgentTraceScope{
+			OrgName:     orgName,
+			ProjectName: projName,
+			AgentName:   agentName,
+			Environment: environment,
+		},
+		Attributes: attributes,
+	})
+	if err != nil {
+		log.Error("TailTraces: failed to tail traces", "agentName", agentName, "environment", environment, "error", err)
+		writeAgentTraceScopeError(w, err, "Failed to tail traces")
+		return
+	}
+	defer stream.Close()
+
+	// The tail is streamed until the client disconnects, so lift the server write timeout for this response only
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Warn("TailTraces: failed to clear write deadline", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := utils.CopyAndFlush(rc, w, stream); err != nil && ctx.Err() == nil {
+		log.Error("TailTraces: failed to stream traces", "agentName", agentName, "error", err)
+		return
+	}
+	log.Info("TailTraces: trace tail ended", "agentName", agentName, "environment", environment)
+}
+
+// parseTraceExportFormat reads the optional format query parameter, defaulting to OTLP JSON
+func parseTraceExportFormat(r *http.Request) (string, string) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = utils.TraceExportFormatOTLPJSON
+	}
+	if format != utils.TraceExportFormatOTLPJSON && format != utils.TraceExportFormatJaeger {
+		return format, fmt.Sprintf("Invalid format parameter: must be '%s' or '%s'", utils.TraceExportFormatOTLPJSON, utils.TraceExportFormatJaeger)
+	}
+	return format, ""
+}
+
+// parseSessionTimeRange reads the optional startTime and endTime query parameters.
+// The observer defaults missing bounds, so only the format and ordering are checked here.
+func parseSessionTimeRange(r *http.Request) (string, string, string) {
+	startTime := r.URL.Query().Get("startTime")
+	endTime := r.URL.Query().Get("endTime")
+
+	var start, end time.Time
+	var err error
+	if startTime != "" {
+		if start, err = time.Parse(time.RFC3339, startTime); err != nil {
+			return startTime, endTime, "Invalid startTime parameter: must be in RFC3339 format"
+		}
+	}
+	if endTime != "" {
+		if end, err = time.Parse(time.RFC3339, endTime); err != nil {
+			return startTime, endTime, "Invalid endTime parameter: must be in RFC3339 format"
+		}
+	}
+	if startTime != "" && endTime != "" && start.After(end) {
+		return startTime, endTime, "Invalid time range: startTime must be before endTime"
+	}
+	return startTime, endTime, ""
+}
+
+// writeAgentTraceScopeError maps errors from resolving an agent's traces to a response
+func writeAgentTraceScopeError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrEnvironmentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
+
+// usageReportToCSV converts a usage report into CSV records with one column per groupBy dimension
+func usageReportToCSV(report *models.UsageReportResponse) [][]string {
+	header := append([]string{}, report.GroupBy...)
+	header = append(header, "inputTokens", "outputTokens", "totalTokens", "estimatedCost", "currency")
+
+	records := [][]string{header}
+	for _, item := range report.Items {
+		record := make([]string, 0, len(header))
+		for _, dimension := range report.GroupBy {
+			switch utils.UsageGroupBy(dimension) {
+			case utils.UsageGroupByOrg:
+				record = append(record, item.OrgName)
+			case utils.UsageGroupByProject:
+				record = append(record, item.ProjectName)
+			case utils.UsageGroupByAgent:
+				record = append(record, item.AgentName)
+			case utils.UsageGroupByModel:
+				record = append(record, item.Model)
+			}
+		}
+		record = append(record,
+			strconv.FormatInt(item.InputTokens, 10),
+			strconv.FormatInt(item.OutputTokens, 10),
+			strconv.FormatInt(item.TotalTokens, 10),
+			strconv.FormatFloat(item.EstimatedCost, 'f', 6, 64),
+			report.Currency,
+		)
+		records = append(records, record)
+	}
+	return records
+}
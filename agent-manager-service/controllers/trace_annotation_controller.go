@@ -0,0 +1,218 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type TraceAnnotationController interface {
+	CreateTraceAnnotation(w http.ResponseWriter, r *http.Request)
+	ListTraceAnnotations(w http.ResponseWriter, r *http.Request)
+	UpdateTraceAnnotation(w http.ResponseWriter, r *http.Request)
+	DeleteTraceAnnotation(w http.ResponseWriter, r *http.Request)
+}
+
+type traceAnnotationController struct {
+	traceAnnotationService services.TraceAnnotationManagerService
+}
+
+// NewTraceAnnotationController returns a new TraceAnnotationController instance.
+func NewTraceAnnotationController(traceAnnotationService services.TraceAnnotationManagerService) TraceAnnotationController {
+	return &traceAnnotationController{
+		traceAnnotationService: traceAnnotationService,
+	}
+}
+
+func (c *traceAnnotationController) CreateTraceAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	traceId, ok := traceIdFromPath(w, r)
+	if !ok {
+		return
+	}
+	payload, ok := decodeTraceAnnotationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := c.traceAnnotationService.CreateTraceAnnotation(ctx, userIdpId, agentRef(r), traceId, payload)
+	if err != nil {
+		log.Error("CreateTraceAnnotation: failed to create trace annotation", "traceId", traceId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to create trace annotation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *traceAnnotationController) ListTraceAnnotations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	traceId, ok := traceIdFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := c.traceAnnotationService.ListTraceAnnotations(ctx, userIdpId, agentRef(r), traceId, r.URL.Query().Get("spanId"))
+	if err != nil {
+		log.Error("ListTraceAnnotations: failed to list trace annotations", "traceId", traceId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to list trace annotations")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *traceAnnotationController) UpdateTraceAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	traceId, ok := traceIdFromPath(w, r)
+	if !ok {
+		return
+	}
+	annotationId, err := uuid.Parse(r.PathValue(utils.PathParamAnnotationId))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Trace annotation not found")
+		return
+	}
+	payload, ok := decodeTraceAnnotationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	response, err := c.traceAnnotationService.UpdateTraceAnnotation(ctx, userIdpId, agentRef(r), traceId, annotationId, payload)
+	if err != nil {
+		log.Error("UpdateTraceAnnotation: failed to update trace annotation", "annotationId", annotationId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to update trace annotation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *traceAnnotationController) DeleteTraceAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	traceId, ok := traceIdFromPath(w, r)
+	if !ok {
+		return
+	}
+	annotationId, err := uuid.Parse(r.PathValue(utils.PathParamAnnotationId))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Trace annotation not found")
+		return
+	}
+
+	if err := c.traceAnnotationService.DeleteTraceAnnotation(ctx, userIdpId, agentRef(r), traceId, annotationId); err != nil {
+		log.Error("DeleteTraceAnnotation: failed to delete trace annotation", "annotationId", annotationId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to delete trace annotation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+// traceIdFromPath reads the trace ID path parameter, writing a 400 response if it is too long to be stored
+func traceIdFromPath(w http.ResponseWriter, r *http.Request) (string, bool) {
+	traceId := r.PathValue(utils.PathParamTraceId)
+	if len(traceId) > utils.TraceAnnotationMaxTraceIDLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("traceId must be at most %d characters", utils.TraceAnnotationMaxTraceIDLength))
+		return "", false
+	}
+	return traceId, true
+}
+
+// decodeTraceAnnotationRequest decodes and validates an annotation request body, writing a 400 response if it is invalid
+func decodeTraceAnnotationRequest(w http.ResponseWriter, r *http.Request) (models.TraceAnnotationRequest, bool) {
+	log := logger.GetLogger(r.Context())
+
+	var payload models.TraceAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("failed to decode trace annotation request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return payload, false
+	}
+	payload.SpanID = strings.TrimSpace(payload.SpanID)
+	payload.Label = strings.TrimSpace(payload.Label)
+	payload.Comment = strings.TrimSpace(payload.Comment)
+
+	if payload.Score == nil && payload.Label == "" && payload.Comment == "" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "At least one of score, label or comment is required")
+		return payload, false
+	}
+	if len(payload.SpanID) > utils.TraceAnnotationMaxSpanIDLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("spanId must be at most %d characters", utils.TraceAnnotationMaxSpanIDLength))
+		return payload, false
+	}
+	if len(payload.Label) > utils.TraceAnnotationMaxLabelLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("label must be at most %d characters", utils.TraceAnnotationMaxLabelLength))
+		return payload, false
+	}
+	if len(payload.Comment) > utils.TraceAnnotationMaxCommentLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("comment must be at most %d characters", utils.TraceAnnotationMaxCommentLength))
+		return payload, false
+	}
+	return payload, true
+}
+
+func writeTraceAnnotationError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrTraceAnnotationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Trace annotation not found")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
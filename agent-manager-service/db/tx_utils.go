@@ -21,6 +21,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
@@ -52,3 +53,12 @@ func CtxWithTx(ctx context.Context, tx *gorm.DB) context.Context {
 func IsRecordNotFoundError(err error) bool {
 	return errors.Is(err, gorm.ErrRecordNotFound)
 }
+
+// uniqueViolationCode is the PostgreSQL error code of a unique constraint violation
+const uniqueViolationCode = "23505"
+
+// IsUniqueViolationError reports whether err is caused by a row violating the given unique constraint
+func IsUniqueViolationError(err error, constraintName string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == constraintName
+}
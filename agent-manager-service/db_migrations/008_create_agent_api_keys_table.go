@@ -0,0 +1,56 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table agent_api_keys
+var migration008 = migration{
+	ID: 8,
+	Migrate: func(db *gorm.DB) error {
+		createTable := `CREATE TABLE agent_api_keys
+(
+   id               UUID PRIMARY KEY,
+   agent_id         UUID NOT NULL,
+   name             VARCHAR(100) NOT NULL,
+   environment      VARCHAR(100) NOT NULL,
+   key_prefix       VARCHAR(20) NOT NULL,
+   key_hash         VARCHAR(64) NOT NULL,
+   project_uid      VARCHAR(100) NOT NULL,
+   component_uid    VARCHAR(100) NOT NULL,
+   environment_uid  VARCHAR(100) NOT NULL,
+   created_by       UUID NOT NULL,
+   created_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   rotated_at       TIMESTAMPTZ,
+   last_used_at     TIMESTAMPTZ,
+   revoked_at       TIMESTAMPTZ,
+   CONSTRAINT fk_agent_api_keys_agent_id FOREIGN KEY (agent_id) REFERENCES agents(id) ON DELETE CASCADE
+)`
+
+		createHashIndex := `CREATE UNIQUE INDEX uk_agent_api_keys_key_hash ON agent_api_keys(key_hash)`
+		createAgentIndex := `CREATE INDEX idx_agent_api_keys_agent_environment ON agent_api_keys(agent_id, environment) WHERE revoked_at IS NULL`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createTable, createHashIndex, createAgentIndex); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
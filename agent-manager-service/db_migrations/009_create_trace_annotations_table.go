@@ -0,0 +1,52 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table trace_annotations
+var migration009 = migration{
+	ID: 9,
+	Migrate: func(db *gorm.DB) error {
+		createTable := `CREATE TABLE trace_annotations
+(
+   id          UUID PRIMARY KEY,
+   agent_id    UUID NOT NULL,
+   trace_id    VARCHAR(64) NOT NULL,
+   span_id     VARCHAR(32) NOT NULL DEFAULT '',
+   score       DOUBLE PRECISION,
+   label       VARCHAR(100) NOT NULL DEFAULT '',
+   comment     TEXT NOT NULL DEFAULT '',
+   created_by  UUID NOT NULL,
+   created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_trace_annotations_agent_id FOREIGN KEY (agent_id) REFERENCES agents(id) ON DELETE CASCADE
+)`
+
+		createTraceIndex := `CREATE INDEX idx_trace_annotations_agent_trace ON trace_annotations(agent_id, trace_id)`
+		createLabelIndex := `CREATE INDEX idx_trace_annotations_agent_label ON trace_annotations(agent_id, label) WHERE label <> ''`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createTable, createTraceIndex, createLabelIndex); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
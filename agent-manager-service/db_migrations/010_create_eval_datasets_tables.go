@@ -0,0 +1,60 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create tables eval_datasets and eval_dataset_items
+var migration010 = migration{
+	ID: 10,
+	Migrate: func(db *gorm.DB) error {
+		createDatasetsTable := `CREATE TABLE eval_datasets
+(
+   id           UUID PRIMARY KEY,
+   project_id   UUID NOT NULL,
+   name         VARCHAR(100) NOT NULL,
+   version      INTEGER NOT NULL,
+   description  TEXT NOT NULL DEFAULT '',
+   created_by   UUID NOT NULL,
+   created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT uq_eval_datasets_project_name_version UNIQUE (project_id, name, version),
+   CONSTRAINT fk_eval_datasets_project_id FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+)`
+
+		createItemsTable := `CREATE TABLE eval_dataset_items
+(
+   id           UUID PRIMARY KEY,
+   dataset_id   UUID NOT NULL,
+   position     INTEGER NOT NULL,
+   trace_id     VARCHAR(64) NOT NULL DEFAULT '',
+   agent_name   VARCHAR(100) NOT NULL DEFAULT '',
+   input        TEXT NOT NULL,
+   output       TEXT NOT NULL DEFAULT '',
+   CONSTRAINT uq_eval_dataset_items_dataset_position UNIQUE (dataset_id, position),
+   CONSTRAINT fk_eval_dataset_items_dataset_id FOREIGN KEY (dataset_id) REFERENCES eval_datasets(id) ON DELETE CASCADE
+)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createDatasetsTable, createItemsTable); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
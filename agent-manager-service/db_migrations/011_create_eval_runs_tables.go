@@ -0,0 +1,73 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create tables eval_runs and eval_run_results
+var migration011 = migration{
+	ID: 11,
+	Migrate: func(db *gorm.DB) error {
+		createRunsTable := `CREATE TABLE eval_runs
+(
+   id               UUID PRIMARY KEY,
+   project_id       UUID NOT NULL,
+   dataset_name     VARCHAR(100) NOT NULL,
+   dataset_version  INTEGER NOT NULL,
+   agent_name       VARCHAR(100) NOT NULL,
+   environment      VARCHAR(100) NOT NULL,
+   endpoint_url     TEXT NOT NULL,
+   status           VARCHAR(20) NOT NULL,
+   concurrency      INTEGER NOT NULL,
+   evaluators       JSONB NOT NULL,
+   total_items      INTEGER NOT NULL,
+   summary          JSONB,
+   error            TEXT NOT NULL DEFAULT '',
+   created_by       UUID NOT NULL,
+   created_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   completed_at     TIMESTAMPTZ,
+   CONSTRAINT fk_eval_runs_project_id FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+)`
+
+		createRunsIndex := `CREATE INDEX idx_eval_runs_project_created_at ON eval_runs(project_id, created_at DESC)`
+
+		createResultsTable := `CREATE TABLE eval_run_results
+(
+   id               UUID PRIMARY KEY,
+   run_id           UUID NOT NULL,
+   position         INTEGER NOT NULL,
+   input            TEXT NOT NULL,
+   expected_output  TEXT NOT NULL DEFAULT '',
+   output           TEXT NOT NULL DEFAULT '',
+   error            TEXT NOT NULL DEFAULT '',
+   latency_ms       BIGINT NOT NULL DEFAULT 0,
+   scores           JSONB NOT NULL,
+   passed           BOOLEAN NOT NULL DEFAULT FALSE,
+   CONSTRAINT uq_eval_run_results_run_position UNIQUE (run_id, position),
+   CONSTRAINT fk_eval_run_results_run_id FOREIGN KEY (run_id) REFERENCES eval_runs(id) ON DELETE CASCADE
+)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createRunsTable, createRunsIndex, createResultsTable); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
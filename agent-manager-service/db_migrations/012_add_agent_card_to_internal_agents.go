@@ -0,0 +1,36 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// add agent_card column to internal_agents for a2a agents
+var migration012 = migration{
+	ID: 12,
+	Migrate: func(db *gorm.DB) error {
+		addAgentCardColumn := `ALTER TABLE internal_agents ADD COLUMN agent_card JSONB`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, addAgentCardColumn); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
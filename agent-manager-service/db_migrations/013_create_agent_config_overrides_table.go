@@ -0,0 +1,48 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table agent_config_overrides
+var migration013 = migration{
+	ID: 13,
+	Migrate: func(db *gorm.DB) error {
+		createTable := `CREATE TABLE agent_config_overrides
+(
+   id           UUID PRIMARY KEY,
+   agent_id     UUID NOT NULL,
+   environment  VARCHAR(100) NOT NULL,
+   key          VARCHAR(255) NOT NULL,
+   value        TEXT NOT NULL,
+   created_by   UUID NOT NULL,
+   created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_agent_config_overrides_agent_id FOREIGN KEY (agent_id) REFERENCES agents(id) ON DELETE CASCADE,
+   CONSTRAINT uk_agent_config_overrides_agent_environment_key UNIQUE (agent_id, environment, key)
+)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createTable); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
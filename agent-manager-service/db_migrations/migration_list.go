@@ -16,7 +16,7 @@
 
 package dbmigrations
 
-const latestVersion = 7
+const latestVersion = 13
 
 // migration list sorted by version.  Add new migrations to the end of the list.
 // Previous migrations should not be modified.
@@ -28,4 +28,10 @@ var migrations = []migration{
 	migration005,
 	migration006,
 	migration007,
+	migration008,
+	migration009,
+	migration010,
+	migration011,
+	migration012,
+	migration013,
 }
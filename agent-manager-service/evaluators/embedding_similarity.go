@@ -0,0 +1,86 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluators
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// embeddingSimilarityEvaluator scores outputs by the cosine similarity of their embedding to the embedding of the
+// expected output, as computed by the configured embeddings endpoint
+type embeddingSimilarityEvaluator struct {
+	client    evaluationsvc.EvaluationClient
+	threshold float64
+}
+
+func newEmbeddingSimilarityEvaluator(cfg models.EvaluatorConfig, client evaluationsvc.EvaluationClient) (Evaluator, error) {
+	if config.GetConfig().Evaluation.Embedding.URL == "" {
+		return nil, fmt.Errorf("no embeddings endpoint is configured, set EVAL_EMBEDDING_URL")
+	}
+	threshold, err := passThreshold(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &embeddingSimilarityEvaluator{client: client, threshold: threshold}, nil
+}
+
+func (e *embeddingSimilarityEvaluator) Evaluate(ctx context.Context, sample Sample) (Result, error) {
+	if strings.TrimSpace(sample.Expected) == "" {
+		return Result{}, fmt.Errorf("item has no expected output")
+	}
+	if strings.TrimSpace(sample.Output) == "" {
+		return binaryResult(false, "output is empty"), nil
+	}
+	embeddings, err := e.client.CreateEmbeddings(ctx, []string{sample.Output, sample.Expected})
+	if err != nil {
+		return Result{}, err
+	}
+	similarity, err := cosineSimilarity(embeddings[0], embeddings[1])
+	if err != nil {
+		return Result{}, err
+	}
+	// Negative similarities are scored as 0 to keep scores between 0 and 1
+	score := math.Max(similarity, 0)
+	return Result{
+		Score:  score,
+		Passed: score >= e.threshold,
+		Reason: fmt.Sprintf("cosine similarity %.3f, threshold %.3f", similarity, e.threshold),
+	}, nil
+}
+
+func cosineSimilarity(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("embeddings have different dimensions %d and %d", len(a), len(b))
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0, nil
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package evaluators scores the outputs an agent produces for dataset items during offline evaluation runs.
+// Evaluators are created from their run configuration through a registry keyed by evaluator type, so new
+// evaluators can be added by registering a Factory.
+package evaluators
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Sample is a dataset item together with the output the agent produced for it
+type Sample struct {
+	Input string
+	// Output recorded in the dataset, empty when the item has none
+	Expected string
+	Output   string
+}
+
+// Result is the score of a sample, between 0 and 1
+type Result struct {
+	Score  float64
+	Passed bool
+	Reason string
+}
+
+type Evaluator interface {
+	// Evaluate scores a sample. An error means the sample could not be scored, not that it failed.
+	Evaluate(ctx context.Context, sample Sample) (Result, error)
+}
+
+// Factory creates an evaluator from its configuration, returning an error when the configuration is invalid
+type Factory func(cfg models.EvaluatorConfig, client evaluationsvc.EvaluationClient) (Evaluator, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[utils.EvaluatorType]Factory{
+		utils.EvaluatorTypeExactMatch:          newExactMatchEvaluator,
+		utils.EvaluatorTypeRegex:               newRegexEvaluator,
+		utils.EvaluatorTypeJSONSchema:          newJSONSchemaEvaluator,
+		utils.EvaluatorTypeEmbeddingSimilarity: newEmbeddingSimilarityEvaluator,
+		utils.EvaluatorTypeLLMJudge:            newLLMJudgeEvaluator,
+	}
+)
+
+// Register adds an evaluator type, replacing any evaluator already registered for it
+func Register(evaluatorType utils.EvaluatorType, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[evaluatorType] = factory
+}
+
+// New creates the evaluator configured by cfg
+func New(cfg models.EvaluatorConfig, client evaluationsvc.EvaluationClient) (Evaluator, error) {
+	registryMu.RLock()
+	factory, ok := registry[utils.EvaluatorType(cfg.Type)]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown evaluator type %q", cfg.Type)
+	}
+	return factory(cfg, client)
+}
+
+// passThreshold returns the configured threshold of a graded evaluator, or the default one
+func passThreshold(cfg models.EvaluatorConfig) (float64, error) {
+	if cfg.Threshold == nil {
+		return utils.EvalRunDefaultThreshold, nil
+	}
+	if *cfg.Threshold < 0 || *cfg.Threshold > 1 {
+		return 0, fmt.Errorf("threshold must be between 0 and 1")
+	}
+	return *cfg.Threshold, nil
+}
+
+// binaryResult scores a pass as 1 and a failure as 0
+func binaryResult(passed bool, reason string) Result {
+	if passed {
+		return Result{Score: 1, Passed: true, Reason: reason}
+	}
+	return Result{Score: 0, Passed: false, Reason: reason}
+}
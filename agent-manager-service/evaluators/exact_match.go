@@ -0,0 +1,47 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// exactMatchEvaluator passes outputs that equal the expected output, ignoring surrounding whitespace
+type exactMatchEvaluator struct {
+	ignoreCase bool
+}
+
+func newExactMatchEvaluator(cfg models.EvaluatorConfig, _ evaluationsvc.EvaluationClient) (Evaluator, error) {
+	return &exactMatchEvaluator{ignoreCase: cfg.IgnoreCase}, nil
+}
+
+func (e *exactMatchEvaluator) Evaluate(ctx context.Context, sample Sample) (Result, error) {
+	expected := strings.TrimSpace(sample.Expected)
+	if expected == "" {
+		return Result{}, fmt.Errorf("item has no expected output")
+	}
+	output := strings.TrimSpace(sample.Output)
+	if e.ignoreCase {
+		return binaryResult(strings.EqualFold(output, expected), ""), nil
+	}
+	return binaryResult(output == expected, ""), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// jsonSchemaEvaluator passes outputs that are JSON documents valid against a schema. References to other
+// schemas are not resolved.
+type jsonSchemaEvaluator struct {
+	validator *validate.SchemaValidator
+}
+
+func newJSONSchemaEvaluator(cfg models.EvaluatorConfig, _ evaluationsvc.EvaluationClient) (Evaluator, error) {
+	if len(cfg.Schema) == 0 {
+		return nil, fmt.Errorf("schema is required")
+	}
+	var schema spec.Schema
+	if err := json.Unmarshal(cfg.Schema, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	validator, err := newSchemaValidator(&schema)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonSchemaEvaluator{validator: validator}, nil
+}
+
+// newSchemaValidator creates a validator for a schema. The validator panics on schemas it cannot apply, both
+// when it is created and while validating.
+func newSchemaValidator(schema *spec.Schema) (validator *validate.SchemaValidator, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid schema: %v", r)
+		}
+	}()
+	return validate.NewSchemaValidator(schema, nil, "", strfmt.Default), nil
+}
+
+func (e *jsonSchemaEvaluator) Evaluate(ctx context.Context, sample Sample) (Result, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(sample.Output), &document); err != nil {
+		return binaryResult(false, "output is not valid JSON"), nil
+	}
+	validationErrs, err := e.validate(document)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(validationErrs) == 0 {
+		return binaryResult(true, ""), nil
+	}
+	reasons := make([]string, len(validationErrs))
+	for i, validationErr := range validationErrs {
+		reasons[i] = validationErr.Error()
+	}
+	return binaryResult(false, strings.Join(reasons, "; ")), nil
+}
+
+func (e *jsonSchemaEvaluator) validate(document interface{}) (validationErrs []error, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("schema cannot be applied: %v", r)
+		}
+	}()
+	return e.validator.Validate(document).Errors, nil
+}
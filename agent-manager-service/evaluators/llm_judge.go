@@ -0,0 +1,91 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+const defaultJudgeCriteria = "Is the response correct, relevant and helpful for the input? " +
+	"When an expected response is given, does the response convey the same information?"
+
+const judgeSystemPrompt = "You are an impartial judge evaluating the response of an AI agent. " +
+	"Assess the response only on the given criteria. " +
+	`Reply with a JSON object of the form {"score": <number between 0 and 1>, "reason": "<one or two sentences>"}, ` +
+	"where 1 means the response fully meets the criteria and 0 means it does not meet them at all."
+
+// llmJudgeEvaluator asks the configured judge model to score outputs on a set of criteria
+type llmJudgeEvaluator struct {
+	client    evaluationsvc.EvaluationClient
+	criteria  string
+	threshold float64
+}
+
+func newLLMJudgeEvaluator(cfg models.EvaluatorConfig, client evaluationsvc.EvaluationClient) (Evaluator, error) {
+	if config.GetConfig().Evaluation.Judge.URL == "" {
+		return nil, fmt.Errorf("no judge endpoint is configured, set EVAL_JUDGE_URL")
+	}
+	threshold, err := passThreshold(cfg)
+	if err != nil {
+		return nil, err
+	}
+	criteria := strings.TrimSpace(cfg.Criteria)
+	if criteria == "" {
+		criteria = defaultJudgeCriteria
+	}
+	return &llmJudgeEvaluator{client: client, criteria: criteria, threshold: threshold}, nil
+}
+
+func (e *llmJudgeEvaluator) Evaluate(ctx context.Context, sample Sample) (Result, error) {
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Criteria:\n%s\n\nInput:\n%s\n\n", e.criteria, sample.Input)
+	if sample.Expected != "" {
+		fmt.Fprintf(&prompt, "Expected response:\n%s\n\n", sample.Expected)
+	}
+	fmt.Fprintf(&prompt, "Response:\n%s", sample.Output)
+
+	reply, err := e.client.CompleteChat(ctx, []evaluationsvc.ChatMessage{
+		{Role: "system", Content: judgeSystemPrompt},
+		{Role: "user", Content: prompt.String()},
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	var verdict struct {
+		Score  *float64 `json:"score"`
+		Reason string   `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(reply), &verdict); err != nil {
+		return Result{}, fmt.Errorf("judge reply is not a JSON object: %w", err)
+	}
+	if verdict.Score == nil || *verdict.Score < 0 || *verdict.Score > 1 {
+		return Result{}, fmt.Errorf("judge reply has no score between 0 and 1")
+	}
+	return Result{
+		Score:  *verdict.Score,
+		Passed: *verdict.Score >= e.threshold,
+		Reason: verdict.Reason,
+	}, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package evaluators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// regexEvaluator passes outputs that match a pattern
+type regexEvaluator struct {
+	pattern *regexp.Regexp
+}
+
+func newRegexEvaluator(cfg models.EvaluatorConfig, _ evaluationsvc.EvaluationClient) (Evaluator, error) {
+	if cfg.Pattern == "" {
+		return nil, fmt.Errorf("pattern is required")
+	}
+	pattern, err := regexp.Compile(cfg.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return &regexEvaluator{pattern: pattern}, nil
+}
+
+func (e *regexEvaluator) Evaluate(ctx context.Context, sample Sample) (Result, error) {
+	if e.pattern.MatchString(sample.Output) {
+		return binaryResult(true, ""), nil
+	}
+	return binaryResult(false, fmt.Sprintf("output does not match %s", e.pattern)), nil
+}
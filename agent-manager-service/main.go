@@ -97,6 +97,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := dependencies.EvalRunManagerService.FailInterruptedEvalRuns(context.Background()); err != nil {
+		slog.Error("failed to recover interrupted evaluation runs", "error", err)
+		os.Exit(1)
+	}
+
 	handler := api.MakeHTTPHandler(dependencies)
 	server := &http.Server{
 		Addr:           fmt.Sprintf("%s:%d", cfg.ServerHost, cfg.ServerPort),
@@ -0,0 +1,86 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// AgentCard is the A2A agent card of an a2a agent, stored as submitted at create time
+type AgentCard struct {
+	Name               string                             `json:"name"`
+	Description        string                             `json:"description"`
+	Version            string                             `json:"version"`
+	ProtocolVersion    string                             `json:"protocolVersion,omitempty"`
+	Provider           *AgentCardProvider                 `json:"provider,omitempty"`
+	DocumentationURL   string                             `json:"documentationUrl,omitempty"`
+	Capabilities       AgentCardCapabilities              `json:"capabilities"`
+	SecuritySchemes    map[string]AgentCardSecurityScheme `json:"securitySchemes,omitempty"`
+	Security           []map[string][]string              `json:"security,omitempty"`
+	DefaultInputModes  []string                           `json:"defaultInputModes"`
+	DefaultOutputModes []string                           `json:"defaultOutputModes"`
+	Skills             []AgentCardSkill                   `json:"skills"`
+}
+
+type AgentCardProvider struct {
+	Organization string `json:"organization"`
+	URL          string `json:"url"`
+}
+
+type AgentCardCapabilities struct {
+	Streaming              bool `json:"streaming,omitempty"`
+	PushNotifications      bool `json:"pushNotifications,omitempty"`
+	StateTransitionHistory bool `json:"stateTransitionHistory,omitempty"`
+}
+
+type AgentCardSkill struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags,omitempty"`
+	Examples    []string `json:"examples,omitempty"`
+	InputModes  []string `json:"inputModes,omitempty"`
+	OutputModes []string `json:"outputModes,omitempty"`
+}
+
+type AgentCardSecurityScheme struct {
+	Type             string                 `json:"type"`
+	Description      string                 `json:"description,omitempty"`
+	Name             string                 `json:"name,omitempty"`
+	In               string                 `json:"in,omitempty"`
+	Scheme           string                 `json:"scheme,omitempty"`
+	BearerFormat     string                 `json:"bearerFormat,omitempty"`
+	Flows            map[string]interface{} `json:"flows,omitempty"`
+	OpenIDConnectURL string                 `json:"openIdConnectUrl,omitempty"`
+}
+
+// A2AAgentResponse is a deployed a2a agent as listed by the org-wide discovery API
+type A2AAgentResponse struct {
+	Name        string    `json:"name"`
+	DisplayName string    `json:"displayName"`
+	ProjectName string    `json:"projectName"`
+	AgentCard   AgentCard `json:"agentCard"`
+	// Environments the agent is deployed to, with the URLs it is reachable at
+	Deployments []A2AAgentDeployment `json:"deployments"`
+}
+
+type A2AAgentDeployment struct {
+	Environment  string `json:"environment"`
+	URL          string `json:"url"`
+	AgentCardURL string `json:"agentCardUrl"`
+}
+
+type A2AAgentListResponse struct {
+	Agents []A2AAgentResponse `json:"agents"`
+	Total  int                `json:"total"`
+}
@@ -73,4 +73,6 @@ type Agent struct {
 type InternalAgent struct {
 	ID           uuid.UUID              `gorm:"column:id;primaryKey"`
 	WorkloadSpec map[string]interface{} `gorm:"column:workload_spec;type:jsonb;serializer:json"`
+	// Set for a2a agents only
+	AgentCard *AgentCard `gorm:"column:agent_card;type:jsonb;serializer:json"`
 }
@@ -0,0 +1,69 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateConfigOverrideRequest is the request body for overriding a configuration value in an environment
+type CreateConfigOverrideRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// UpdateConfigOverrideRequest is the request body for changing the value of an override
+type UpdateConfigOverrideRequest struct {
+	Value string `json:"value"`
+}
+
+// ConfigOverrideResponse describes the value a configuration key is overridden with in an environment
+type ConfigOverrideResponse struct {
+	Key         string    `json:"key"`
+	Value       string    `json:"value"`
+	Environment string    `json:"environment"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ConfigOverrideListResponse lists the overrides of an environment, ordered by key
+type ConfigOverrideListResponse struct {
+	Overrides []ConfigOverrideResponse `json:"overrides"`
+	Total     int                      `json:"total"`
+}
+
+// EffectiveConfigItem is a value of the configuration an agent runs with in an environment. Source tells whether it
+// is a base value from the runtime configuration of the agent or an override of the environment.
+type EffectiveConfigItem struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// DB Model
+type AgentConfigOverride struct {
+	ID          uuid.UUID `gorm:"column:id;primaryKey"`
+	AgentID     uuid.UUID `gorm:"column:agent_id"`
+	Environment string    `gorm:"column:environment"`
+	Key         string    `gorm:"column:key"`
+	Value       string    `gorm:"column:value"`
+	CreatedBy   uuid.UUID `gorm:"column:created_by"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
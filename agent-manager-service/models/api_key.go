@@ -0,0 +1,84 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateAPIKeyRequest is the request body for issuing an API key
+type CreateAPIKeyRequest struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+}
+
+// APIKeyResponse describes an API key without its secret
+type APIKeyResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Environment string     `json:"environment"`
+	KeyPrefix   string     `json:"keyPrefix"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	RotatedAt   *time.Time `json:"rotatedAt,omitempty"`
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// APIKeySecretResponse is returned when a key is issued or rotated. The plaintext key is only ever returned here.
+type APIKeySecretResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+// APIKeyListResponse lists the active API keys of an agent
+type APIKeyListResponse struct {
+	APIKeys []APIKeyResponse `json:"apiKeys"`
+	Total   int              `json:"total"`
+}
+
+// VerifyAPIKeyRequest is the request body of the internal API key verification endpoint
+type VerifyAPIKeyRequest struct {
+	Key string `json:"key"`
+}
+
+// VerifyAPIKeyResponse holds the agent deployment a verified API key was issued for
+type VerifyAPIKeyResponse struct {
+	KeyID          string `json:"keyId"`
+	Environment    string `json:"environment"`
+	ProjectUID     string `json:"projectUid"`
+	ComponentUID   string `json:"componentUid"`
+	EnvironmentUID string `json:"environmentUid"`
+}
+
+// DB Model
+type AgentAPIKey struct {
+	ID             uuid.UUID  `gorm:"column:id;primaryKey"`
+	AgentID        uuid.UUID  `gorm:"column:agent_id"`
+	Name           string     `gorm:"column:name"`
+	Environment    string     `gorm:"column:environment"`
+	KeyPrefix      string     `gorm:"column:key_prefix"`
+	KeyHash        string     `gorm:"column:key_hash"`
+	ProjectUID     string     `gorm:"column:project_uid"`
+	ComponentUID   string     `gorm:"column:component_uid"`
+	EnvironmentUID string     `gorm:"column:environment_uid"`
+	CreatedBy      uuid.UUID  `gorm:"column:created_by"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+	RotatedAt      *time.Time `gorm:"column:rotated_at"`
+	LastUsedAt     *time.Time `gorm:"column:last_used_at"`
+	RevokedAt      *time.Time `gorm:"column:revoked_at"`
+}
@@ -29,6 +29,32 @@ type DeploymentResponse struct {
 	PromotionTargetEnvironment *PromotionTargetEnvironment `json:"promotionTargetEnvironment,omitempty"`
 	LastDeployedAt             time.Time                   `json:"lastDeployedAt"`
 	Endpoints                  []Endpoint                  `json:"endpoints"`
+	// Set for event-driven agents
+	Queue *QueueConsumerStatus `json:"queue,omitempty"`
+	// Sizing and scaling the agent was released with. Replicas is set when it is not autoscaled
+	Resources   *ResourceRequirements `json:"resources,omitempty"`
+	Replicas    *int32                `json:"replicas,omitempty"`
+	Autoscaling *AutoscalingConfig    `json:"autoscaling,omitempty"`
+}
+
+// ResourceRequirements represents the CPU and memory of an agent container
+type ResourceRequirements struct {
+	Requests ResourceQuantity `json:"requests"`
+	Limits   ResourceQuantity `json:"limits"`
+}
+
+// ResourceQuantity represents amounts of CPU and memory in Kubernetes quantity notation
+type ResourceQuantity struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+// AutoscalingConfig represents horizontal autoscaling of an agent
+type AutoscalingConfig struct {
+	MinReplicas                    int32  `json:"minReplicas"`
+	MaxReplicas                    int32  `json:"maxReplicas"`
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetConcurrency              *int32 `json:"targetConcurrency,omitempty"`
 }
 
 // PromotionTargetEnvironment represents environment promotion targets
@@ -40,12 +66,26 @@ type PromotionTargetEnvironment struct {
 // EndpointsResponse represents detailed endpoint information
 type EndpointsResponse struct {
 	Endpoint
+	// Protocol the endpoint is served over: HTTP, GRPC or WEBSOCKET
+	Type   string         `json:"type,omitempty"`
 	Schema EndpointSchema `json:"schema"`
+	// Set instead of the schema for mcp-server agents
+	MCP *MCPServerDetails `json:"mcp,omitempty"`
 }
 
 // EndpointSchema represents the schema for an endpoint
 type EndpointSchema struct {
 	Content string `json:"content"`
+	// Set for gRPC endpoints that are described by server reflection instead of a proto file
+	Reflection bool `json:"reflection,omitempty"`
+}
+
+// DeployedEndpointSchema is the schema an endpoint was released to an environment with
+type DeployedEndpointSchema struct {
+	// Protocol the endpoint is served over: HTTP, GRPC or WEBSOCKET
+	Type string
+	// Empty for endpoints without a schema
+	Content string
 }
 
 // Endpoint represents endpoint configuration
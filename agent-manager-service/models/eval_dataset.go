@@ -0,0 +1,108 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DatasetTraceSelection selects traces of an agent to build dataset items from, either by trace ID or by
+// filter. When no trace IDs are given, the most recent traces matching the filter are selected.
+type DatasetTraceSelection struct {
+	AgentName string   `json:"agentName"`
+	TraceIDs  []string `json:"traceIds,omitempty"`
+	StartTime string   `json:"startTime,omitempty"`
+	EndTime   string   `json:"endTime,omitempty"`
+	Label     string   `json:"label,omitempty"`
+	Limit     int      `json:"limit,omitempty"`
+}
+
+// CreateDatasetRequest is the request body for creating the first version of a dataset from traces
+type CreateDatasetRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Traces      DatasetTraceSelection `json:"traces"`
+}
+
+// UpdateDatasetRequest is the request body for editing a dataset. Every edit creates a new version
+// from the latest one, so that earlier versions stay usable as fixed regression suites.
+type UpdateDatasetRequest struct {
+	Description *string `json:"description,omitempty"`
+	// Replaces the items of the latest version when set
+	Items *[]DatasetItem `json:"items,omitempty"`
+	// Appends items built from traces
+	AddTraces *DatasetTraceSelection `json:"addTraces,omitempty"`
+}
+
+// DatasetItem is an input and the output the agent produced for it
+type DatasetItem struct {
+	TraceID   string `json:"traceId,omitempty"`
+	AgentName string `json:"agentName,omitempty"`
+	Input     string `json:"input"`
+	Output    string `json:"output,omitempty"`
+}
+
+// DatasetResponse describes a version of a dataset with its items
+type DatasetResponse struct {
+	Name        string        `json:"name"`
+	Version     int           `json:"version"`
+	Description string        `json:"description,omitempty"`
+	CreatedBy   string        `json:"createdBy"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	ItemCount   int           `json:"itemCount"`
+	Items       []DatasetItem `json:"items"`
+	// Selected traces whose root span has no GenAI input, set when the version was built from traces
+	SkippedTraceIDs []string `json:"skippedTraceIds,omitempty"`
+}
+
+// DatasetSummary describes the latest version of a dataset
+type DatasetSummary struct {
+	Name          string    `json:"name"`
+	LatestVersion int       `json:"latestVersion"`
+	Description   string    `json:"description,omitempty"`
+	ItemCount     int       `json:"itemCount"`
+	CreatedBy     string    `json:"createdBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type DatasetListResponse struct {
+	Datasets []DatasetSummary `json:"datasets"`
+	Total    int              `json:"total"`
+}
+
+// DB Models
+type EvalDataset struct {
+	ID          uuid.UUID `gorm:"column:id;primaryKey"`
+	ProjectID   uuid.UUID `gorm:"column:project_id"`
+	Name        string    `gorm:"column:name"`
+	Version     int       `gorm:"column:version"`
+	Description string    `gorm:"column:description"`
+	CreatedBy   uuid.UUID `gorm:"column:created_by"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+type EvalDatasetItem struct {
+	ID        uuid.UUID `gorm:"column:id;primaryKey"`
+	DatasetID uuid.UUID `gorm:"column:dataset_id"`
+	Position  int       `gorm:"column:position"`
+	TraceID   string    `gorm:"column:trace_id"`
+	AgentName string    `gorm:"column:agent_name"`
+	Input     string    `gorm:"column:input"`
+	Output    string    `gorm:"column:output"`
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EvaluatorConfig configures an evaluator of a run. Which fields apply depends on the evaluator type.
+type EvaluatorConfig struct {
+	Type string `json:"type"`
+	// Name the evaluator's scores are reported under, defaults to the type. Must be unique within a run.
+	Name string `json:"name,omitempty"`
+	// exact_match: compare outputs ignoring case and surrounding whitespace
+	IgnoreCase bool `json:"ignoreCase,omitempty"`
+	// regex: pattern the output must match
+	Pattern string `json:"pattern,omitempty"`
+	// json_schema: schema the output must be a valid JSON document of
+	Schema json.RawMessage `json:"schema,omitempty"`
+	// embedding_similarity and llm_judge: minimum score, between 0 and 1, for an output to pass
+	Threshold *float64 `json:"threshold,omitempty"`
+	// llm_judge: what the judge should assess the output on
+	Criteria string `json:"criteria,omitempty"`
+}
+
+// CreateEvalRunRequest is the request body for running a dataset version against a deployed agent
+type CreateEvalRunRequest struct {
+	DatasetName string `json:"datasetName"`
+	// Dataset version to run, the latest version when omitted
+	DatasetVersion int    `json:"datasetVersion,omitempty"`
+	AgentName      string `json:"agentName"`
+	Environment    string `json:"environment"`
+	// Agent endpoint to send inputs to, required only when the agent exposes more than one endpoint
+	EndpointName string `json:"endpointName,omitempty"`
+	// Path of the chat API on the endpoint, "/chat" when omitted
+	Path string `json:"path,omitempty"`
+	// Number of items sent to the agent at once
+	Concurrency int               `json:"concurrency,omitempty"`
+	Evaluators  []EvaluatorConfig `json:"evaluators"`
+}
+
+// EvaluatorScore is the score an evaluator gave to the output of a dataset item
+type EvaluatorScore struct {
+	Evaluator string  `json:"evaluator"`
+	Score     float64 `json:"score"`
+	Passed    bool    `json:"passed"`
+	Reason    string  `json:"reason,omitempty"`
+	// Set when the evaluator could not score the output, for example when a model endpoint is unreachable
+	Error string `json:"error,omitempty"`
+}
+
+// EvalRunResultResponse is the outcome of running a dataset item
+type EvalRunResultResponse struct {
+	Position       int    `json:"position"`
+	Input          string `json:"input"`
+	ExpectedOutput string `json:"expectedOutput,omitempty"`
+	Output         string `json:"output"`
+	// Set when the agent could not be invoked, in which case the item is not scored
+	Error     string           `json:"error,omitempty"`
+	LatencyMs int64            `json:"latencyMs"`
+	Scores    []EvaluatorScore `json:"scores"`
+	// Whether every evaluator passed the output
+	Passed bool `json:"passed"`
+}
+
+// EvaluatorSummary aggregates the scores of an evaluator over the items of a run
+type EvaluatorSummary struct {
+	Evaluator    string  `json:"evaluator"`
+	AverageScore float64 `json:"averageScore"`
+	PassRate     float64 `json:"passRate"`
+	// Items the evaluator produced a score for
+	ScoredItems int `json:"scoredItems"`
+}
+
+// EvalRunSummary aggregates the results of a finished run
+type EvalRunSummary struct {
+	TotalItems  int `json:"totalItems"`
+	PassedItems int `json:"passedItems"`
+	// Items the agent could not be invoked for
+	ErroredItems     int                `json:"erroredItems"`
+	PassRate         float64            `json:"passRate"`
+	AverageLatencyMs float64            `json:"averageLatencyMs"`
+	Evaluators       []EvaluatorSummary `json:"evaluators"`
+}
+
+// EvalRunResponse describes a run and, when fetched individually, the results of its items
+type EvalRunResponse struct {
+	ID             string            `json:"id"`
+	DatasetName    string            `json:"datasetName"`
+	DatasetVersion int               `json:"datasetVersion"`
+	AgentName      string            `json:"agentName"`
+	Environment    string            `json:"environment"`
+	EndpointURL    string            `json:"endpointUrl"`
+	Status         string            `json:"status"`
+	Concurrency    int               `json:"concurrency"`
+	Evaluators     []EvaluatorConfig `json:"evaluators"`
+	// Number of items that have finished so far
+	CompletedItems int                     `json:"completedItems"`
+	TotalItems     int                     `json:"totalItems"`
+	Summary        *EvalRunSummary         `json:"summary,omitempty"`
+	Error          string                  `json:"error,omitempty"`
+	CreatedBy      string                  `json:"createdBy"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	CompletedAt    *time.Time              `json:"completedAt,omitempty"`
+	Results        []EvalRunResultResponse `json:"results,omitempty"`
+}
+
+type EvalRunListResponse struct {
+	Runs  []EvalRunResponse `json:"runs"`
+	Total int               `json:"total"`
+}
+
+// EvalRunItemComparison compares the results of an item in two runs
+type EvalRunItemComparison struct {
+	Input           string `json:"input"`
+	BasePassed      bool   `json:"basePassed"`
+	CandidatePassed bool   `json:"candidatePassed"`
+	// Candidate score minus base score, for each evaluator that scored the item in both runs
+	ScoreDeltas map[string]float64 `json:"scoreDeltas"`
+	// One of improved, regressed or unchanged, based on whether the item passed in each run
+	Change string `json:"change"`
+}
+
+// EvalRunComparisonResponse compares a candidate run with a base run. Items are matched by input, so runs of
+// different dataset versions can be compared; items found in only one of the runs are counted as unmatched.
+type EvalRunComparisonResponse struct {
+	BaseRunID        string          `json:"baseRunId"`
+	CandidateRunID   string          `json:"candidateRunId"`
+	BaseSummary      *EvalRunSummary `json:"baseSummary"`
+	CandidateSummary *EvalRunSummary `json:"candidateSummary"`
+	// Candidate pass rate minus base pass rate
+	PassRateDelta float64 `json:"passRateDelta"`
+	// Candidate average score minus base average score, for each evaluator used in both runs
+	AverageScoreDeltas map[string]float64      `json:"averageScoreDeltas"`
+	Improved           int                     `json:"improved"`
+	Regressed          int                     `json:"regressed"`
+	Unchanged          int                     `json:"unchanged"`
+	Unmatched          int                     `json:"unmatched"`
+	Items              []EvalRunItemComparison `json:"items"`
+}
+
+// DB Models
+type EvalRun struct {
+	ID             uuid.UUID         `gorm:"column:id;primaryKey"`
+	ProjectID      uuid.UUID         `gorm:"column:project_id"`
+	DatasetName    string            `gorm:"column:dataset_name"`
+	DatasetVersion int               `gorm:"column:dataset_version"`
+	AgentName      string            `gorm:"column:agent_name"`
+	Environment    string            `gorm:"column:environment"`
+	EndpointURL    string            `gorm:"column:endpoint_url"`
+	Status         string            `gorm:"column:status"`
+	Concurrency    int               `gorm:"column:concurrency"`
+	Evaluators     []EvaluatorConfig `gorm:"column:evaluators;type:jsonb;serializer:json"`
+	TotalItems     int               `gorm:"column:total_items"`
+	Summary        *EvalRunSummary   `gorm:"column:summary;type:jsonb;serializer:json"`
+	Error          string            `gorm:"column:error"`
+	CreatedBy      uuid.UUID         `gorm:"column:created_by"`
+	CreatedAt      time.Time         `gorm:"column:created_at"`
+	CompletedAt    *time.Time        `gorm:"column:completed_at"`
+}
+
+type EvalRunResult struct {
+	ID             uuid.UUID        `gorm:"column:id;primaryKey"`
+	RunID          uuid.UUID        `gorm:"column:run_id"`
+	Position       int              `gorm:"column:position"`
+	Input          string           `gorm:"column:input"`
+	ExpectedOutput string           `gorm:"column:expected_output"`
+	Output         string           `gorm:"column:output"`
+	Error          string           `gorm:"column:error"`
+	LatencyMs      int64            `gorm:"column:latency_ms"`
+	Scores         []EvaluatorScore `gorm:"column:scores;type:jsonb;serializer:json"`
+	Passed         bool             `gorm:"column:passed"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import "time"
+
+// JobRunResponse is a single run of a job agent in an environment
+type JobRunResponse struct {
+	Name        string     `json:"name"`
+	Environment string     `json:"environment"`
+	Trigger     string     `json:"trigger"`
+	Status      string     `json:"status"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// Reason the run failed, if it did
+	Message string `json:"message,omitempty"`
+	// Data plane namespace the run's pods are in, used to look up its logs
+	Namespace string `json:"-"`
+}
+
+// JobScheduleResponse is the schedule of a job agent as deployed to an environment
+type JobScheduleResponse struct {
+	Schedule string `json:"schedule"`
+	// Suspended jobs only run when triggered
+	Suspended          bool       `json:"suspended"`
+	LastScheduleTime   *time.Time `json:"lastScheduleTime,omitempty"`
+	LastSuccessfulTime *time.Time `json:"lastSuccessfulTime,omitempty"`
+}
+
+type JobRunsResponse struct {
+	Schedule JobScheduleResponse `json:"schedule"`
+	Runs     []JobRunResponse    `json:"runs"`
+	Total    int                 `json:"total"`
+}
+
+type JobRunLogsResponse struct {
+	Run        JobRunResponse `json:"run"`
+	Logs       []LogEntry     `json:"logs"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type ComponentLogsResponse struct {
+	Logs       []LogEntry `json:"logs"`
+	TotalCount int32      `json:"totalCount"`
+	TookMs     float32    `json:"tookMs"`
+}
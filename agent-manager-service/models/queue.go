@@ -0,0 +1,37 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// QueueConfig is the queue an event-driven agent consumes from
+type QueueConfig struct {
+	Broker        string `json:"broker"`
+	URL           string `json:"url"`
+	Topic         string `json:"topic"`
+	ConsumerGroup string `json:"consumerGroup"`
+	Concurrency   int32  `json:"concurrency"`
+}
+
+// QueueConsumerStatus is the state of the consumer group of an event-driven agent on its broker
+type QueueConsumerStatus struct {
+	Broker        string `json:"broker"`
+	Topic         string `json:"topic"`
+	ConsumerGroup string `json:"consumerGroup"`
+	// Messages on the topic the consumer group has not processed yet
+	ConsumerLag *int64 `json:"consumerLag,omitempty"`
+	// Set when the lag could not be read from the broker
+	Error string `json:"error,omitempty"`
+}
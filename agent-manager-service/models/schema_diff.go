@@ -0,0 +1,50 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// SchemaDiffResponse compares the endpoint schemas of an agent deployed to an environment with the ones deployed to
+// the environment it is promoted to next
+type SchemaDiffResponse struct {
+	Environment       string `json:"environment"`
+	TargetEnvironment string `json:"targetEnvironment"`
+	// Set when promoting would make a breaking change to any of the endpoints
+	HasBreakingChanges bool                 `json:"hasBreakingChanges"`
+	Endpoints          []EndpointSchemaDiff `json:"endpoints"`
+}
+
+// EndpointSchemaDiff is how the OpenAPI schema of an endpoint changes when the agent is promoted
+type EndpointSchemaDiff struct {
+	Name            string         `json:"name"`
+	Status          string         `json:"status"`
+	BreakingChanges int            `json:"breakingChanges"`
+	Changes         []SchemaChange `json:"changes"`
+	// Set when the schemas could not be compared, such as when one of them is not a valid OpenAPI document
+	Error string `json:"error,omitempty"`
+}
+
+// SchemaChange is a single difference between the schema deployed to the target environment and the one promoted
+type SchemaChange struct {
+	// API path the change was made under, empty for changes outside of paths
+	Path       string `json:"path,omitempty"`
+	Property   string `json:"property"`
+	ChangeType string `json:"changeType"`
+	Original   string `json:"original,omitempty"`
+	New        string `json:"new,omitempty"`
+	Breaking   bool   `json:"breaking"`
+	// Line of the change in the promoted schema, or in the target environment's schema for removals
+	Line int `json:"line,omitempty"`
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TraceAnnotationRequest is the request body for annotating a trace or one of its spans.
+// At least one of score, label or comment must be set.
+type TraceAnnotationRequest struct {
+	SpanID  string   `json:"spanId,omitempty"`
+	Score   *float64 `json:"score,omitempty"`
+	Label   string   `json:"label,omitempty"`
+	Comment string   `json:"comment,omitempty"`
+}
+
+// TraceAnnotationResponse describes a reviewer's annotation of a trace, or of a span when spanId is set
+type TraceAnnotationResponse struct {
+	ID        string    `json:"id"`
+	TraceID   string    `json:"traceId"`
+	SpanID    string    `json:"spanId,omitempty"`
+	Score     *float64  `json:"score,omitempty"`
+	Label     string    `json:"label,omitempty"`
+	Comment   string    `json:"comment,omitempty"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TraceAnnotationListResponse lists the annotations of a trace, oldest first
+type TraceAnnotationListResponse struct {
+	Annotations []TraceAnnotationResponse `json:"annotations"`
+	Total       int                       `json:"total"`
+}
+
+// DB Model
+type TraceAnnotation struct {
+	ID        uuid.UUID `gorm:"column:id;primaryKey"`
+	AgentID   uuid.UUID `gorm:"column:agent_id"`
+	TraceID   string    `gorm:"column:trace_id"`
+	SpanID    string    `gorm:"column:span_id"`
+	Score     *float64  `gorm:"column:score"`
+	Label     string    `gorm:"column:label"`
+	Comment   string    `gorm:"column:comment"`
+	CreatedBy uuid.UUID `gorm:"column:created_by"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
@@ -27,12 +27,43 @@ type TraceOverview struct {
 	EndTime         string `json:"endTime"`
 	DurationInNanos int64  `json:"durationInNanos"`
 	SpanCount       int    `json:"spanCount"`
+	// Reviewer annotations of the trace and its spans, only set when listing the traces of an agent
+	Annotations []TraceAnnotationResponse `json:"annotations,omitempty"`
 }
 
 // TraceOverviewResponse represents the response for listing traces
 type TraceOverviewResponse struct {
 	Traces     []TraceOverview `json:"traces"`
 	TotalCount int             `json:"totalCount"`
+	Truncated  bool            `json:"truncated"`
+}
+
+// SessionOverview represents a conversation made up of one trace per turn
+type SessionOverview struct {
+	SessionID       string `json:"sessionId"`
+	TurnCount       int    `json:"turnCount"`
+	SpanCount       int64  `json:"spanCount"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	DurationInNanos int64  `json:"durationInNanos"`
+	InputTokens     int64  `json:"inputTokens"`
+	OutputTokens    int64  `json:"outputTokens"`
+	TotalTokens     int64  `json:"totalTokens"`
+	Truncated       bool   `json:"truncated"`
+}
+
+// SessionOverviewResponse represents the response for listing sessions
+type SessionOverviewResponse struct {
+	Sessions   []SessionOverview `json:"sessions"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// SessionTracesResponse represents the traces of a session in chronological order
+type SessionTracesResponse struct {
+	SessionID  string          `json:"sessionId"`
+	Traces     []TraceOverview `json:"traces"`
+	TotalCount int             `json:"totalCount"`
+	Truncated  bool            `json:"truncated"`
 }
 
 // Span represents a single span in a trace
@@ -47,25 +78,69 @@ type Span struct {
 	EndTime         time.Time              `json:"endTime,omitempty"`
 	DurationInNanos int64                  `json:"durationInNanos"`
 	Status          string                 `json:"status,omitempty"`
+	StatusDetails   *SpanStatus            `json:"statusDetails,omitempty"`
 	Attributes      map[string]interface{} `json:"attributes,omitempty"`
 	Resource        map[string]interface{} `json:"resource,omitempty"`
+	Events          []SpanEvent            `json:"events,omitempty"`
+	Links           []SpanLink             `json:"links,omitempty"`
 }
 
-// SpanEvent represents an event within a span (for future use)
+// SpanEvent represents an event within a span, such as an exception or a streamed token
 type SpanEvent struct {
-	Name       string            `json:"name"`
-	Timestamp  time.Time         `json:"timestamp"`
-	Attributes map[string]string `json:"attributes,omitempty"`
+	Name       string                 `json:"name"`
+	Timestamp  time.Time              `json:"timestamp"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Exception  *SpanException         `json:"exception,omitempty"`
+}
+
+// SpanException represents the exception details of an exception event
+type SpanException struct {
+	Type       string `json:"type,omitempty"`
+	Message    string `json:"message,omitempty"`
+	Stacktrace string `json:"stacktrace,omitempty"`
+	Escaped    bool   `json:"escaped,omitempty"`
+}
+
+// SpanLink represents a link from a span to a span in the same or another trace
+type SpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	TraceState string                 `json:"traceState,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
-// SpanStatus represents the status of a span (for future use)
+// SpanStatus represents the status of a span
 type SpanStatus struct {
-	Code    string `json:"code"`
+	Code    string `json:"code"` // UNSET, OK or ERROR
 	Message string `json:"message,omitempty"`
 }
 
+// SpanNode represents a span in the span tree of a trace, with timing for waterfall rendering
+type SpanNode struct {
+	Span
+	Depth           int         `json:"depth"`
+	OffsetInNanos   int64       `json:"offsetInNanos"`
+	SelfTimeInNanos int64       `json:"selfTimeInNanos"`
+	CriticalPath    bool        `json:"criticalPath"`
+	Orphan          bool        `json:"orphan,omitempty"`
+	Children        []*SpanNode `json:"children,omitempty"`
+}
+
+// TraceTreeResponse represents the response for trace details in tree view
+type TraceTreeResponse struct {
+	TraceID         string      `json:"traceId"`
+	StartTime       time.Time   `json:"startTime"`
+	DurationInNanos int64       `json:"durationInNanos"`
+	Roots           []*SpanNode `json:"roots"`
+	TotalCount      int         `json:"totalCount"`
+	// Reviewer annotations of the trace and its spans
+	Annotations []TraceAnnotationResponse `json:"annotations,omitempty"`
+}
+
 // TraceResponse represents the response for trace details
 type TraceResponse struct {
 	Spans      []Span `json:"spans"`
 	TotalCount int    `json:"totalCount"`
+	// Reviewer annotations of the trace and its spans
+	Annotations []TraceAnnotationResponse `json:"annotations,omitempty"`
 }
@@ -0,0 +1,42 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// UsageReportItem represents token usage and estimated cost for one group of a usage report.
+// Only the fields selected by groupBy are populated.
+type UsageReportItem struct {
+	OrgName       string  `json:"orgName,omitempty"`
+	ProjectName   string  `json:"projectName,omitempty"`
+	AgentName     string  `json:"agentName,omitempty"`
+	Model         string  `json:"model,omitempty"`
+	InputTokens   int64   `json:"inputTokens"`
+	OutputTokens  int64   `json:"outputTokens"`
+	TotalTokens   int64   `json:"totalTokens"`
+	EstimatedCost float64 `json:"estimatedCost"`
+}
+
+// UsageReportResponse represents the response for token and cost usage reports
+type UsageReportResponse struct {
+	StartTime string            `json:"startTime"`
+	EndTime   string            `json:"endTime"`
+	GroupBy   []string          `json:"groupBy"`
+	Currency  string            `json:"currency"`
+	Items     []UsageReportItem `json:"items"`
+	Total     UsageReportItem   `json:"total"`
+	// Models that reported usage but have no configured price; their cost is counted as zero
+	UnpricedModels []string `json:"unpricedModels,omitempty"`
+}
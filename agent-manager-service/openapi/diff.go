@@ -0,0 +1,134 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openapi
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+// ChangeType describes how a property of a document changed
+type ChangeType string
+
+const (
+	ChangeTypeModified ChangeType = "MODIFIED"
+	ChangeTypeAdded    ChangeType = "ADDED"
+	ChangeTypeRemoved  ChangeType = "REMOVED"
+)
+
+// Change is a single difference between two versions of an OpenAPI document
+type Change struct {
+	// API path the change was made under, empty for changes outside of paths
+	Path     string
+	Property string
+	Type     ChangeType
+	Original string
+	New      string
+	// Breaking changes can fail clients written against the original document
+	Breaking bool
+	// Line of the change in the updated document, or in the original document for removals
+	Line int
+}
+
+// Diff is the set of differences between two versions of an OpenAPI document
+type Diff struct {
+	Changes         []Change
+	BreakingChanges int
+}
+
+// Compare returns the differences between two versions of an OpenAPI document
+func Compare(original, updated []byte) (*Diff, error) {
+	originalDoc, err := parseDocument(original)
+	if err != nil {
+		return nil, fmt.Errorf("invalid original document: %w", err)
+	}
+	updatedDoc, err := parseDocument(updated)
+	if err != nil {
+		return nil, fmt.Errorf("invalid updated document: %w", err)
+	}
+	documentChanges, err := libopenapi.CompareDocuments(originalDoc, updatedDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare documents: %w", err)
+	}
+
+	diff := &Diff{Changes: []Change{}}
+	if documentChanges == nil {
+		return diff, nil
+	}
+	changePaths := pathsOfChanges(documentChanges)
+	for _, change := range documentChanges.GetAllChanges() {
+		diff.Changes = append(diff.Changes, toChange(change, changePaths[change]))
+		if change.Breaking {
+			diff.BreakingChanges++
+		}
+	}
+	return diff, nil
+}
+
+// pathsOfChanges maps the changes made within path items to the API path of the item
+func pathsOfChanges(documentChanges *model.DocumentChanges) map[*model.Change]string {
+	changePaths := make(map[*model.Change]string)
+	if documentChanges.PathsChanges == nil {
+		return changePaths
+	}
+	paths := make([]string, 0, len(documentChanges.PathsChanges.PathItemsChanges))
+	for path := range documentChanges.PathsChanges.PathItemsChanges {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		for _, change := range documentChanges.PathsChanges.PathItemsChanges[path].GetAllChanges() {
+			changePaths[change] = path
+		}
+	}
+	// Paths added or removed as a whole are reported against the paths object, keyed by the path
+	if documentChanges.PathsChanges.PropertyChanges != nil {
+		for _, change := range documentChanges.PathsChanges.Changes {
+			changePaths[change] = change.Property
+		}
+	}
+	return changePaths
+}
+
+func toChange(change *model.Change, path string) Change {
+	result := Change{
+		Path:     path,
+		Property: change.Property,
+		Original: change.Original,
+		New:      change.New,
+		Breaking: change.Breaking,
+	}
+	switch change.ChangeType {
+	case model.PropertyAdded, model.ObjectAdded:
+		result.Type = ChangeTypeAdded
+	case model.PropertyRemoved, model.ObjectRemoved:
+		result.Type = ChangeTypeRemoved
+	default:
+		result.Type = ChangeTypeModified
+	}
+	if change.Context != nil {
+		if result.Type == ChangeTypeRemoved && change.Context.OriginalLine != nil {
+			result.Line = *change.Context.OriginalLine
+		} else if change.Context.NewLine != nil {
+			result.Line = *change.Context.NewLine
+		}
+	}
+	return result
+}
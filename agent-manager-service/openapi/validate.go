@@ -0,0 +1,133 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/utils"
+)
+
+var supportedVersionPattern = regexp.MustCompile(`^3\.[01]\.\d+$`)
+
+// ValidationError lists the problems found in an OpenAPI document
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid OpenAPI document: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate parses an OpenAPI 3.0 or 3.1 document in YAML or JSON form and returns a *ValidationError describing
+// what is wrong with it, if anything. References are resolved within the document only; references to other
+// files or URLs are reported as problems since the document is stored on its own.
+func Validate(content []byte) error {
+	doc, err := parseDocument(content)
+	if err != nil {
+		return &ValidationError{Problems: []string{err.Error()}}
+	}
+	model, err := doc.BuildV3Model()
+	if err != nil {
+		return &ValidationError{Problems: errorMessages(err)}
+	}
+
+	var problems []string
+	info := model.Model.Info
+	if info == nil {
+		problems = append(problems, "info is required")
+	} else {
+		if strings.TrimSpace(info.Title) == "" {
+			problems = append(problems, "info.title is required")
+		}
+		if strings.TrimSpace(info.Version) == "" {
+			problems = append(problems, "info.version is required")
+		}
+	}
+
+	is31 := doc.GetSpecInfo().SpecFormat == datamodel.OAS31
+	paths := model.Model.Paths
+	if paths == nil || paths.PathItems == nil {
+		if !is31 {
+			problems = append(problems, "paths is required")
+		} else if model.Model.Components == nil && model.Model.Webhooks == nil {
+			problems = append(problems, "at least one of paths, components or webhooks is required")
+		}
+	} else {
+		for path, pathItem := range paths.PathItems.FromOldest() {
+			if !strings.HasPrefix(path, "/") {
+				problems = append(problems, fmt.Sprintf("path %q must start with '/'", path))
+			}
+			// Responses became optional in OpenAPI 3.1
+			if is31 {
+				continue
+			}
+			for method, operation := range pathItem.GetOperations().FromOldest() {
+				if operation.Responses == nil {
+					problems = append(problems, fmt.Sprintf("%s %s must declare its responses", strings.ToUpper(method), path))
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// parseDocument parses an OpenAPI document, rejecting documents of other specifications and versions
+func parseDocument(content []byte) (libopenapi.Document, error) {
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+	doc, err := libopenapi.NewDocumentWithConfiguration(content, &datamodel.DocumentConfiguration{
+		IgnorePolymorphicCircularReferences: true,
+		IgnoreArrayCircularReferences:       true,
+		Logger:                              slog.New(slog.DiscardHandler),
+	})
+	if err != nil {
+		return nil, err
+	}
+	specInfo := doc.GetSpecInfo()
+	if specInfo.SpecType != utils.OpenApi3 {
+		return nil, fmt.Errorf("unsupported specification version %q (must be OpenAPI 3.0 or 3.1)", doc.GetVersion())
+	}
+	if !supportedVersionPattern.MatchString(doc.GetVersion()) {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q (must be 3.0.x or 3.1.x)", doc.GetVersion())
+	}
+	return doc, nil
+}
+
+// errorMessages flattens the errors joined together while building a document model
+func errorMessages(err error) []string {
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		return []string{err.Error()}
+	}
+	var messages []string
+	for _, e := range joined.Unwrap() {
+		messages = append(messages, errorMessages(e)...)
+	}
+	return messages
+}
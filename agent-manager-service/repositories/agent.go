@@ -35,6 +35,7 @@ type AgentRepository interface {
 	HardDeleteAgentByName(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
 	UpdateAgentTimestamp(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
 	RollbackSoftDeleteAgent(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
+	ListA2AAgents(ctx context.Context, orgId uuid.UUID) ([]*models.Agent, error)
 }
 
 type agentRepository struct{}
@@ -56,6 +57,20 @@ func (r *agentRepository) ListAgents(ctx context.Context, orgId uuid.UUID, proje
 	return agents, nil
 }
 
+// ListA2AAgents lists the agents of an organization, across projects, that have an agent card
+func (r *agentRepository) ListA2AAgents(ctx context.Context, orgId uuid.UUID) ([]*models.Agent, error) {
+	var agents []*models.Agent
+	if err := db.DB(ctx).
+		Preload("AgentDetails").
+		Joins("JOIN internal_agents ON internal_agents.id = agents.id").
+		Where("agents.org_id = ? AND internal_agents.agent_card IS NOT NULL", orgId).
+		Order("agents.created_at DESC").
+		Find(&agents).Error; err != nil {
+		return nil, fmt.Errorf("agentRepository.ListA2AAgents: %w", err)
+	}
+	return agents, nil
+}
+
 func (r *agentRepository) GetAgentByName(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) (*models.Agent, error) {
 	var agent models.Agent
 	if err := db.DB(ctx).
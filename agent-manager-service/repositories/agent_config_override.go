@@ -0,0 +1,90 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type AgentConfigOverrideRepository interface {
+	CreateConfigOverride(ctx context.Context, override *models.AgentConfigOverride) error
+	GetConfigOverride(ctx context.Context, agentId uuid.UUID, environment string, key string) (*models.AgentConfigOverride, error)
+	ListConfigOverrides(ctx context.Context, agentId uuid.UUID, environment string) ([]models.AgentConfigOverride, error)
+	UpdateConfigOverride(ctx context.Context, override *models.AgentConfigOverride) error
+	DeleteConfigOverride(ctx context.Context, overrideId uuid.UUID) error
+}
+
+type agentConfigOverrideRepository struct{}
+
+func NewAgentConfigOverrideRepository() AgentConfigOverrideRepository {
+	return &agentConfigOverrideRepository{}
+}
+
+func (r *agentConfigOverrideRepository) CreateConfigOverride(ctx context.Context, override *models.AgentConfigOverride) error {
+	if err := db.DB(ctx).Create(override).Error; err != nil {
+		return fmt.Errorf("agentConfigOverrideRepository.CreateConfigOverride: %w", err)
+	}
+	return nil
+}
+
+func (r *agentConfigOverrideRepository) GetConfigOverride(ctx context.Context, agentId uuid.UUID, environment string, key string) (*models.AgentConfigOverride, error) {
+	var override models.AgentConfigOverride
+	if err := db.DB(ctx).
+		Where("agent_id = ? AND environment = ? AND key = ?", agentId, environment, key).
+		First(&override).Error; err != nil {
+		return nil, fmt.Errorf("agentConfigOverrideRepository.GetConfigOverride: %w", err)
+	}
+	return &override, nil
+}
+
+// ListConfigOverrides lists the overrides of an environment of an agent, ordered by key
+func (r *agentConfigOverrideRepository) ListConfigOverrides(ctx context.Context, agentId uuid.UUID, environment string) ([]models.AgentConfigOverride, error) {
+	overrides := []models.AgentConfigOverride{}
+	if err := db.DB(ctx).
+		Where("agent_id = ? AND environment = ?", agentId, environment).
+		Order("key ASC").
+		Find(&overrides).Error; err != nil {
+		return nil, fmt.Errorf("agentConfigOverrideRepository.ListConfigOverrides: %w", err)
+	}
+	return overrides, nil
+}
+
+func (r *agentConfigOverrideRepository) UpdateConfigOverride(ctx context.Context, override *models.AgentConfigOverride) error {
+	if err := db.DB(ctx).Model(&models.AgentConfigOverride{}).
+		Where("id = ?", override.ID).
+		Updates(map[string]interface{}{
+			"value":      override.Value,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		return fmt.Errorf("agentConfigOverrideRepository.UpdateConfigOverride: %w", err)
+	}
+	return nil
+}
+
+func (r *agentConfigOverrideRepository) DeleteConfigOverride(ctx context.Context, overrideId uuid.UUID) error {
+	if err := db.DB(ctx).Where("id = ?", overrideId).Delete(&models.AgentConfigOverride{}).Error; err != nil {
+		return fmt.Errorf("agentConfigOverrideRepository.DeleteConfigOverride: %w", err)
+	}
+	return nil
+}
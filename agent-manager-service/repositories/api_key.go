@@ -0,0 +1,122 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, apiKey *models.AgentAPIKey) error
+	ListAPIKeys(ctx context.Context, agentId uuid.UUID, environment string) ([]models.AgentAPIKey, error)
+	GetAPIKey(ctx context.Context, agentId uuid.UUID, keyId uuid.UUID) (*models.AgentAPIKey, error)
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*models.AgentAPIKey, error)
+	RotateAPIKey(ctx context.Context, keyId uuid.UUID, keyPrefix string, keyHash string) error
+	RevokeAPIKey(ctx context.Context, keyId uuid.UUID) error
+	UpdateAPIKeyLastUsed(ctx context.Context, keyId uuid.UUID, interval time.Duration) error
+}
+
+type apiKeyRepository struct{}
+
+func NewAPIKeyRepository() APIKeyRepository {
+	return &apiKeyRepository{}
+}
+
+func (r *apiKeyRepository) CreateAPIKey(ctx context.Context, apiKey *models.AgentAPIKey) error {
+	if err := db.DB(ctx).Create(apiKey).Error; err != nil {
+		return fmt.Errorf("apiKeyRepository.CreateAPIKey: %w", err)
+	}
+	return nil
+}
+
+// ListAPIKeys lists the active keys of an agent, optionally limited to an environment
+func (r *apiKeyRepository) ListAPIKeys(ctx context.Context, agentId uuid.UUID, environment string) ([]models.AgentAPIKey, error) {
+	var apiKeys []models.AgentAPIKey
+	query := db.DB(ctx).Where("agent_id = ? AND revoked_at IS NULL", agentId)
+	if environment != "" {
+		query = query.Where("environment = ?", environment)
+	}
+	if err := query.Order("created_at DESC").Find(&apiKeys).Error; err != nil {
+		return nil, fmt.Errorf("apiKeyRepository.ListAPIKeys: %w", err)
+	}
+	return apiKeys, nil
+}
+
+// GetAPIKey returns an active key of an agent
+func (r *apiKeyRepository) GetAPIKey(ctx context.Context, agentId uuid.UUID, keyId uuid.UUID) (*models.AgentAPIKey, error) {
+	var apiKey models.AgentAPIKey
+	if err := db.DB(ctx).
+		Where("id = ? AND agent_id = ? AND revoked_at IS NULL", keyId, agentId).
+		First(&apiKey).Error; err != nil {
+		return nil, fmt.Errorf("apiKeyRepository.GetAPIKey: %w", err)
+	}
+	return &apiKey, nil
+}
+
+// GetActiveAPIKeyByHash returns the active key with the given hash, as long as its agent has not been deleted
+func (r *apiKeyRepository) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*models.AgentAPIKey, error) {
+	var apiKey models.AgentAPIKey
+	if err := db.DB(ctx).
+		Joins("JOIN agents ON agents.id = agent_api_keys.agent_id AND agents.deleted_at IS NULL").
+		Where("agent_api_keys.key_hash = ? AND agent_api_keys.revoked_at IS NULL", keyHash).
+		First(&apiKey).Error; err != nil {
+		return nil, fmt.Errorf("apiKeyRepository.GetActiveAPIKeyByHash: %w", err)
+	}
+	return &apiKey, nil
+}
+
+func (r *apiKeyRepository) RotateAPIKey(ctx context.Context, keyId uuid.UUID, keyPrefix string, keyHash string) error {
+	if err := db.DB(ctx).Model(&models.AgentAPIKey{}).
+		Where("id = ? AND revoked_at IS NULL", keyId).
+		Updates(map[string]interface{}{
+			"key_prefix":   keyPrefix,
+			"key_hash":     keyHash,
+			"rotated_at":   gorm.Expr("NOW()"),
+			"last_used_at": nil,
+		}).Error; err != nil {
+		return fmt.Errorf("apiKeyRepository.RotateAPIKey: %w", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) RevokeAPIKey(ctx context.Context, keyId uuid.UUID) error {
+	if err := db.DB(ctx).Model(&models.AgentAPIKey{}).
+		Where("id = ? AND revoked_at IS NULL", keyId).
+		Update("revoked_at", gorm.Expr("NOW()")).Error; err != nil {
+		return fmt.Errorf("apiKeyRepository.RevokeAPIKey: %w", err)
+	}
+	return nil
+}
+
+// UpdateAPIKeyLastUsed records that a key was used. To avoid a write on every request, the timestamp
+// is only updated when it is older than the given interval.
+func (r *apiKeyRepository) UpdateAPIKeyLastUsed(ctx context.Context, keyId uuid.UUID, interval time.Duration) error {
+	if err := db.DB(ctx).Model(&models.AgentAPIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", keyId, time.Now().Add(-interval)).
+		Update("last_used_at", gorm.Expr("NOW()")).Error; err != nil {
+		return fmt.Errorf("apiKeyRepository.UpdateAPIKeyLastUsed: %w", err)
+	}
+	return nil
+}
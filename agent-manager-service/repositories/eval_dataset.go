@@ -0,0 +1,136 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type EvalDatasetRepository interface {
+	CreateDatasetVersion(ctx context.Context, dataset *models.EvalDataset, items []models.EvalDatasetItem) error
+	GetLatestDataset(ctx context.Context, projectId uuid.UUID, name string) (*models.EvalDataset, error)
+	GetDatasetVersion(ctx context.Context, projectId uuid.UUID, name string, version int) (*models.EvalDataset, error)
+	ListLatestDatasets(ctx context.Context, projectId uuid.UUID) ([]models.EvalDataset, error)
+	ListDatasetItems(ctx context.Context, datasetId uuid.UUID) ([]models.EvalDatasetItem, error)
+	CountDatasetItems(ctx context.Context, datasetIds []uuid.UUID) (map[uuid.UUID]int, error)
+	DeleteDataset(ctx context.Context, projectId uuid.UUID, name string) error
+}
+
+type evalDatasetRepository struct{}
+
+func NewEvalDatasetRepository() EvalDatasetRepository {
+	return &evalDatasetRepository{}
+}
+
+// CreateDatasetVersion stores a dataset version together with its items
+func (r *evalDatasetRepository) CreateDatasetVersion(ctx context.Context, dataset *models.EvalDataset, items []models.EvalDatasetItem) error {
+	if err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dataset).Error; err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(items, 100).Error
+	}); err != nil {
+		return fmt.Errorf("evalDatasetRepository.CreateDatasetVersion: %w", err)
+	}
+	return nil
+}
+
+func (r *evalDatasetRepository) GetLatestDataset(ctx context.Context, projectId uuid.UUID, name string) (*models.EvalDataset, error) {
+	var dataset models.EvalDataset
+	if err := db.DB(ctx).
+		Where("project_id = ? AND name = ?", projectId, name).
+		Order("version DESC").
+		First(&dataset).Error; err != nil {
+		return nil, fmt.Errorf("evalDatasetRepository.GetLatestDataset: %w", err)
+	}
+	return &dataset, nil
+}
+
+func (r *evalDatasetRepository) GetDatasetVersion(ctx context.Context, projectId uuid.UUID, name string, version int) (*models.EvalDataset, error) {
+	var dataset models.EvalDataset
+	if err := db.DB(ctx).
+		Where("project_id = ? AND name = ? AND version = ?", projectId, name, version).
+		First(&dataset).Error; err != nil {
+		return nil, fmt.Errorf("evalDatasetRepository.GetDatasetVersion: %w", err)
+	}
+	return &dataset, nil
+}
+
+// ListLatestDatasets returns the latest version of every dataset of a project, ordered by name
+func (r *evalDatasetRepository) ListLatestDatasets(ctx context.Context, projectId uuid.UUID) ([]models.EvalDataset, error) {
+	datasets := []models.EvalDataset{}
+	if err := db.DB(ctx).
+		Raw("SELECT DISTINCT ON (name) * FROM eval_datasets WHERE project_id = ? ORDER BY name, version DESC", projectId).
+		Scan(&datasets).Error; err != nil {
+		return nil, fmt.Errorf("evalDatasetRepository.ListLatestDatasets: %w", err)
+	}
+	return datasets, nil
+}
+
+func (r *evalDatasetRepository) ListDatasetItems(ctx context.Context, datasetId uuid.UUID) ([]models.EvalDatasetItem, error) {
+	items := []models.EvalDatasetItem{}
+	if err := db.DB(ctx).
+		Where("dataset_id = ?", datasetId).
+		Order("position ASC").
+		Find(&items).Error; err != nil {
+		return nil, fmt.Errorf("evalDatasetRepository.ListDatasetItems: %w", err)
+	}
+	return items, nil
+}
+
+// CountDatasetItems returns the number of items of each of the given dataset versions
+func (r *evalDatasetRepository) CountDatasetItems(ctx context.Context, datasetIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(datasetIds))
+	if len(datasetIds) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		DatasetID uuid.UUID
+		Count     int
+	}
+	if err := db.DB(ctx).Model(&models.EvalDatasetItem{}).
+		Select("dataset_id, COUNT(*) AS count").
+		Where("dataset_id IN ?", datasetIds).
+		Group("dataset_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("evalDatasetRepository.CountDatasetItems: %w", err)
+	}
+	for _, row := range rows {
+		counts[row.DatasetID] = row.Count
+	}
+	return counts, nil
+}
+
+// DeleteDataset deletes every version of a dataset. Items are removed by cascade.
+func (r *evalDatasetRepository) DeleteDataset(ctx context.Context, projectId uuid.UUID, name string) error {
+	if err := db.DB(ctx).
+		Where("project_id = ? AND name = ?", projectId, name).
+		Delete(&models.EvalDataset{}).Error; err != nil {
+		return fmt.Errorf("evalDatasetRepository.DeleteDataset: %w", err)
+	}
+	return nil
+}
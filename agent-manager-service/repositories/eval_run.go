@@ -0,0 +1,146 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type EvalRunRepository interface {
+	CreateEvalRun(ctx context.Context, run *models.EvalRun) error
+	FinishEvalRun(ctx context.Context, run *models.EvalRun) error
+	FailRunningEvalRuns(ctx context.Context, errorMessage string, completedAt time.Time) (int64, error)
+	GetEvalRun(ctx context.Context, projectId uuid.UUID, runId uuid.UUID) (*models.EvalRun, error)
+	ListEvalRuns(ctx context.Context, projectId uuid.UUID, datasetName string, agentName string) ([]models.EvalRun, error)
+	CreateEvalRunResult(ctx context.Context, result *models.EvalRunResult) error
+	ListEvalRunResults(ctx context.Context, runId uuid.UUID) ([]models.EvalRunResult, error)
+	CountEvalRunResults(ctx context.Context, runIds []uuid.UUID) (map[uuid.UUID]int, error)
+}
+
+type evalRunRepository struct{}
+
+func NewEvalRunRepository() EvalRunRepository {
+	return &evalRunRepository{}
+}
+
+func (r *evalRunRepository) CreateEvalRun(ctx context.Context, run *models.EvalRun) error {
+	if err := db.DB(ctx).Create(run).Error; err != nil {
+		return fmt.Errorf("evalRunRepository.CreateEvalRun: %w", err)
+	}
+	return nil
+}
+
+// FinishEvalRun records the final status, summary and error of a run
+func (r *evalRunRepository) FinishEvalRun(ctx context.Context, run *models.EvalRun) error {
+	if err := db.DB(ctx).Model(run).
+		Select("status", "summary", "error", "completed_at").
+		Updates(run).Error; err != nil {
+		return fmt.Errorf("evalRunRepository.FinishEvalRun: %w", err)
+	}
+	return nil
+}
+
+// FailRunningEvalRuns marks every run that is still running as failed with the given error, and returns how many
+// runs were marked
+func (r *evalRunRepository) FailRunningEvalRuns(ctx context.Context, errorMessage string, completedAt time.Time) (int64, error) {
+	result := db.DB(ctx).Model(&models.EvalRun{}).
+		Where("status = ?", utils.EvalRunStatusRunning).
+		Updates(map[string]interface{}{
+			"status":       utils.EvalRunStatusFailed,
+			"error":        errorMessage,
+			"completed_at": completedAt,
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("evalRunRepository.FailRunningEvalRuns: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *evalRunRepository) GetEvalRun(ctx context.Context, projectId uuid.UUID, runId uuid.UUID) (*models.EvalRun, error) {
+	var run models.EvalRun
+	if err := db.DB(ctx).
+		Where("project_id = ? AND id = ?", projectId, runId).
+		First(&run).Error; err != nil {
+		return nil, fmt.Errorf("evalRunRepository.GetEvalRun: %w", err)
+	}
+	return &run, nil
+}
+
+// ListEvalRuns returns the runs of a project, most recent first, optionally filtered by dataset and agent
+func (r *evalRunRepository) ListEvalRuns(ctx context.Context, projectId uuid.UUID, datasetName string, agentName string) ([]models.EvalRun, error) {
+	runs := []models.EvalRun{}
+	query := db.DB(ctx).Where("project_id = ?", projectId)
+	if datasetName != "" {
+		query = query.Where("dataset_name = ?", datasetName)
+	}
+	if agentName != "" {
+		query = query.Where("agent_name = ?", agentName)
+	}
+	if err := query.Order("created_at DESC").Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("evalRunRepository.ListEvalRuns: %w", err)
+	}
+	return runs, nil
+}
+
+func (r *evalRunRepository) CreateEvalRunResult(ctx context.Context, result *models.EvalRunResult) error {
+	if err := db.DB(ctx).Create(result).Error; err != nil {
+		return fmt.Errorf("evalRunRepository.CreateEvalRunResult: %w", err)
+	}
+	return nil
+}
+
+func (r *evalRunRepository) ListEvalRunResults(ctx context.Context, runId uuid.UUID) ([]models.EvalRunResult, error) {
+	results := []models.EvalRunResult{}
+	if err := db.DB(ctx).
+		Where("run_id = ?", runId).
+		Order("position ASC").
+		Find(&results).Error; err != nil {
+		return nil, fmt.Errorf("evalRunRepository.ListEvalRunResults: %w", err)
+	}
+	return results, nil
+}
+
+// CountEvalRunResults returns the number of finished items of each of the given runs
+func (r *evalRunRepository) CountEvalRunResults(ctx context.Context, runIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(runIds))
+	if len(runIds) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		RunID uuid.UUID
+		Count int
+	}
+	if err := db.DB(ctx).Model(&models.EvalRunResult{}).
+		Select("run_id, COUNT(*) AS count").
+		Where("run_id IN ?", runIds).
+		Group("run_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("evalRunRepository.CountEvalRunResults: %w", err)
+	}
+	for _, row := range rows {
+		counts[row.RunID] = row.Count
+	}
+	return counts, nil
+}
@@ -29,6 +29,7 @@ import (
 type InternalAgentRepository interface {
 	GetAgentById(ctx context.Context, agentId uuid.UUID) (*models.InternalAgent, error)
 	CreateInternalAgent(ctx context.Context, agent *models.InternalAgent) error
+	UpdateWorkloadSpec(ctx context.Context, agent *models.InternalAgent) error
 }
 
 type internalAgentRepository struct{}
@@ -51,3 +52,13 @@ func (r *internalAgentRepository) CreateInternalAgent(ctx context.Context, agent
 	}
 	return nil
 }
+
+// UpdateWorkloadSpec replaces the workload spec the next build of the agent is generated from
+func (r *internalAgentRepository) UpdateWorkloadSpec(ctx context.Context, agent *models.InternalAgent) error {
+	if err := db.DB(ctx).Model(agent).
+		Select("workload_spec").
+		Updates(agent).Error; err != nil {
+		return fmt.Errorf("internalAgentRepository.UpdateWorkloadSpec: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type TraceAnnotationRepository interface {
+	CreateTraceAnnotation(ctx context.Context, annotation *models.TraceAnnotation) error
+	GetTraceAnnotation(ctx context.Context, agentId uuid.UUID, traceId string, annotationId uuid.UUID) (*models.TraceAnnotation, error)
+	ListTraceAnnotations(ctx context.Context, agentId uuid.UUID, traceIds []string) ([]models.TraceAnnotation, error)
+	ListTraceIDsByLabel(ctx context.Context, agentId uuid.UUID, label string) ([]string, error)
+	UpdateTraceAnnotation(ctx context.Context, annotation *models.TraceAnnotation) error
+	DeleteTraceAnnotation(ctx context.Context, annotationId uuid.UUID) error
+}
+
+type traceAnnotationRepository struct{}
+
+func NewTraceAnnotationRepository() TraceAnnotationRepository {
+	return &traceAnnotationRepository{}
+}
+
+func (r *traceAnnotationRepository) CreateTraceAnnotation(ctx context.Context, annotation *models.TraceAnnotation) error {
+	if err := db.DB(ctx).Create(annotation).Error; err != nil {
+		return fmt.Errorf("traceAnnotationRepository.CreateTraceAnnotation: %w", err)
+	}
+	return nil
+}
+
+func (r *traceAnnotationRepository) GetTraceAnnotation(ctx context.Context, agentId uuid.UUID, traceId string, annotationId uuid.UUID) (*models.TraceAnnotation, error) {
+	var annotation models.TraceAnnotation
+	if err := db.DB(ctx).
+		Where("id = ? AND agent_id = ? AND trace_id = ?", annotationId, agentId, traceId).
+		First(&annotation).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.GetTraceAnnotation: %w", err)
+	}
+	return &annotation, nil
+}
+
+// ListTraceAnnotations lists the annotations of the given traces of an agent, oldest first
+func (r *traceAnnotationRepository) ListTraceAnnotations(ctx context.Context, agentId uuid.UUID, traceIds []string) ([]models.TraceAnnotation, error) {
+	annotations := []models.TraceAnnotation{}
+	if len(traceIds) == 0 {
+		return annotations, nil
+	}
+	if err := db.DB(ctx).
+		Where("agent_id = ? AND trace_id IN ?", agentId, traceIds).
+		Order("created_at ASC").
+		Find(&annotations).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.ListTraceAnnotations: %w", err)
+	}
+	return annotations, nil
+}
+
+// ListTraceIDsByLabel returns the distinct IDs of the agent's traces that have an annotation with the given label
+func (r *traceAnnotationRepository) ListTraceIDsByLabel(ctx context.Context, agentId uuid.UUID, label string) ([]string, error) {
+	var traceIds []string
+	if err := db.DB(ctx).Model(&models.TraceAnnotation{}).
+		Where("agent_id = ? AND label = ?", agentId, label).
+		Distinct().
+		Pluck("trace_id", &traceIds).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.ListTraceIDsByLabel: %w", err)
+	}
+	return traceIds, nil
+}
+
+func (r *traceAnnotationRepository) UpdateTraceAnnotation(ctx context.Context, annotation *models.TraceAnnotation) error {
+	if err := db.DB(ctx).Model(&models.TraceAnnotation{}).
+		Where("id = ?", annotation.ID).
+		Updates(map[string]interface{}{
+			"score":      annotation.Score,
+			"label":      annotation.Label,
+			"comment":    annotation.Comment,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		return fmt.Errorf("traceAnnotationRepository.UpdateTraceAnnotation: %w", err)
+	}
+	return nil
+}
+
+func (r *traceAnnotationRepository) DeleteTraceAnnotation(ctx context.Context, annotationId uuid.UUID) error {
+	if err := db.DB(ctx).Where("id = ?", annotationId).Delete(&models.TraceAnnotation{}).Error; err != nil {
+		return fmt.Errorf("traceAnnotationRepository.DeleteTraceAnnotation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/google/uuid"
+
+	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// configOverrideUniqueConstraint keeps a key from being overridden twice in the same environment of an agent
+const configOverrideUniqueConstraint = "uk_agent_config_overrides_agent_environment_key"
+
+type AgentConfigManagerService interface {
+	GetEffectiveConfigurations(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) ([]models.EffectiveConfigItem, error)
+	ListConfigOverrides(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) (*models.ConfigOverrideListResponse, error)
+	CreateConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, req models.CreateConfigOverrideRequest, redeploy bool) (*models.ConfigOverrideResponse, error)
+	UpdateConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, key string, req models.UpdateConfigOverrideRequest, redeploy bool) (*models.ConfigOverrideResponse, error)
+	DeleteConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, key string, redeploy bool) error
+	RedeployConfigurations(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) ([]models.EffectiveConfigItem, error)
+}
+
+type agentConfigManagerService struct {
+	OrganizationRepository        repositories.OrganizationRepository
+	ProjectRepository             repositories.ProjectRepository
+	AgentRepository               repositories.AgentRepository
+	AgentConfigOverrideRepository repositories.AgentConfigOverrideRepository
+	OpenChoreoSvcClient           clients.OpenChoreoSvcClient
+	logger                        *slog.Logger
+}
+
+func NewAgentConfigManagerService(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	configOverrideRepo repositories.AgentConfigOverrideRepository,
+	openChoreoSvcClient clients.OpenChoreoSvcClient,
+	logger *slog.Logger,
+) AgentConfigManagerService {
+	return &agentConfigManagerService{
+		OrganizationRepository:        orgRepo,
+		ProjectRepository:             projRepo,
+		AgentRepository:               agentRepo,
+		AgentConfigOverrideRepository: configOverrideRepo,
+		OpenChoreoSvcClient:           openChoreoSvcClient,
+		logger:                        logger,
+	}
+}
+
+// GetEffectiveConfigurations returns the configuration an agent runs with in an environment: the env of its runtime
+// configuration, with the overrides of the environment applied over it
+func (s *agentConfigManagerService) GetEffectiveConfigurations(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) ([]models.EffectiveConfigItem, error) {
+	s.logger.Info("Getting agent configurations", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment)
+	dbAgent, err := s.getInternalAgent(ctx, userIdpId, agent, environment)
+	if err != nil {
+		return nil, err
+	}
+	return s.getEffectiveConfigurations(ctx, dbAgent, environment)
+}
+
+// ListConfigOverrides lists the overrides of the configuration of an agent in an environment
+func (s *agentConfigManagerService) ListConfigOverrides(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) (*models.ConfigOverrideListResponse, error) {
+	s.logger.Info("Listing configuration overrides", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment)
+	dbAgent, err := s.getInternalAgent(ctx, userIdpId, agent, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := s.AgentConfigOverrideRepository.ListConfigOverrides(ctx, dbAgent.ID, environment)
+	if err != nil {
+		s.logger.Error("Failed to list configuration overrides", "agentName", agent.AgentName, "environment", environment, "error", err)
+		return nil, fmt.Errorf("failed to list configuration overrides: %w", err)
+	}
+
+	response := &models.ConfigOverrideListResponse{
+		Overrides: make([]models.ConfigOverrideResponse, 0, len(overrides)),
+		Total:     len(overrides),
+	}
+	for i := range overrides {
+		response.Overrides = append(response.Overrides, *toConfigOverrideResponse(&overrides[i]))
+	}
+	return response, nil
+}
+
+// CreateConfigOverride overrides a configuration value in an environment. The override is applied to the deployment of
+// the environment right away when redeploy is set, and with the next redeploy otherwise.
+func (s *agentConfigManagerService) CreateConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, req models.CreateConfigOverrideRequest, redeploy bool) (*models.ConfigOverrideResponse, error) {
+	s.logger.Info("Creating configuration override", "key", req.Key, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment, "redeploy", redeploy)
+	if err := utils.ValidateConfigOverride(req.Key, req.Value); err != nil {
+		return nil, fmt.Errorf("%w: %s", utils.ErrInvalidConfigOverride, err)
+	}
+	dbAgent, err := s.getInternalAgent(ctx, userIdpId, agent, environment)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.AgentConfigOverrideRepository.GetConfigOverride(ctx, dbAgent.ID, environment, req.Key); err == nil {
+		return nil, utils.ErrConfigOverrideExists
+	} else if !db.IsRecordNotFoundError(err) {
+		return nil, fmt.Errorf("failed to check configuration override %s: %w", req.Key, err)
+	}
+
+	override := &models.AgentConfigOverride{
+		ID:          uuid.New(),
+		AgentID:     dbAgent.ID,
+		Environment: environment,
+		Key:         req.Key,
+		Value:       req.Value,
+		CreatedBy:   userIdpId,
+	}
+	if err := s.AgentConfigOverrideRepository.CreateConfigOverride(ctx, override); err != nil {
+		// A concurrent request may have created the override since it was checked
+		if db.IsUniqueViolationError(err, configOverrideUniqueConstraint) {
+			return nil, utils.ErrConfigOverrideExists
+		}
+		s.logger.Error("Failed to store configuration override", "key", req.Key, "agentName", agent.AgentName, "environment", environment, "error", err)
+		return nil, fmt.Errorf("failed to store configuration override: %w", err)
+	}
+	if redeploy {
+		err := s.redeployConfigOverrides(ctx, agent, dbAgent.ID, environment, func(ctx context.Context) error {
+			return s.AgentConfigOverrideRepository.DeleteConfigOverride(ctx, override.ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Read back the override to pick up database defaults
+	created, err := s.AgentConfigOverrideRepository.GetConfigOverride(ctx, dbAgent.ID, environment, req.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch configuration override: %w", err)
+	}
+	s.logger.Info("Created configuration override successfully", "key", req.Key, "agentName", agent.AgentName, "environment", environment)
+	return toConfigOverrideResponse(created), nil
+}
+
+// UpdateConfigOverride changes the value of an override, redeploying the environment when redeploy is set
+func (s *agentConfigManagerService) UpdateConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, key string, req models.UpdateConfigOverrideRequest, redeploy bool) (*models.ConfigOverrideResponse, error) {
+	s.logger.Info("Updating configuration override", "key", key, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment, "redeploy", redeploy)
+	if err := utils.ValidateConfigOverride(key, req.Value); err != nil {
+		return nil, fmt.Errorf("%w: %s", utils.ErrInvalidConfigOverride, err)
+	}
+	override, err := s.getConfigOverride(ctx, userIdpId, agent, environment, key)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *override
+	override.Value = req.Value
+	if err := s.AgentConfigOverrideRepository.UpdateConfigOverride(ctx, override); err != nil {
+		s.logger.Error("Failed to update configuration override", "key", key, "agentName", agent.AgentName, "environment", environment, "error", err)
+		return nil, fmt.Errorf("failed to update configuration override: %w", err)
+	}
+	if redeploy {
+		err := s.redeployConfigOverrides(ctx, agent, override.AgentID, environment, func(ctx context.Context) error {
+			return s.AgentConfigOverrideRepository.UpdateConfigOverride(ctx, &previous)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Read back the override to pick up the new update time
+	updated, err := s.AgentConfigOverrideRepository.GetConfigOverride(ctx, override.AgentID, environment, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch configuration override: %w", err)
+	}
+	return toConfigOverrideResponse(updated), nil
+}
+
+// DeleteConfigOverride removes an override, so that the environment falls back to the base value of the key
+func (s *agentConfigManagerService) DeleteConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, key string, redeploy bool) error {
+	s.logger.Info("Deleting configuration override", "key", key, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment, "redeploy", redeploy)
+	override, err := s.getConfigOverride(ctx, userIdpId, agent, environment, key)
+	if err != nil {
+		return err
+	}
+
+	if err := s.AgentConfigOverrideRepository.DeleteConfigOverride(ctx, override.ID); err != nil {
+		s.logger.Error("Failed to delete configuration override", "key", key, "agentName", agent.AgentName, "environment", environment, "error", err)
+		return fmt.Errorf("failed to delete configuration override: %w", err)
+	}
+	if redeploy {
+		return s.redeployConfigOverrides(ctx, agent, override.AgentID, environment, func(ctx context.Context) error {
+			return s.AgentConfigOverrideRepository.CreateConfigOverride(ctx, override)
+		})
+	}
+	return nil
+}
+
+// RedeployConfigurations applies the overrides of an environment to the deployment of the agent in it, without a new
+// build, and returns the configuration the agent is redeployed with
+func (s *agentConfigManagerService) RedeployConfigurations(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) ([]models.EffectiveConfigItem, error) {
+	s.logger.Info("Redeploying agent configurations", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment)
+	dbAgent, err := s.getInternalAgent(ctx, userIdpId, agent, environment)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyConfigOverrides(ctx, agent, dbAgent.ID, environment); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Redeployed agent configurations successfully", "agentName", agent.AgentName, "environment", environment)
+	return s.getEffectiveConfigurations(ctx, dbAgent, environment)
+}
+
+// redeployConfigOverrides applies the overrides of an environment after a change to them was stored. The release
+// binding is only updated once the change is committed, and when it cannot be updated the change is reverted so that
+// the stored overrides keep matching the deployment.
+func (s *agentConfigManagerService) redeployConfigOverrides(ctx context.Context, agent AgentRef, agentId uuid.UUID, environment string, revert func(ctx context.Context) error) error {
+	err := s.applyConfigOverrides(ctx, agent, agentId, environment)
+	if err == nil {
+		return nil
+	}
+	if revertErr := revert(ctx); revertErr != nil {
+		s.logger.Error("Failed to revert configuration override after a failed redeploy", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment, "error", revertErr)
+	}
+	return err
+}
+
+// applyConfigOverrides sets the overrides of an environment on the release binding that deploys the agent to it.
+// Only the overrides are written, OpenChoreo applies them over the env of the released workload.
+func (s *agentConfigManagerService) applyConfigOverrides(ctx context.Context, agent AgentRef, agentId uuid.UUID, environment string) error {
+	overrides, err := s.AgentConfigOverrideRepository.ListConfigOverrides(ctx, agentId, environment)
+	if err != nil {
+		return fmt.Errorf("failed to list configuration overrides: %w", err)
+	}
+	envVars := make([]models.EnvVars, 0, len(overrides))
+	for _, override := range overrides {
+		envVars = append(envVars, models.EnvVars{Key: override.Key, Value: override.Value})
+	}
+	if err := s.OpenChoreoSvcClient.UpdateAgentConfigOverrides(ctx, agent.OrgName, agent.ProjectName, agent.AgentName, environment, envVars); err != nil {
+		s.logger.Error("Failed to apply configuration overrides in OpenChoreo", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment, "error", err)
+		if errors.Is(err, utils.ErrAgentNotDeployed) {
+			return utils.ErrAgentNotDeployed
+		}
+		return fmt.Errorf("failed to apply configuration overrides of agent %s: %w", agent.AgentName, err)
+	}
+	return nil
+}
+
+// getEffectiveConfigurations merges the overrides of an environment over the base env of the agent, ordered by key
+func (s *agentConfigManagerService) getEffectiveConfigurations(ctx context.Context, dbAgent *models.Agent, environment string) ([]models.EffectiveConfigItem, error) {
+	baseEnv, err := buildEnvVars(dbAgent.AgentDetails.WorkloadSpec)
+	if err != nil {
+		s.logger.Error("Failed to read base configuration", "agentName", dbAgent.Name, "error", err)
+		return nil, fmt.Errorf("failed to read base configuration: %w", err)
+	}
+	overrides, err := s.AgentConfigOverrideRepository.ListConfigOverrides(ctx, dbAgent.ID, environment)
+	if err != nil {
+		s.logger.Error("Failed to list configuration overrides", "agentName", dbAgent.Name, "environment", environment, "error", err)
+		return nil, fmt.Errorf("failed to list configuration overrides: %w", err)
+	}
+
+	configs := make(map[string]models.EffectiveConfigItem, len(baseEnv)+len(overrides))
+	for _, envVar := range baseEnv {
+		configs[envVar.Key] = models.EffectiveConfigItem{Key: envVar.Key, Value: envVar.Value, Source: utils.ConfigSourceBase}
+	}
+	for _, override := range overrides {
+		configs[override.Key] = models.EffectiveConfigItem{Key: override.Key, Value: override.Value, Source: utils.ConfigSourceOverride}
+	}
+
+	effective := make([]models.EffectiveConfigItem, 0, len(configs))
+	for _, config := range configs {
+		effective = append(effective, config)
+	}
+	sort.Slice(effective, func(i, j int) bool {
+		return effective[i].Key < effective[j].Key
+	})
+	return effective, nil
+}
+
+// getInternalAgent validates the organization, project and environment and returns the agent with its details.
+// Configuration only applies to agents deployed by the platform.
+func (s *agentConfigManagerService) getInternalAgent(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) (*models.Agent, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, agent.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", agent.OrgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", agent.OrgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, agent.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", agent.ProjectName, err)
+	}
+	dbAgent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agent.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if dbAgent.ProvisioningType != string(utils.InternalAgent) || dbAgent.AgentDetails == nil {
+		return nil, utils.ErrAgentNotInternal
+	}
+	if _, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, agent.OrgName, environment); err != nil {
+		s.logger.Error("Failed to validate environment", "environment", environment, "orgName", agent.OrgName, "error", err)
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			return nil, utils.ErrEnvironmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get environment %s: %w", environment, err)
+	}
+	return dbAgent, nil
+}
+
+// getConfigOverride returns an override of the configuration of the agent in an environment
+func (s *agentConfigManagerService) getConfigOverride(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string, key string) (*models.AgentConfigOverride, error) {
+	dbAgent, err := s.getInternalAgent(ctx, userIdpId, agent, environment)
+	if err != nil {
+		return nil, err
+	}
+	override, err := s.AgentConfigOverrideRepository.GetConfigOverride(ctx, dbAgent.ID, environment, key)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrConfigOverrideNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch configuration override: %w", err)
+	}
+	return override, nil
+}
+
+func toConfigOverrideResponse(override *models.AgentConfigOverride) *models.ConfigOverrideResponse {
+	return &models.ConfigOverrideResponse{
+		Key:         override.Key,
+		Value:       override.Value,
+		Environment: override.Environment,
+		CreatedAt:   override.CreatedAt,
+		UpdatedAt:   override.UpdatedAt,
+	}
+}
@@ -18,19 +18,27 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	mcpsvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/mcpsvc"
 	observabilitysvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
 	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/queuesvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/openapi"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
@@ -47,9 +55,12 @@ type AgentManagerService interface {
 	GetBuild(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string) (*models.BuildDetailsResponse, error)
 	GetAgentDeployments(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) ([]*models.DeploymentResponse, error)
 	GetAgentEndpoints(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environmentName string) (map[string]models.EndpointsResponse, error)
-	GetAgentConfigurations(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error)
+	GetAgentSchemaDiff(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environmentName string) (*models.SchemaDiffResponse, error)
+	UpdateAgentEndpoints(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, endpoints []spec.AgentEndpoint) error
+	UpdateAgentScaling(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environmentName string, req *spec.UpdateAgentScalingRequest) error
 	GetBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string) (*models.BuildLogsResponse, error)
 	GenerateName(ctx context.Context, userIdpId uuid.UUID, orgName string, payload spec.ResourceNameRequest) (string, error)
+	ListA2AAgents(ctx context.Context, userIdpId uuid.UUID, orgName string, environmentName string) ([]models.A2AAgentResponse, error)
 }
 
 type agentManagerService struct {
@@ -59,6 +70,8 @@ type agentManagerService struct {
 	InternalAgentRepository repositories.InternalAgentRepository
 	OpenChoreoSvcClient     clients.OpenChoreoSvcClient
 	ObservabilitySvcClient  observabilitysvc.ObservabilitySvcClient
+	MCPClient               mcpsvc.MCPClient
+	QueueClient             queuesvc.QueueClient
 	logger                  *slog.Logger
 }
 
@@ -69,6 +82,8 @@ func NewAgentManagerService(
 	internalAgentRepo repositories.InternalAgentRepository,
 	openChoreoSvcClient clients.OpenChoreoSvcClient,
 	observabilitySvcClient observabilitysvc.ObservabilitySvcClient,
+	mcpClient mcpsvc.MCPClient,
+	queueClient queuesvc.QueueClient,
 	logger *slog.Logger,
 ) AgentManagerService {
 	return &agentManagerService{
@@ -78,6 +93,8 @@ func NewAgentManagerService(
 		InternalAgentRepository: internalAgentRepo,
 		OpenChoreoSvcClient:     openChoreoSvcClient,
 		ObservabilitySvcClient:  observabilitySvcClient,
+		MCPClient:               mcpClient,
+		QueueClient:             queueClient,
 		logger:                  logger,
 	}
 }
@@ -169,11 +186,24 @@ func (s *agentManagerService) CreateAgent(ctx context.Context, userIdpId uuid.UU
 		return fmt.Errorf("failed to find organization %s: %w", orgName, err)
 	}
 	// Validate project exists in OpenChoreo
-	_, err = s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
+	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
 	if err != nil {
 		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
 		return err
 	}
+	// The resources and scaling of the request are what the agent is first released with, which is to the first
+	// environment of the deployment pipeline
+	if req.RuntimeConfigs != nil && hasAgentScaling(req.RuntimeConfigs.Resources, req.RuntimeConfigs.Replicas, req.RuntimeConfigs.Autoscaling) {
+		pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, openChoreoProject)
+		if err != nil {
+			return err
+		}
+		scalingEnvironment := findLowestEnvironment(pipeline.PromotionPaths)
+		if err := validateAgentScalingCaps(scalingEnvironment, req.RuntimeConfigs.Resources, req.RuntimeConfigs.Replicas, req.RuntimeConfigs.Autoscaling); err != nil {
+			s.logger.Warn("Agent scaling exceeds the environment caps", "agentName", req.Name, "orgName", orgName, "projectName", projectName, "error", err)
+			return err
+		}
+	}
 	// Check if agent already exists
 	agent, err := s.OpenChoreoSvcClient.GetAgentComponent(ctx, orgName, projectName, req.Name)
 	if err != nil && err != utils.ErrAgentNotFound {
@@ -372,9 +402,16 @@ func (s *agentManagerService) saveAgentRecord(ctx context.Context, orgId uuid.UU
 				return fmt.Errorf("failed to build workload spec: %w", err)
 			}
 
+			agentCard, err := toAgentCardModel(req.AgentCard)
+			if err != nil {
+				s.logger.Error("Failed to convert agent card", "agentName", req.Name, "error", err)
+				return fmt.Errorf("failed to convert agent card: %w", err)
+			}
+
 			internalAgent := &models.InternalAgent{
 				ID:           agentId,
 				WorkloadSpec: workloadSpec,
+				AgentCard:    agentCard,
 			}
 
 			if err := s.InternalAgentRepository.CreateInternalAgent(txCtx, internalAgent); err != nil {
@@ -577,41 +614,118 @@ func (s *agentManagerService) DeployAgent(ctx context.Context, userIdpId uuid.UU
 		return "", fmt.Errorf("deploy operation is not supported for agent type: '%s'", agent.ProvisioningType)
 	}
 
+	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
+	if err != nil {
+		s.logger.Error("Failed to fetch OpenChoreo project", "orgName", orgName, "projectName", projectName, "error", err)
+		return "", fmt.Errorf("failed to fetch openchoreo project: %w", err)
+	}
+	pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, openChoreoProject)
+	if err != nil {
+		return "", err
+	}
+	// The resources and scaling of the request apply to the environment the agent is deployed to
+	lowestEnv := findLowestEnvironment(pipeline.PromotionPaths)
+	if err := validateAgentScalingCaps(lowestEnv, req.Resources, req.Replicas, req.Autoscaling); err != nil {
+		s.logger.Warn("Agent scaling exceeds the environment caps", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+		return "", err
+	}
+
 	// Create a new request with the combined environment variables
 	deployReq := &spec.DeployAgentRequest{
 		ImageId: req.ImageId,
 		Env:     req.Env,
 	}
 
-	// Deploy agent component in Open Choreo
+	// Deploy agent component in Open Choreo. The env of the request replaces the base configuration of the agent,
+	// which the configuration overrides of each environment are applied over.
 	s.logger.Debug("Deploying agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "imageId", req.ImageId)
+	if hasAgentScaling(req.Resources, req.Replicas, req.Autoscaling) {
+		err := s.OpenChoreoSvcClient.UpdateAgentScaling(ctx, orgName, projectName, agentName, lowestEnv, req.Resources, req.Replicas, req.Autoscaling)
+		switch {
+		case errors.Is(err, utils.ErrAgentNotDeployed):
+			// The first deployment to the environment is released with the resources and scaling of the component
+			deployReq.Resources, deployReq.Replicas, deployReq.Autoscaling = req.Resources, req.Replicas, req.Autoscaling
+		case err != nil:
+			s.logger.Error("Failed to update agent scaling in OpenChoreo", "agentName", agentName, "environment", lowestEnv, "error", err)
+			return "", fmt.Errorf("failed to update agent scaling: agentName %s, error: %w", agentName, err)
+		}
+	}
 	if err := s.OpenChoreoSvcClient.DeployAgentComponent(ctx, orgName, projectName, agentName, deployReq); err != nil {
 		s.logger.Error("Failed to deploy agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
 		return "", fmt.Errorf("failed to deploy agent component: agentName %s, error: %w", agentName, err)
 	}
-	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
+	// The base configuration is only stored once it is deployed, so that a failed deployment does not change it
+	if req.Env != nil && agent.AgentDetails != nil {
+		if agent.AgentDetails.WorkloadSpec == nil {
+			agent.AgentDetails.WorkloadSpec = map[string]interface{}{}
+		}
+		agent.AgentDetails.WorkloadSpec["envVars"] = req.Env
+		if err := s.InternalAgentRepository.UpdateWorkloadSpec(ctx, agent.AgentDetails); err != nil {
+			s.logger.Error("Failed to update workload spec", "agentName", agentName, "error", err)
+			return "", fmt.Errorf("failed to update workload spec: %w", err)
+		}
+	}
+	err = s.AgentRepository.UpdateAgentTimestamp(ctx, org.ID, project.ID, agentName)
 	if err != nil {
-		s.logger.Error("Failed to fetch OpenChoreo project", "orgName", orgName, "projectName", projectName, "error", err)
-		return "", fmt.Errorf("failed to fetch openchoreo project: %w", err)
+		s.logger.Error("Failed to update agent timestamp after successful deployment", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
 	}
+	s.logger.Info("Agent deployed successfully to "+lowestEnv, "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", lowestEnv)
+	return lowestEnv, nil
+}
 
-	pipelineName := openChoreoProject.DeploymentPipeline
+// getProjectDeploymentPipeline returns the deployment pipeline agents of a project are promoted through
+func (s *agentManagerService) getProjectDeploymentPipeline(ctx context.Context, orgName string, project *models.ProjectResponse) (*models.DeploymentPipelineResponse, error) {
+	pipelineName := project.DeploymentPipeline
 	if pipelineName == "" {
-		s.logger.Error("Project has no deployment pipeline configured", "orgName", orgName, "projectName", projectName)
-		return "", fmt.Errorf("project has no deployment pipeline configured")
+		s.logger.Error("Project has no deployment pipeline configured", "orgName", orgName, "projectName", project.Name)
+		return nil, fmt.Errorf("project has no deployment pipeline configured")
 	}
 	pipeline, err := s.OpenChoreoSvcClient.GetDeploymentPipeline(ctx, orgName, pipelineName)
 	if err != nil {
 		s.logger.Error("Failed to fetch deployment pipeline", "orgName", orgName, "pipelineName", pipelineName, "error", err)
-		return "", fmt.Errorf("failed to fetch deployment pipeline: %w", err)
+		return nil, fmt.Errorf("failed to fetch deployment pipeline: %w", err)
 	}
-	err = s.AgentRepository.UpdateAgentTimestamp(ctx, org.ID, project.ID, agentName)
-	if err != nil {
-		s.logger.Error("Failed to update agent timestamp after successful deployment", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+	return pipeline, nil
+}
+
+func hasAgentScaling(resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) bool {
+	return resources != nil || replicas != nil || autoscaling != nil
+}
+
+// validateAgentScalingCaps checks the resources and scaling of an agent against the caps of the environment they are
+// set for
+func validateAgentScalingCaps(environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+	if !hasAgentScaling(resources, replicas, autoscaling) {
+		return nil
 	}
-	lowestEnv := findLowestEnvironment(pipeline.PromotionPaths)
-	s.logger.Info("Agent deployed successfully to "+lowestEnv, "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", lowestEnv)
-	return lowestEnv, nil
+	scalingConfig := config.GetConfig().AgentScaling
+	caps, ok := scalingConfig.EnvironmentCaps[environment]
+	if !ok {
+		caps = scalingConfig.DefaultCaps
+	}
+	if err := utils.ValidateAgentScalingCaps(environment, caps, resources, replicas, autoscaling); err != nil {
+		return fmt.Errorf("%w: %s", utils.ErrInvalidAgentScaling, err)
+	}
+	return nil
+}
+
+// pipelineEnvironments returns the environments of a deployment pipeline in the order they are first referenced
+func pipelineEnvironments(promotionPaths []models.PromotionPath) []string {
+	var environments []string
+	seen := make(map[string]bool)
+	add := func(environment string) {
+		if environment != "" && !seen[environment] {
+			seen[environment] = true
+			environments = append(environments, environment)
+		}
+	}
+	for _, path := range promotionPaths {
+		add(path.SourceEnvironmentRef)
+		for _, target := range path.TargetEnvironmentRefs {
+			add(target.Name)
+		}
+	}
+	return environments
 }
 
 func findLowestEnvironment(promotionPaths []models.PromotionPath) string {
@@ -848,6 +962,21 @@ func (s *agentManagerService) GetAgentDeployments(ctx context.Context, userIdpId
 		s.logger.Error("Failed to get deployments from OpenChoreo", "agentName", agentName, "pipelineName", pipelineName, "orgName", orgName, "projectName", projectName, "error", err)
 		return nil, fmt.Errorf("failed to get deployments for agent %s: %w", agentName, err)
 	}
+	// Event-driven agents report the lag of their consumer group, which the environments they are deployed to share
+	if agent.AgentDetails != nil {
+		if queue := queueConfigFromWorkloadSpec(agent.AgentDetails.WorkloadSpec); queue != nil {
+			var queueStatus *models.QueueConsumerStatus
+			for _, deployment := range deployments {
+				if deployment.Status == clients.DeploymentStatusNotDeployed {
+					continue
+				}
+				if queueStatus == nil {
+					queueStatus = s.getQueueConsumerStatus(ctx, *queue)
+				}
+				deployment.Queue = queueStatus
+			}
+		}
+	}
 
 	s.logger.Info("Fetched deployments successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "deploymentCount", len(deployments))
 	return deployments, nil
@@ -897,12 +1026,40 @@ func (s *agentManagerService) GetAgentEndpoints(ctx context.Context, userIdpId u
 		return nil, fmt.Errorf("failed to get endpoints for agent %s: %w", agentName, err)
 	}
 
+	// MCP servers are described by what they list over MCP rather than by an OpenAPI schema
+	if agent.AgentDetails != nil {
+		for name, mcpEndpoint := range mcpEndpointsFromWorkloadSpec(agent.AgentDetails.WorkloadSpec) {
+			endpoint, ok := endpoints[name]
+			if !ok {
+				continue
+			}
+			endpoint.MCP = s.introspectMCPEndpoint(ctx, endpoint.URL, mcpEndpoint)
+			endpoints[name] = endpoint
+		}
+	}
+
+	// gRPC endpoints described by server reflection have no proto file in their schema
+	if agent.AgentDetails != nil {
+		for _, name := range grpcReflectionEndpointsFromWorkloadSpec(agent.AgentDetails.WorkloadSpec) {
+			endpoint, ok := endpoints[name]
+			if !ok {
+				continue
+			}
+			endpoint.Schema.Reflection = true
+			endpoints[name] = endpoint
+		}
+	}
+
 	s.logger.Info("Fetched endpoints successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environmentName, "endpointCount", len(endpoints))
 	return endpoints, nil
 }
 
-func (s *agentManagerService) GetAgentConfigurations(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error) {
-	s.logger.Info("Getting agent configurations", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "userIdpId", userIdpId)
+// GetAgentSchemaDiff compares the OpenAPI schemas of the HTTP endpoints of an agent deployed to an environment with
+// the ones deployed to the environment it is promoted to next, so that breaking changes can be reviewed before
+// promoting. Schemas of gRPC and WebSocket endpoints are not compared.
+func (s *agentManagerService) GetAgentSchemaDiff(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environmentName string) (*models.SchemaDiffResponse, error) {
+	s.logger.Info("Getting agent schema diff", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environmentName, "userIdpId", userIdpId)
+	// Validate organization exists
 	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
 	if err != nil {
 		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
@@ -928,25 +1085,497 @@ func (s *agentManagerService) GetAgentConfigurations(ctx context.Context, userId
 		return nil, fmt.Errorf("failed to fetch agent: %w", err)
 	}
 	if agent.ProvisioningType != string(utils.InternalAgent) {
-		s.logger.Warn("Configuration operation not supported for agent type", "agentName", agentName, "provisioningType", agent.ProvisioningType, "orgName", orgName, "projectName", projectName)
-		return nil, fmt.Errorf("configuration operation is not supported for agent type: '%s'", agent.ProvisioningType)
+		return nil, utils.ErrAgentNotInternal
 	}
-	// Check if environment exists
-	_, err = s.OpenChoreoSvcClient.GetEnvironment(ctx, orgName, environment)
+
+	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
 	if err != nil {
-		s.logger.Error("Failed to validate environment", "environment", environment, "orgName", orgName, "error", err)
-		return nil, fmt.Errorf("failed to get environments for organization %s: %w", orgName, err)
+		s.logger.Error("Failed to fetch OpenChoreo project", "projectName", projectName, "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to fetch openchoreo project: %w", err)
+	}
+	pipeline, err := s.OpenChoreoSvcClient.GetDeploymentPipeline(ctx, orgName, openChoreoProject.DeploymentPipeline)
+	if err != nil {
+		s.logger.Error("Failed to fetch deployment pipeline", "orgName", orgName, "pipelineName", openChoreoProject.DeploymentPipeline, "error", err)
+		return nil, fmt.Errorf("failed to fetch deployment pipeline: %w", err)
+	}
+	targetEnvironment, inPipeline := findPromotionTarget(environmentName, pipeline.PromotionPaths)
+	if !inPipeline {
+		return nil, utils.ErrEnvironmentNotFound
+	}
+	if targetEnvironment == "" {
+		return nil, utils.ErrNoPromotionTarget
+	}
+
+	schemas, err := s.OpenChoreoSvcClient.GetDeployedEndpointSchemas(ctx, orgName, projectName, agentName, environmentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch deployed endpoint schemas", "agentName", agentName, "environment", environmentName, "error", err)
+		return nil, fmt.Errorf("failed to get endpoint schemas deployed to %s: %w", environmentName, err)
+	}
+	// Nothing breaks when promoting to an environment the agent is not deployed to yet
+	targetSchemas, err := s.OpenChoreoSvcClient.GetDeployedEndpointSchemas(ctx, orgName, projectName, agentName, targetEnvironment)
+	if err != nil && !errors.Is(err, utils.ErrAgentNotDeployed) {
+		s.logger.Error("Failed to fetch deployed endpoint schemas", "agentName", agentName, "environment", targetEnvironment, "error", err)
+		return nil, fmt.Errorf("failed to get endpoint schemas deployed to %s: %w", targetEnvironment, err)
+	}
+
+	response := &models.SchemaDiffResponse{
+		Environment:       environmentName,
+		TargetEnvironment: targetEnvironment,
+		Endpoints:         diffEndpointSchemas(targetSchemas, schemas),
+	}
+	for _, endpoint := range response.Endpoints {
+		if endpoint.BreakingChanges > 0 || endpoint.Status == string(utils.SchemaDiffStatusRemoved) {
+			response.HasBreakingChanges = true
+		}
+	}
+	s.logger.Info("Fetched schema diff successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName,
+		"environment", environmentName, "targetEnvironment", targetEnvironment, "hasBreakingChanges", response.HasBreakingChanges)
+	return response, nil
+}
+
+// findPromotionTarget returns the environment an environment is promoted to, and whether the environment is part of
+// the deployment pipeline at all. The last environment of the pipeline has no promotion target.
+func findPromotionTarget(environmentName string, promotionPaths []models.PromotionPath) (string, bool) {
+	inPipeline := false
+	for _, path := range promotionPaths {
+		if path.SourceEnvironmentRef == environmentName {
+			// Since promotion is linear, take the first (and only) target
+			if len(path.TargetEnvironmentRefs) > 0 {
+				return path.TargetEnvironmentRefs[0].Name, true
+			}
+			inPipeline = true
+		}
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.Name == environmentName {
+				inPipeline = true
+			}
+		}
+	}
+	return "", inPipeline
+}
+
+// diffEndpointSchemas compares the schemas of the HTTP endpoints deployed to the target environment of a promotion
+// with the ones that are promoted, ordered by endpoint name
+func diffEndpointSchemas(deployed map[string]models.DeployedEndpointSchema, promoted map[string]models.DeployedEndpointSchema) []models.EndpointSchemaDiff {
+	names := make([]string, 0, len(deployed)+len(promoted))
+	for name, schema := range promoted {
+		if schema.Type == string(utils.InputInterfaceTypeHTTP) {
+			names = append(names, name)
+		}
+	}
+	for name, schema := range deployed {
+		if _, ok := promoted[name]; !ok && schema.Type == string(utils.InputInterfaceTypeHTTP) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diffs := make([]models.EndpointSchemaDiff, 0, len(names))
+	for _, name := range names {
+		diff := models.EndpointSchemaDiff{Name: name, Changes: []models.SchemaChange{}}
+		deployedSchema, isDeployed := deployed[name]
+		promotedSchema, isPromoted := promoted[name]
+		switch {
+		case !isDeployed:
+			diff.Status = string(utils.SchemaDiffStatusAdded)
+		case !isPromoted:
+			diff.Status = string(utils.SchemaDiffStatusRemoved)
+		case deployedSchema.Content == promotedSchema.Content:
+			diff.Status = string(utils.SchemaDiffStatusUnchanged)
+		default:
+			diff.Status = string(utils.SchemaDiffStatusModified)
+			schemaDiff, err := openapi.Compare([]byte(deployedSchema.Content), []byte(promotedSchema.Content))
+			if err != nil {
+				diff.Error = err.Error()
+				break
+			}
+			if len(schemaDiff.Changes) == 0 {
+				diff.Status = string(utils.SchemaDiffStatusUnchanged)
+			}
+			diff.BreakingChanges = schemaDiff.BreakingChanges
+			for _, change := range schemaDiff.Changes {
+				diff.Changes = append(diff.Changes, models.SchemaChange{
+					Path:       change.Path,
+					Property:   change.Property,
+					ChangeType: string(change.Type),
+					Original:   change.Original,
+					New:        change.New,
+					Breaking:   change.Breaking,
+					Line:       change.Line,
+				})
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// UpdateAgentEndpoints replaces the endpoints an api agent exposes besides its primary endpoint. The workload spec is
+// updated together with the component, so the next build deploys the workload with the new endpoints.
+func (s *agentManagerService) UpdateAgentEndpoints(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, endpoints []spec.AgentEndpoint) error {
+	s.logger.Info("Updating agent endpoints", "agentName", agentName, "orgName", orgName, "projectName", projectName, "endpointCount", len(endpoints), "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrProjectNotFound
+		}
+		return fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from repository", "agentName", agentName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if agent.ProvisioningType != string(utils.InternalAgent) || agent.AgentDetails == nil {
+		return utils.ErrAgentNotInternal
+	}
+
+	// The primary endpoint stays as it was created; only the endpoints listed after it are replaced
+	primaryEndpointName := fmt.Sprintf("%s-endpoint", agentName)
+	var primaryPort int32
+	workloadEndpoints := []interface{}{}
+	if existing, ok := agent.AgentDetails.WorkloadSpec["endpoints"].([]interface{}); ok {
+		for _, item := range existing {
+			endpoint, ok := item.(map[string]interface{})
+			if !ok || endpoint["name"] != primaryEndpointName {
+				continue
+			}
+			if port, ok := endpoint["port"].(float64); ok {
+				primaryPort = int32(port)
+			}
+			workloadEndpoints = append(workloadEndpoints, endpoint)
+		}
+	}
+	if err := utils.ValidateAgentEndpoints(endpoints, primaryPort); err != nil {
+		return fmt.Errorf("%w: %s", utils.ErrInvalidAgentEndpoints, err)
+	}
+	for _, endpoint := range buildAdditionalEndpointsSpec(endpoints) {
+		workloadEndpoints = append(workloadEndpoints, endpoint)
+	}
+	agent.AgentDetails.WorkloadSpec["endpoints"] = workloadEndpoints
+
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := db.CtxWithTx(ctx, tx)
+		if err := s.InternalAgentRepository.UpdateWorkloadSpec(txCtx, agent.AgentDetails); err != nil {
+			s.logger.Error("Failed to update workload spec", "agentName", agentName, "error", err)
+			return fmt.Errorf("failed to update workload spec: %w", err)
+		}
+		if err := s.OpenChoreoSvcClient.UpdateAgentEndpoints(ctx, orgName, projectName, agentName, endpoints); err != nil {
+			s.logger.Error("Failed to update agent endpoints in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			return fmt.Errorf("failed to update endpoints of agent %s: %w", agentName, err)
+		}
+		return nil
+	})
+}
+
+// UpdateAgentScaling sets the resources and scaling of an agent in an environment of its deployment pipeline. They
+// are checked against the caps of that environment only. Returns ErrAgentNotDeployed when the agent is not deployed
+// to the environment yet.
+func (s *agentManagerService) UpdateAgentScaling(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environmentName string, req *spec.UpdateAgentScalingRequest) error {
+	s.logger.Info("Updating agent scaling", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environmentName, "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrProjectNotFound
+		}
+		return fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from repository", "agentName", agentName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if agent.ProvisioningType != string(utils.InternalAgent) {
+		return utils.ErrAgentNotInternal
+	}
+
+	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
+	if err != nil {
+		s.logger.Error("Failed to fetch OpenChoreo project", "projectName", projectName, "orgName", orgName, "error", err)
+		return fmt.Errorf("failed to fetch openchoreo project: %w", err)
+	}
+	pipeline, err := s.getProjectDeploymentPipeline(ctx, orgName, openChoreoProject)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(pipelineEnvironments(pipeline.PromotionPaths), environmentName) {
+		return utils.ErrEnvironmentNotFound
+	}
+	if err := validateAgentScalingCaps(environmentName, req.Resources, req.Replicas, req.Autoscaling); err != nil {
+		s.logger.Warn("Agent scaling exceeds the environment caps", "agentName", agentName, "environment", environmentName, "error", err)
+		return err
+	}
+
+	if err := s.OpenChoreoSvcClient.UpdateAgentScaling(ctx, orgName, projectName, agentName, environmentName, req.Resources, req.Replicas, req.Autoscaling); err != nil {
+		s.logger.Error("Failed to update agent scaling in OpenChoreo", "agentName", agentName, "environment", environmentName, "error", err)
+		return fmt.Errorf("failed to update scaling of agent %s: %w", agentName, err)
+	}
+	return nil
+}
+
+// ListA2AAgents lists the deployed a2a agents of an organization with their agent cards and the URLs they are
+// reachable at in each environment. Only the given environment is considered when environmentName is set.
+// Agents that are not deployed to any of the environments are left out.
+func (s *agentManagerService) ListA2AAgents(ctx context.Context, userIdpId uuid.UUID, orgName string, environmentName string) ([]models.A2AAgentResponse, error) {
+	s.logger.Info("Listing A2A agents", "orgName", orgName, "environment", environmentName, "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+
+	var environments []string
+	if environmentName != "" {
+		if _, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, orgName, environmentName); err != nil {
+			s.logger.Error("Failed to validate environment", "environment", environmentName, "orgName", orgName, "error", err)
+			return nil, err
+		}
+		environments = []string{environmentName}
+	} else {
+		orgEnvironments, err := s.OpenChoreoSvcClient.ListOrgEnvironments(ctx, orgName)
+		if err != nil {
+			s.logger.Error("Failed to list environments", "orgName", orgName, "error", err)
+			return nil, fmt.Errorf("failed to list environments for organization %s: %w", orgName, err)
+		}
+		for _, env := range orgEnvironments {
+			environments = append(environments, env.Name)
+		}
+	}
+
+	agents, err := s.AgentRepository.ListA2AAgents(ctx, org.ID)
+	if err != nil {
+		s.logger.Error("Failed to list A2A agents", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to list a2a agents: %w", err)
+	}
+	projects, err := s.ProjectRepository.ListProjects(ctx, org.ID)
+	if err != nil {
+		s.logger.Error("Failed to list projects", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	projectNames := make(map[uuid.UUID]string, len(projects))
+	for _, project := range projects {
+		projectNames[project.ID] = project.Name
+	}
+
+	listed := make([]*models.Agent, 0, len(agents))
+	for _, agent := range agents {
+		if _, ok := projectNames[agent.ProjectId]; !ok || agent.AgentDetails == nil || agent.AgentDetails.AgentCard == nil {
+			continue
+		}
+		listed = append(listed, agent)
+	}
+
+	// Endpoints are looked up per agent and environment, so the lookups run concurrently
+	lookupCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	deployments := make([][]*models.A2AAgentDeployment, len(listed))
+	slots := make(chan struct{}, utils.A2AAgentLookupConcurrency)
+	var wg sync.WaitGroup
+	var lookupErr error
+	var errOnce sync.Once
+	for i, agent := range listed {
+		deployments[i] = make([]*models.A2AAgentDeployment, len(environments))
+		for j, env := range environments {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				slots <- struct{}{}
+				defer func() { <-slots }()
+				deployment, err := s.getA2AAgentDeployment(lookupCtx, orgName, projectNames[agent.ProjectId], agent.Name, env)
+				if err != nil {
+					errOnce.Do(func() {
+						lookupErr = err
+						cancel()
+					})
+					return
+				}
+				deployments[i][j] = deployment
+			}()
+		}
+	}
+	wg.Wait()
+	if lookupErr != nil {
+		return nil, lookupErr
 	}
 
-	s.logger.Debug("Fetching agent configurations from OpenChoreo", "agentName", agentName, "environment", environment, "orgName", orgName, "projectName", projectName)
-	configurations, err := s.OpenChoreoSvcClient.GetAgentConfigurations(ctx, orgName, projectName, agentName, environment)
+	a2aAgents := make([]models.A2AAgentResponse, 0, len(listed))
+	for i, agent := range listed {
+		agentDeployments := make([]models.A2AAgentDeployment, 0, len(environments))
+		for _, deployment := range deployments[i] {
+			if deployment != nil {
+				agentDeployments = append(agentDeployments, *deployment)
+			}
+		}
+		if len(agentDeployments) == 0 {
+			continue
+		}
+		a2aAgents = append(a2aAgents, models.A2AAgentResponse{
+			Name:        agent.Name,
+			DisplayName: agent.DisplayName,
+			ProjectName: projectNames[agent.ProjectId],
+			AgentCard:   *agent.AgentDetails.AgentCard,
+			Deployments: agentDeployments,
+		})
+	}
+
+	s.logger.Info("Listed A2A agents successfully", "orgName", orgName, "environment", environmentName, "count", len(a2aAgents))
+	return a2aAgents, nil
+}
+
+// getA2AAgentDeployment returns where an a2a agent is reachable in an environment, or nil when the agent is not
+// deployed there or does not expose its primary endpoint
+func (s *agentManagerService) getA2AAgentDeployment(ctx context.Context, orgName string, projectName string, agentName string, environment string) (*models.A2AAgentDeployment, error) {
+	endpoints, err := s.OpenChoreoSvcClient.GetAgentEndpoints(ctx, orgName, projectName, agentName, environment)
+	if err != nil {
+		if errors.Is(err, utils.ErrAgentNotDeployed) || errors.Is(err, utils.ErrAgentNotFound) || errors.Is(err, utils.ErrAgentEndpointNotFound) {
+			s.logger.Debug("Skipping environment without a deployment", "agentName", agentName, "projectName", projectName, "environment", environment, "error", err)
+			return nil, nil
+		}
+		s.logger.Error("Failed to fetch endpoints", "agentName", agentName, "projectName", projectName, "environment", environment, "error", err)
+		return nil, fmt.Errorf("failed to get endpoints for agent %s in environment %s: %w", agentName, environment, err)
+	}
+	endpoint, ok := endpoints[fmt.Sprintf("%s-endpoint", agentName)]
+	if !ok || endpoint.URL == "" {
+		return nil, nil
+	}
+	url := strings.TrimSuffix(endpoint.URL, "/")
+	return &models.A2AAgentDeployment{
+		Environment:  environment,
+		URL:          url,
+		AgentCardURL: url + utils.A2AAgentCardPath,
+	}, nil
+}
+
+// queueConfigFromWorkloadSpec returns the queue stored with the workload spec of an event-driven agent, or nil for
+// agents that do not consume from a queue
+func queueConfigFromWorkloadSpec(workloadSpec map[string]interface{}) *models.QueueConfig {
+	queueSpec, ok := workloadSpec["queue"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	data, err := json.Marshal(queueSpec)
+	if err != nil {
+		return nil
+	}
+	queue := &models.QueueConfig{}
+	if err := json.Unmarshal(data, queue); err != nil {
+		return nil
+	}
+	return queue
+}
+
+// getQueueConsumerStatus reads the lag of the consumer group of an event-driven agent. A broker that cannot be
+// reached does not fail the deployment listing; the failure is reported in the returned status instead.
+func (s *agentManagerService) getQueueConsumerStatus(ctx context.Context, queue models.QueueConfig) *models.QueueConsumerStatus {
+	status := &models.QueueConsumerStatus{
+		Broker:        queue.Broker,
+		Topic:         queue.Topic,
+		ConsumerGroup: queue.ConsumerGroup,
+	}
+	lag, err := s.QueueClient.GetConsumerLag(ctx, queue)
 	if err != nil {
-		s.logger.Error("Failed to fetch configurations", "agentName", agentName, "environment", environment, "orgName", orgName, "projectName", projectName, "error", err)
-		return nil, fmt.Errorf("failed to get configurations for agent %s: %w", agentName, err)
+		s.logger.Warn("Failed to get consumer lag", "broker", queue.Broker, "topic", queue.Topic, "consumerGroup", queue.ConsumerGroup, "error", err)
+		status.Error = err.Error()
+		return status
+	}
+	status.ConsumerLag = &lag
+	return status
+}
+
+// mcpEndpoint holds the MCP transport settings stored with an endpoint of an mcp-server agent
+type mcpEndpoint struct {
+	transport utils.MCPTransport
+	path      string
+}
+
+// mcpEndpointsFromWorkloadSpec returns the MCP endpoints of a workload spec keyed by endpoint name
+func mcpEndpointsFromWorkloadSpec(workloadSpec map[string]interface{}) map[string]mcpEndpoint {
+	mcpEndpoints := make(map[string]mcpEndpoint)
+	endpointsList, ok := workloadSpec["endpoints"].([]interface{})
+	if !ok {
+		return mcpEndpoints
+	}
+	for _, endpointItem := range endpointsList {
+		endpoint, ok := endpointItem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := endpoint["name"].(string)
+		transport, isMCP := endpoint["mcpTransport"].(string)
+		if name == "" || !isMCP {
+			continue
+		}
+		path, _ := endpoint["mcpPath"].(string)
+		mcpEndpoints[name] = mcpEndpoint{transport: utils.MCPTransport(transport), path: path}
 	}
+	return mcpEndpoints
+}
 
-	s.logger.Info("Fetched configurations successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "configCount", len(configurations))
-	return configurations, nil
+// grpcReflectionEndpointsFromWorkloadSpec returns the names of the gRPC endpoints in the workload spec that are
+// described by server reflection
+func grpcReflectionEndpointsFromWorkloadSpec(workloadSpec map[string]interface{}) []string {
+	var names []string
+	endpointsList, ok := workloadSpec["endpoints"].([]interface{})
+	if !ok {
+		return names
+	}
+	for _, endpointItem := range endpointsList {
+		endpoint, ok := endpointItem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := endpoint["name"].(string)
+		if reflection, _ := endpoint["grpcReflection"].(bool); name != "" && reflection {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// introspectMCPEndpoint lists what the MCP server behind an endpoint offers. A server that cannot be reached does not
+// fail the endpoint listing; the failure is reported in the returned details instead.
+func (s *agentManagerService) introspectMCPEndpoint(ctx context.Context, endpointURL string, endpoint mcpEndpoint) *models.MCPServerDetails {
+	mcpURL := strings.TrimSuffix(endpointURL, "/") + endpoint.path
+	details, err := s.MCPClient.Introspect(ctx, mcpsvc.IntrospectParams{URL: mcpURL, Transport: endpoint.transport})
+	if err != nil {
+		s.logger.Warn("Failed to introspect MCP server", "url", mcpURL, "transport", endpoint.transport, "error", err)
+		return &models.MCPServerDetails{
+			URL:                mcpURL,
+			Transport:          string(endpoint.transport),
+			Tools:              []models.MCPTool{},
+			Resources:          []models.MCPResource{},
+			Prompts:            []models.MCPPrompt{},
+			IntrospectionError: err.Error(),
+		}
+	}
+	return details
 }
 
 func (s *agentManagerService) convertToAgentListItem(agent *clients.AgentComponent) *models.AgentResponse {
@@ -1017,6 +1646,9 @@ func buildWorkloadSpec(req *spec.CreateAgentRequest) (map[string]interface{}, er
 	workloadSpec := make(map[string]interface{})
 
 	workloadSpec["envVars"] = req.RuntimeConfigs.Env
+	if req.RuntimeConfigs.Probes != nil {
+		workloadSpec["probes"] = req.RuntimeConfigs.Probes
+	}
 
 	if req.AgentType.Type == string(utils.AgentTypeAPI) &&
 		utils.StrPointerAsStr(req.AgentType.SubType, "") == string(utils.AgentSubTypeChatAPI) {
@@ -1041,14 +1673,109 @@ func buildWorkloadSpec(req *spec.CreateAgentRequest) (map[string]interface{}, er
 	if req.AgentType.Type == string(utils.AgentTypeAPI) && utils.StrPointerAsStr(req.AgentType.SubType, "") == string(utils.AgentSubTypeCustomAPI) {
 		endpoints := []map[string]interface{}{
 			{
-				"name":       fmt.Sprintf("%s-endpoint", req.Name),
-				"port":       req.InputInterface.Port,
-				"type":       string(req.InputInterface.Type),
-				"schemaPath": req.InputInterface.Schema.Path,
+				"name": fmt.Sprintf("%s-endpoint", req.Name),
+				"port": req.InputInterface.Port,
+				"type": string(req.InputInterface.Type),
+			},
+		}
+		// Inline OpenAPI content is used as is instead of being read from the repository at build time
+		if content, ok := req.InputInterface.Schema.GetContentOk(); ok {
+			endpoints[0]["schemaContent"] = *content
+		} else {
+			endpoints[0]["schemaPath"] = req.InputInterface.Schema.Path
+		}
+		// gRPC servers that serve the reflection service describe themselves, so there is no proto file to attach
+		if req.InputInterface.Schema.GetReflection() {
+			endpoints[0]["grpcReflection"] = true
+		}
+		workloadSpec["endpoints"] = endpoints
+	}
+
+	// Handle MCP servers - the endpoint carries the MCP transport settings instead of an OpenAPI schema
+	if req.AgentType.Type == string(utils.AgentTypeMCPServer) {
+		port := req.InputInterface.Port
+		if port == 0 {
+			port = config.GetConfig().MCPServer.DefaultHTTPPort
+		}
+		mcpTransport, mcpPath := clients.GetMCPTransportConfig(req)
+		endpoints := []map[string]interface{}{
+			{
+				"name":         fmt.Sprintf("%s-endpoint", req.Name),
+				"port":         port,
+				"type":         string(utils.InputInterfaceTypeHTTP),
+				"mcpTransport": string(mcpTransport),
+				"mcpPath":      mcpPath,
 			},
 		}
 		workloadSpec["endpoints"] = endpoints
 	}
 
+	// Handle event-driven agents - they have no endpoints, the queue they consume from is passed to the workload instead
+	if queue := clients.GetQueueConfig(req); queue != nil && req.AgentType.Type == string(utils.AgentTypeEventDriven) {
+		workloadSpec["queue"] = map[string]interface{}{
+			"broker":        queue.Broker,
+			"url":           queue.URL,
+			"topic":         queue.Topic,
+			"consumerGroup": queue.ConsumerGroup,
+			"concurrency":   queue.Concurrency,
+		}
+	}
+
+	// Handle A2A agents - the agent card served at the well-known path describes the endpoint, so no schema is attached
+	if utils.StrPointerAsStr(req.AgentType.SubType, "") == string(utils.AgentSubTypeA2A) {
+		endpoints := []map[string]interface{}{
+			{
+				"name": fmt.Sprintf("%s-endpoint", req.Name),
+				"port": req.InputInterface.Port,
+				"type": string(utils.InputInterfaceTypeHTTP),
+			},
+		}
+		workloadSpec["endpoints"] = endpoints
+	}
+
+	// Handle additional endpoints of api agents - they are listed after the primary endpoint
+	if len(req.Endpoints) > 0 {
+		endpoints, _ := workloadSpec["endpoints"].([]map[string]interface{})
+		workloadSpec["endpoints"] = append(endpoints, buildAdditionalEndpointsSpec(req.Endpoints)...)
+	}
+
 	return workloadSpec, nil
 }
+
+// toAgentCardModel converts the agent card of a create agent request to its stored form
+func toAgentCardModel(card *spec.AgentCard) (*models.AgentCard, error) {
+	if card == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal agent card: %w", err)
+	}
+	agentCard := &models.AgentCard{}
+	if err := json.Unmarshal(data, agentCard); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal agent card: %w", err)
+	}
+	return agentCard, nil
+}
+
+// buildAdditionalEndpointsSpec constructs the workload spec entries of the endpoints an api agent exposes besides its
+// primary endpoint. Their schemas are given inline or read from the repository at build time, each from its own file.
+func buildAdditionalEndpointsSpec(endpoints []spec.AgentEndpoint) []map[string]interface{} {
+	entries := make([]map[string]interface{}, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		entry := map[string]interface{}{
+			"name": endpoint.Name,
+			"port": endpoint.Port,
+			"type": endpoint.Type,
+		}
+		if endpoint.Schema.GetReflection() {
+			entry["grpcReflection"] = true
+		} else if content, ok := endpoint.Schema.GetContentOk(); ok {
+			entry["schemaContent"] = *content
+		} else if endpoint.Schema != nil && endpoint.Schema.Path != "" {
+			entry["schemaFile"] = endpoint.Schema.Path
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
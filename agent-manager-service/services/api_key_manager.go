@@ -0,0 +1,321 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// AgentRef identifies an agent by its organization, project and name
+type AgentRef struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+}
+
+type APIKeyManagerService interface {
+	CreateAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, req models.CreateAPIKeyRequest) (*models.APIKeySecretResponse, error)
+	ListAPIKeys(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) (*models.APIKeyListResponse, error)
+	RotateAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, keyId uuid.UUID) (*models.APIKeySecretResponse, error)
+	RevokeAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, keyId uuid.UUID) error
+	VerifyAPIKey(ctx context.Context, key string) (*models.VerifyAPIKeyResponse, error)
+}
+
+type apiKeyManagerService struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ProjectRepository      repositories.ProjectRepository
+	AgentRepository        repositories.AgentRepository
+	APIKeyRepository       repositories.APIKeyRepository
+	OpenChoreoSvcClient    clients.OpenChoreoSvcClient
+	logger                 *slog.Logger
+}
+
+func NewAPIKeyManagerService(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	apiKeyRepo repositories.APIKeyRepository,
+	openChoreoSvcClient clients.OpenChoreoSvcClient,
+	logger *slog.Logger,
+) APIKeyManagerService {
+	return &apiKeyManagerService{
+		OrganizationRepository: orgRepo,
+		ProjectRepository:      projRepo,
+		AgentRepository:        agentRepo,
+		APIKeyRepository:       apiKeyRepo,
+		OpenChoreoSvcClient:    openChoreoSvcClient,
+		logger:                 logger,
+	}
+}
+
+// CreateAPIKey issues a new API key for an external agent in an environment. The plaintext key is
+// only returned in the response, the database holds its SHA-256 hash.
+func (s *apiKeyManagerService) CreateAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, req models.CreateAPIKeyRequest) (*models.APIKeySecretResponse, error) {
+	s.logger.Info("Creating API key", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", req.Environment)
+	dbAgent, err := s.getExternalAgent(ctx, userIdpId, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the OpenChoreo UIDs the key grants access to, so that verification needs no OpenChoreo calls
+	ocProject, err := s.OpenChoreoSvcClient.GetProject(ctx, agent.ProjectName, agent.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to fetch project from OpenChoreo", "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		return nil, fmt.Errorf("failed to get project %s: %w", agent.ProjectName, err)
+	}
+	agentComponent, err := s.OpenChoreoSvcClient.GetAgentComponent(ctx, agent.OrgName, agent.ProjectName, agent.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from OpenChoreo", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		return nil, fmt.Errorf("failed to fetch agent from oc: %w", err)
+	}
+	environment, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, agent.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to validate environment", "environment", req.Environment, "orgName", agent.OrgName, "error", err)
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			return nil, utils.ErrEnvironmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get environment %s: %w", req.Environment, err)
+	}
+
+	key, keyPrefix, keyHash, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = req.Environment
+	}
+	apiKey := &models.AgentAPIKey{
+		ID:             uuid.New(),
+		AgentID:        dbAgent.ID,
+		Name:           name,
+		Environment:    req.Environment,
+		KeyPrefix:      keyPrefix,
+		KeyHash:        keyHash,
+		ProjectUID:     ocProject.UUID,
+		ComponentUID:   agentComponent.UUID,
+		EnvironmentUID: environment.UUID,
+		CreatedBy:      userIdpId,
+	}
+	if err := s.APIKeyRepository.CreateAPIKey(ctx, apiKey); err != nil {
+		s.logger.Error("Failed to store API key", "agentName", agent.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to store api key: %w", err)
+	}
+
+	// Read back the key to pick up database defaults
+	created, err := s.APIKeyRepository.GetAPIKey(ctx, dbAgent.ID, apiKey.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch api key: %w", err)
+	}
+
+	s.logger.Info("Created API key", "keyId", apiKey.ID, "agentName", agent.AgentName, "environment", req.Environment)
+	return &models.APIKeySecretResponse{
+		APIKeyResponse: toAPIKeyResponse(created),
+		Key:            key,
+	}, nil
+}
+
+// ListAPIKeys lists the active API keys of an external agent, optionally limited to an environment
+func (s *apiKeyManagerService) ListAPIKeys(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, environment string) (*models.APIKeyListResponse, error) {
+	s.logger.Info("Listing API keys", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "environment", environment)
+	dbAgent, err := s.getExternalAgent(ctx, userIdpId, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.APIKeyRepository.ListAPIKeys(ctx, dbAgent.ID, environment)
+	if err != nil {
+		s.logger.Error("Failed to list API keys", "agentName", agent.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	response := &models.APIKeyListResponse{
+		APIKeys: make([]models.APIKeyResponse, 0, len(apiKeys)),
+		Total:   len(apiKeys),
+	}
+	for i := range apiKeys {
+		response.APIKeys = append(response.APIKeys, toAPIKeyResponse(&apiKeys[i]))
+	}
+	return response, nil
+}
+
+// RotateAPIKey replaces the secret of an API key. The previous key stops working immediately.
+func (s *apiKeyManagerService) RotateAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, keyId uuid.UUID) (*models.APIKeySecretResponse, error) {
+	s.logger.Info("Rotating API key", "keyId", keyId, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName)
+	apiKey, err := s.getAPIKey(ctx, userIdpId, agent, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, keyPrefix, keyHash, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.APIKeyRepository.RotateAPIKey(ctx, apiKey.ID, keyPrefix, keyHash); err != nil {
+		s.logger.Error("Failed to rotate API key", "keyId", keyId, "error", err)
+		return nil, fmt.Errorf("failed to rotate api key: %w", err)
+	}
+
+	rotated, err := s.APIKeyRepository.GetAPIKey(ctx, apiKey.AgentID, apiKey.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch api key: %w", err)
+	}
+
+	s.logger.Info("Rotated API key", "keyId", keyId, "agentName", agent.AgentName)
+	return &models.APIKeySecretResponse{
+		APIKeyResponse: toAPIKeyResponse(rotated),
+		Key:            key,
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *apiKeyManagerService) RevokeAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, keyId uuid.UUID) error {
+	s.logger.Info("Revoking API key", "keyId", keyId, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName)
+	apiKey, err := s.getAPIKey(ctx, userIdpId, agent, keyId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.APIKeyRepository.RevokeAPIKey(ctx, apiKey.ID); err != nil {
+		s.logger.Error("Failed to revoke API key", "keyId", keyId, "error", err)
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	s.logger.Info("Revoked API key", "keyId", keyId, "agentName", agent.AgentName)
+	return nil
+}
+
+// VerifyAPIKey resolves a plaintext API key to the agent deployment it was issued for and records its use
+func (s *apiKeyManagerService) VerifyAPIKey(ctx context.Context, key string) (*models.VerifyAPIKeyResponse, error) {
+	if key == "" {
+		return nil, utils.ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.APIKeyRepository.GetActiveAPIKeyByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrInvalidAPIKey
+		}
+		s.logger.Error("Failed to look up API key", "error", err)
+		return nil, fmt.Errorf("failed to look up api key: %w", err)
+	}
+
+	// Failing to record the last use must not fail verification
+	if err := s.APIKeyRepository.UpdateAPIKeyLastUsed(ctx, apiKey.ID, utils.APIKeyLastUsedUpdatePeriod); err != nil {
+		s.logger.Warn("Failed to update API key last used time", "keyId", apiKey.ID, "error", err)
+	}
+
+	return &models.VerifyAPIKeyResponse{
+		KeyID:          apiKey.ID.String(),
+		Environment:    apiKey.Environment,
+		ProjectUID:     apiKey.ProjectUID,
+		ComponentUID:   apiKey.ComponentUID,
+		EnvironmentUID: apiKey.EnvironmentUID,
+	}, nil
+}
+
+// getExternalAgent validates the organization and project and returns the agent, which must be an external agent
+func (s *apiKeyManagerService) getExternalAgent(ctx context.Context, userIdpId uuid.UUID, agent AgentRef) (*models.Agent, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, agent.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", agent.OrgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", agent.OrgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, agent.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", agent.ProjectName, err)
+	}
+	dbAgent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agent.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if dbAgent.ProvisioningType != string(utils.ExternalAgent) {
+		return nil, utils.ErrAgentNotExternal
+	}
+	return dbAgent, nil
+}
+
+// getAPIKey returns an active API key of an external agent
+func (s *apiKeyManagerService) getAPIKey(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, keyId uuid.UUID) (*models.AgentAPIKey, error) {
+	dbAgent, err := s.getExternalAgent(ctx, userIdpId, agent)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := s.APIKeyRepository.GetAPIKey(ctx, dbAgent.ID, keyId)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch api key: %w", err)
+	}
+	return apiKey, nil
+}
+
+// generateAPIKey returns a new random API key, the prefix shown to identify it and its hash
+func generateAPIKey() (string, string, string, error) {
+	secret := make([]byte, utils.APIKeyRandomBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	key := utils.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, key[:utils.APIKeyDisplayPrefixLength], hashAPIKey(key), nil
+}
+
+// hashAPIKey returns the hex encoded SHA-256 hash of an API key. Keys are random, so a fast hash is sufficient.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAPIKeyResponse(apiKey *models.AgentAPIKey) models.APIKeyResponse {
+	return models.APIKeyResponse{
+		ID:          apiKey.ID.String(),
+		Name:        apiKey.Name,
+		Environment: apiKey.Environment,
+		KeyPrefix:   apiKey.KeyPrefix,
+		CreatedAt:   apiKey.CreatedAt,
+		RotatedAt:   apiKey.RotatedAt,
+		LastUsedAt:  apiKey.LastUsedAt,
+	}
+}
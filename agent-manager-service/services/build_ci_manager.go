@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,11 +28,28 @@ import (
 
 	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/openapi"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Prefix of the placeholder the build workflow replaces with the content of the schema file following it. Used for
+// the schemas of additional endpoints, which each come from their own file.
+const schemaFilePlaceholderPrefix = "SCHEMA_FILE:"
+
+// Environment variables through which an event-driven agent learns the queue it consumes from
+const (
+	queueBrokerEnvVar        = "AMP_QUEUE_BROKER"
+	queueURLEnvVar           = "AMP_QUEUE_URL"
+	queueTopicEnvVar         = "AMP_QUEUE_TOPIC"
+	queueConsumerGroupEnvVar = "AMP_QUEUE_CONSUMER_GROUP"
+	queueConcurrencyEnvVar   = "AMP_QUEUE_CONCURRENCY"
 )
 
 type BuildCIManagerService interface {
 	HandleBuildCallback(ctx context.Context, orgName string, projectName string, agentName string) (string, error)
+	ValidateBuildSchema(ctx context.Context, orgName string, projectName string, agentName string, schemaPath string, content []byte) error
 }
 
 type buildCIManagerService struct {
@@ -59,14 +77,63 @@ func NewBuildCIManager(
 }
 
 func (b *buildCIManagerService) HandleBuildCallback(ctx context.Context, orgName string, projectName string, agentName string) (string, error) {
+	org, agent, err := b.getAgent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		return "", err
+	}
+	if agent.AgentDetails == nil || agent.AgentDetails.WorkloadSpec == nil {
+		return "", fmt.Errorf("agent workload specification is missing for agent: %s", agentName)
+	}
+	// Build Workload CR template with placeholders
+	workloadCR, err := buildWorkloadCRTemplate(agent.AgentDetails.WorkloadSpec, org.OpenChoreoOrgName, projectName, agentName)
+	if err != nil {
+		return "", err
+	}
+
+	b.logger.Info("Successfully generated workload CR template",
+		"agentName", agentName,
+		"project", projectName,
+		"organization", org.OrgName)
+
+	return workloadCR, nil
+}
+
+// ValidateBuildSchema validates a schema file read from the repository of an agent during its build, before it is
+// substituted into the Workload CR. Only the OpenAPI schemas of HTTP endpoints are validated; proto files and the
+// message descriptions of WebSocket endpoints are passed through as is.
+func (b *buildCIManagerService) ValidateBuildSchema(ctx context.Context, orgName string, projectName string, agentName string, schemaPath string, content []byte) error {
+	_, agent, err := b.getAgent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		return err
+	}
+	if agent.AgentDetails == nil || agent.AgentDetails.WorkloadSpec == nil {
+		return fmt.Errorf("agent workload specification is missing for agent: %s", agentName)
+	}
+	endpointType, found := findSchemaFileEndpointType(agent.AgentDetails.WorkloadSpec, schemaPath)
+	if !found {
+		return fmt.Errorf("%w: no endpoint uses schema file %s", utils.ErrAgentEndpointNotFound, schemaPath)
+	}
+	if endpointType != string(utils.InputInterfaceTypeHTTP) {
+		return nil
+	}
+	if err := openapi.Validate(content); err != nil {
+		b.logger.Info("Schema file of agent is not a valid OpenAPI document", "agentName", agentName, "project", projectName,
+			"organization", orgName, "schemaPath", schemaPath, "error", err)
+		return err
+	}
+	return nil
+}
+
+// getAgent fetches an agent along with the organization it belongs to
+func (b *buildCIManagerService) getAgent(ctx context.Context, orgName string, projectName string, agentName string) (*models.Organization, *models.Agent, error) {
 	// Get organization
 	org, err := b.OrganizationRepo.GetOrganizationByOcName(ctx, orgName)
 	if err != nil {
 		if db.IsRecordNotFoundError(err) {
 			b.logger.Error("Organization not found", "organization", orgName)
-			return "", fmt.Errorf("organization not found: %s", orgName)
+			return nil, nil, fmt.Errorf("%w: %s", utils.ErrOrganizationNotFound, orgName)
 		}
-		return "", fmt.Errorf("failed to find organization %s: %w", orgName, err)
+		return nil, nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
 	}
 
 	// Get project
@@ -74,9 +141,9 @@ func (b *buildCIManagerService) HandleBuildCallback(ctx context.Context, orgName
 	if err != nil {
 		if db.IsRecordNotFoundError(err) {
 			b.logger.Error("Project not found", "project", projectName, "organization", orgName)
-			return "", fmt.Errorf("project not found: %s", projectName)
+			return nil, nil, fmt.Errorf("%w: %s", utils.ErrProjectNotFound, projectName)
 		}
-		return "", fmt.Errorf("failed to find project %s: %w", projectName, err)
+		return nil, nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
 	}
 
 	// Get agent from database
@@ -84,36 +151,44 @@ func (b *buildCIManagerService) HandleBuildCallback(ctx context.Context, orgName
 	if err != nil {
 		if db.IsRecordNotFoundError(err) {
 			b.logger.Error("Agent not found", "agentName", agentName, "project", projectName, "organization", orgName)
-			return "", fmt.Errorf("agent not found: %s", agentName)
+			return nil, nil, fmt.Errorf("%w: %s", utils.ErrAgentNotFound, agentName)
 		}
-		return "", fmt.Errorf("failed to fetch agent: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch agent: %w", err)
 	}
-	if agent.AgentDetails == nil || agent.AgentDetails.WorkloadSpec == nil {
-		return "", fmt.Errorf("agent workload specification is missing for agent: %s", agentName)
+	return org, agent, nil
+}
+
+// findSchemaFileEndpointType returns the type of the endpoint whose schema is read from the given file at build time
+func findSchemaFileEndpointType(workloadSpec map[string]interface{}, schemaPath string) (string, bool) {
+	endpointsList, ok := workloadSpec["endpoints"].([]interface{})
+	if !ok || schemaPath == "" {
+		return "", false
 	}
-	// Build Workload CR template with placeholders
-	workloadCR, err := buildWorkloadCRTemplate(agent.AgentDetails.WorkloadSpec, org.OpenChoreoOrgName, projectName, agentName)
-	if err != nil {
-		return "", err
+	for _, endpointItem := range endpointsList {
+		endpoint, ok := endpointItem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if endpoint["schemaPath"] != schemaPath && endpoint["schemaFile"] != schemaPath {
+			continue
+		}
+		endpointType, _ := endpoint["type"].(string)
+		return endpointType, true
 	}
-
-	b.logger.Info("Successfully generated workload CR template",
-		"agentName", agentName,
-		"project", projectName,
-		"organization", org.OrgName)
-
-	return workloadCR, nil
+	return "", false
 }
 
 // buildWorkloadCRTemplate constructs a Workload CR object with placeholders and converts to YAML string
 // IMAGE_TAG - placeholder for the actual container image
 // SCHEMA_CONTENT - placeholder for the OpenAPI schema content (if applicable)
+// SCHEMA_FILE:<path> - placeholder for the content of the schema file of an additional endpoint
 func buildWorkloadCRTemplate(workloadSpec map[string]interface{}, orgName, projectName, componentName string) (string, error) {
 	// Build environment variables
 	envVars, err := buildEnvVars(workloadSpec)
 	if err != nil {
 		return "", fmt.Errorf("failed to build environment variables: %w", err)
 	}
+	envVars = append(envVars, buildQueueEnvVars(workloadSpec)...)
 
 	// Build endpoints
 	endpoints, err := buildEndpoints(workloadSpec)
@@ -137,6 +212,8 @@ func buildWorkloadCRTemplate(workloadSpec map[string]interface{}, orgName, proje
 				ComponentName: componentName,
 			},
 			WorkloadTemplateSpec: v1alpha1.WorkloadTemplateSpec{
+				// The probes in the workload spec are not set on the container, which has no probe fields; they are
+				// rendered from the probes parameter the component was created with
 				Containers: map[string]v1alpha1.Container{
 					"main": {
 						Image: "IMAGE_TAG", // Placeholder for actual image
@@ -188,6 +265,22 @@ func buildEnvVars(workloadSpec map[string]interface{}) ([]v1alpha1.EnvVar, error
 	return envVars, nil
 }
 
+// buildQueueEnvVars exposes the queue settings of an event-driven agent to its container. The Workload CR has no
+// queue endpoint type, so the consumer is configured through the environment instead.
+func buildQueueEnvVars(workloadSpec map[string]interface{}) []v1alpha1.EnvVar {
+	queue := queueConfigFromWorkloadSpec(workloadSpec)
+	if queue == nil {
+		return nil
+	}
+	return []v1alpha1.EnvVar{
+		{Key: queueBrokerEnvVar, Value: queue.Broker},
+		{Key: queueURLEnvVar, Value: queue.URL},
+		{Key: queueTopicEnvVar, Value: queue.Topic},
+		{Key: queueConsumerGroupEnvVar, Value: queue.ConsumerGroup},
+		{Key: queueConcurrencyEnvVar, Value: strconv.Itoa(int(queue.Concurrency))},
+	}
+}
+
 // buildEndpoints converts endpoints from workload spec to v1alpha1.WorkloadEndpoint map
 func buildEndpoints(workloadSpec map[string]interface{}) (map[string]v1alpha1.WorkloadEndpoint, error) {
 	endpoints := make(map[string]v1alpha1.WorkloadEndpoint)
@@ -218,25 +311,41 @@ func buildEndpoints(workloadSpec map[string]interface{}) (map[string]v1alpha1.Wo
 		}
 
 		workloadEndpoint := v1alpha1.WorkloadEndpoint{
-			Type: v1alpha1.EndpointType(endpointType),
+			Type: clients.GetWorkloadEndpointType(endpointType),
 			Port: port,
 		}
+		schemaType := getWorkloadSchemaType(workloadEndpoint.Type)
+
+		// MCP server endpoints are described by the tools, resources and prompts the server lists, not by an OpenAPI schema
+		if mcpTransport, isMCP := endpoint["mcpTransport"].(string); isMCP {
+			if mcpTransport != string(utils.MCPTransportStreamableHTTP) && mcpTransport != string(utils.MCPTransportSSE) {
+				return nil, fmt.Errorf("endpoint %s has unsupported MCP transport %q", endpointName, mcpTransport)
+			}
+			endpoints[endpointName] = workloadEndpoint
+			continue
+		}
 
 		// Check if schema content or schema path is provided
 		schemaContent, hasSchemaContent := endpoint["schemaContent"].(string)
 		schemaPath, hasSchemaPath := endpoint["schemaPath"].(string)
+		schemaFile, hasSchemaFile := endpoint["schemaFile"].(string)
 
 		// If schema content exists or schema path exists, use placeholder
 		if hasSchemaContent && schemaContent != "" {
 			workloadEndpoint.Schema = &v1alpha1.Schema{
-				Type:    string(v1alpha1.EndpointTypeREST),
+				Type:    schemaType,
 				Content: schemaContent,
 			}
 		} else if hasSchemaPath && schemaPath != "" {
 			workloadEndpoint.Schema = &v1alpha1.Schema{
-				Type:    string(v1alpha1.EndpointTypeREST),
+				Type:    schemaType,
 				Content: "SCHEMA_CONTENT", // Placeholder for actual schema
 			}
+		} else if hasSchemaFile && schemaFile != "" {
+			workloadEndpoint.Schema = &v1alpha1.Schema{
+				Type:    schemaType,
+				Content: schemaFilePlaceholderPrefix + schemaFile,
+			}
 		}
 
 		endpoints[endpointName] = workloadEndpoint
@@ -244,3 +353,12 @@ func buildEndpoints(workloadSpec map[string]interface{}) (map[string]v1alpha1.Wo
 
 	return endpoints, nil
 }
+
+// getWorkloadSchemaType returns the type of the schema describing a workload endpoint. HTTP endpoints are described
+// by OpenAPI (REST) schemas, gRPC endpoints by proto files.
+func getWorkloadSchemaType(endpointType v1alpha1.EndpointType) string {
+	if endpointType == v1alpha1.EndpointTypeHTTP {
+		return string(v1alpha1.EndpointTypeREST)
+	}
+	return string(endpointType)
+}
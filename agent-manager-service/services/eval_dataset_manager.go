@@ -0,0 +1,480 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// ProjectRef identifies a project by its organization and name
+type ProjectRef struct {
+	OrgName     string
+	ProjectName string
+}
+
+type EvalDatasetManagerService interface {
+	CreateDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, req models.CreateDatasetRequest) (*models.DatasetResponse, error)
+	ListDatasets(ctx context.Context, userIdpId uuid.UUID, project ProjectRef) (*models.DatasetListResponse, error)
+	GetDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, name string, version int) (*models.DatasetResponse, error)
+	UpdateDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, name string, req models.UpdateDatasetRequest) (*models.DatasetResponse, error)
+	DeleteDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, name string) error
+}
+
+type evalDatasetManagerService struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ProjectRepository      repositories.ProjectRepository
+	AgentRepository        repositories.AgentRepository
+	EvalDatasetRepository  repositories.EvalDatasetRepository
+	ObservabilityService   ObservabilityManagerService
+	logger                 *slog.Logger
+}
+
+func NewEvalDatasetManagerService(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	evalDatasetRepo repositories.EvalDatasetRepository,
+	observabilityService ObservabilityManagerService,
+	logger *slog.Logger,
+) EvalDatasetManagerService {
+	return &evalDatasetManagerService{
+		OrganizationRepository: orgRepo,
+		ProjectRepository:      projRepo,
+		AgentRepository:        agentRepo,
+		EvalDatasetRepository:  evalDatasetRepo,
+		ObservabilityService:   observabilityService,
+		logger:                 logger,
+	}
+}
+
+// CreateDataset creates the first version of a dataset from the root span input and output of the selected traces
+func (s *evalDatasetManagerService) CreateDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, req models.CreateDatasetRequest) (*models.DatasetResponse, error) {
+	s.logger.Info("Creating dataset", "datasetName", req.Name, "projectName", project.ProjectName, "orgName", project.OrgName, "agentName", req.Traces.AgentName)
+	org, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.EvalDatasetRepository.GetLatestDataset(ctx, dbProject.ID, req.Name); err == nil {
+		return nil, utils.ErrEvalDatasetAlreadyExists
+	} else if !db.IsRecordNotFoundError(err) {
+		return nil, fmt.Errorf("failed to check dataset %s: %w", req.Name, err)
+	}
+
+	items, skipped, err := s.selectTraceItems(ctx, userIdpId, project, org.ID, dbProject.ID, req.Traces)
+	if err != nil {
+		return nil, err
+	}
+
+	dataset := &models.EvalDataset{
+		ID:          uuid.New(),
+		ProjectID:   dbProject.ID,
+		Name:        req.Name,
+		Version:     1,
+		Description: req.Description,
+		CreatedBy:   userIdpId,
+	}
+	response, err := s.saveDatasetVersion(ctx, dataset, items)
+	if err != nil {
+		return nil, err
+	}
+	response.SkippedTraceIDs = skipped
+	s.logger.Info("Created dataset successfully", "datasetName", req.Name, "itemCount", response.ItemCount, "skippedCount", len(skipped))
+	return response, nil
+}
+
+// ListDatasets lists the latest version of every dataset of a project
+func (s *evalDatasetManagerService) ListDatasets(ctx context.Context, userIdpId uuid.UUID, project ProjectRef) (*models.DatasetListResponse, error) {
+	s.logger.Info("Listing datasets", "projectName", project.ProjectName, "orgName", project.OrgName)
+	_, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+
+	datasets, err := s.EvalDatasetRepository.ListLatestDatasets(ctx, dbProject.ID)
+	if err != nil {
+		s.logger.Error("Failed to list datasets", "projectName", project.ProjectName, "error", err)
+		return nil, fmt.Errorf("failed to list datasets: %w", err)
+	}
+	datasetIds := make([]uuid.UUID, len(datasets))
+	for i, dataset := range datasets {
+		datasetIds[i] = dataset.ID
+	}
+	itemCounts, err := s.EvalDatasetRepository.CountDatasetItems(ctx, datasetIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dataset items: %w", err)
+	}
+
+	response := &models.DatasetListResponse{
+		Datasets: make([]models.DatasetSummary, len(datasets)),
+		Total:    len(datasets),
+	}
+	for i, dataset := range datasets {
+		response.Datasets[i] = models.DatasetSummary{
+			Name:          dataset.Name,
+			LatestVersion: dataset.Version,
+			Description:   dataset.Description,
+			ItemCount:     itemCounts[dataset.ID],
+			CreatedBy:     dataset.CreatedBy.String(),
+			CreatedAt:     dataset.CreatedAt,
+		}
+	}
+	return response, nil
+}
+
+// GetDataset returns a version of a dataset with its items. Version 0 returns the latest version.
+func (s *evalDatasetManagerService) GetDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, name string, version int) (*models.DatasetResponse, error) {
+	s.logger.Info("Getting dataset", "datasetName", name, "version", version, "projectName", project.ProjectName, "orgName", project.OrgName)
+	_, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+	dataset, err := s.getDataset(ctx, dbProject.ID, name, version)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.EvalDatasetRepository.ListDatasetItems(ctx, dataset.ID)
+	if err != nil {
+		s.logger.Error("Failed to list dataset items", "datasetName", name, "version", dataset.Version, "error", err)
+		return nil, fmt.Errorf("failed to list dataset items: %w", err)
+	}
+	return toDatasetResponse(dataset, items), nil
+}
+
+// UpdateDataset creates a new version of a dataset from its latest version with the requested changes applied
+func (s *evalDatasetManagerService) UpdateDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, name string, req models.UpdateDatasetRequest) (*models.DatasetResponse, error) {
+	s.logger.Info("Updating dataset", "datasetName", name, "projectName", project.ProjectName, "orgName", project.OrgName)
+	org, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.getDataset(ctx, dbProject.ID, name, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []models.EvalDatasetItem
+	if req.Items != nil {
+		for _, item := range *req.Items {
+			items = append(items, models.EvalDatasetItem{
+				TraceID:   item.TraceID,
+				AgentName: item.AgentName,
+				Input:     item.Input,
+				Output:    item.Output,
+			})
+		}
+	} else {
+		items, err = s.EvalDatasetRepository.ListDatasetItems(ctx, latest.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list dataset items: %w", err)
+		}
+	}
+	var skipped []string
+	if req.AddTraces != nil {
+		added, addSkipped, err := s.selectTraceItems(ctx, userIdpId, project, org.ID, dbProject.ID, *req.AddTraces)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, added...)
+		skipped = addSkipped
+	}
+
+	description := latest.Description
+	if req.Description != nil {
+		description = *req.Description
+	}
+	dataset := &models.EvalDataset{
+		ID:          uuid.New(),
+		ProjectID:   dbProject.ID,
+		Name:        name,
+		Version:     latest.Version + 1,
+		Description: description,
+		CreatedBy:   userIdpId,
+	}
+	response, err := s.saveDatasetVersion(ctx, dataset, items)
+	if err != nil {
+		return nil, err
+	}
+	response.SkippedTraceIDs = skipped
+	s.logger.Info("Updated dataset successfully", "datasetName", name, "version", dataset.Version, "itemCount", response.ItemCount)
+	return response, nil
+}
+
+// DeleteDataset deletes every version of a dataset
+func (s *evalDatasetManagerService) DeleteDataset(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, name string) error {
+	s.logger.Info("Deleting dataset", "datasetName", name, "projectName", project.ProjectName, "orgName", project.OrgName)
+	_, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return err
+	}
+	if _, err := s.getDataset(ctx, dbProject.ID, name, 0); err != nil {
+		return err
+	}
+	if err := s.EvalDatasetRepository.DeleteDataset(ctx, dbProject.ID, name); err != nil {
+		s.logger.Error("Failed to delete dataset", "datasetName", name, "error", err)
+		return fmt.Errorf("failed to delete dataset: %w", err)
+	}
+	return nil
+}
+
+// saveDatasetVersion numbers the items in order and stores them with the dataset version
+func (s *evalDatasetManagerService) saveDatasetVersion(ctx context.Context, dataset *models.EvalDataset, items []models.EvalDatasetItem) (*models.DatasetResponse, error) {
+	if len(items) == 0 {
+		return nil, utils.ErrEvalDatasetEmpty
+	}
+	if len(items) > utils.EvalDatasetMaxItems {
+		return nil, utils.ErrEvalDatasetTooLarge
+	}
+	stored := make([]models.EvalDatasetItem, len(items))
+	for i, item := range items {
+		stored[i] = models.EvalDatasetItem{
+			ID:        uuid.New(),
+			DatasetID: dataset.ID,
+			Position:  i,
+			TraceID:   item.TraceID,
+			AgentName: item.AgentName,
+			Input:     item.Input,
+			Output:    item.Output,
+		}
+	}
+	if err := s.EvalDatasetRepository.CreateDatasetVersion(ctx, dataset, stored); err != nil {
+		s.logger.Error("Failed to store dataset", "datasetName", dataset.Name, "version", dataset.Version, "error", err)
+		return nil, fmt.Errorf("failed to store dataset: %w", err)
+	}
+	return toDatasetResponse(dataset, stored), nil
+}
+
+// selectTraceItems builds dataset items from the root span input and output of the selected traces of an
+// agent. Traces whose root span has no GenAI input are skipped and their IDs are returned.
+func (s *evalDatasetManagerService) selectTraceItems(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, orgId uuid.UUID, projectId uuid.UUID, selection models.DatasetTraceSelection) ([]models.EvalDatasetItem, []string, error) {
+	if _, err := s.AgentRepository.GetAgentByName(ctx, orgId, projectId, selection.AgentName); err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", selection.AgentName, "projectName", project.ProjectName, "orgName", project.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil, utils.ErrAgentNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+
+	traceIds := selection.TraceIDs
+	if len(traceIds) == 0 {
+		limit := selection.Limit
+		if limit == 0 {
+			limit = utils.EvalDatasetMaxTraceSelection
+		}
+		traces, err := s.ObservabilityService.ListTraces(ctx, userIdpId, ListTracesRequest{
+			OrgName:     project.OrgName,
+			ProjectName: project.ProjectName,
+			ServiceName: selection.AgentName,
+			StartTime:   selection.StartTime,
+			EndTime:     selection.EndTime,
+			Limit:       limit,
+			SortOrder:   "desc",
+			Label:       selection.Label,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, trace := range traces.Traces {
+			traceIds = append(traceIds, trace.TraceID)
+		}
+	}
+
+	items := []models.EvalDatasetItem{}
+	skipped := []string{}
+	for _, traceId := range traceIds {
+		trace, err := s.ObservabilityService.GetTraceDetails(ctx, userIdpId, TraceDetailsRequest{
+			OrgName:     project.OrgName,
+			ProjectName: project.ProjectName,
+			TraceID:     traceId,
+			ServiceName: selection.AgentName,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		input, output, ok := traceInputOutput(trace.Spans)
+		if !ok {
+			skipped = append(skipped, traceId)
+			continue
+		}
+		items = append(items, models.EvalDatasetItem{
+			TraceID:   traceId,
+			AgentName: selection.AgentName,
+			Input:     input,
+			Output:    output,
+		})
+	}
+	return items, skipped, nil
+}
+
+// GenAI attributes the input and output of a root span are read from, in order of preference. Traceloop
+// records the input and output of workflows as entity attributes, while LLM spans carry the prompt and
+// completion messages, as indexed attributes in older semantic conventions and as JSON in newer ones.
+var (
+	genAIInputAttributes  = []string{"traceloop.entity.input", "gen_ai.input.messages", "input.value"}
+	genAIOutputAttributes = []string{"traceloop.entity.output", "gen_ai.output.messages", "output.value"}
+)
+
+// traceInputOutput returns the GenAI input and output recorded on the root span of a trace
+func traceInputOutput(spans []models.Span) (string, string, bool) {
+	root := rootSpan(spans)
+	if root == nil {
+		return "", "", false
+	}
+	input := firstAttribute(root.Attributes, genAIInputAttributes)
+	if input == "" {
+		input = lastUserPrompt(root.Attributes)
+	}
+	if input == "" {
+		return "", "", false
+	}
+	output := firstAttribute(root.Attributes, genAIOutputAttributes)
+	if output == "" {
+		output = attributeString(root.Attributes["gen_ai.completion.0.content"])
+	}
+	return input, output, true
+}
+
+// rootSpan returns the span without a parent, or the earliest span when the root was not recorded
+func rootSpan(spans []models.Span) *models.Span {
+	var root *models.Span
+	for i := range spans {
+		if spans[i].ParentSpanID == "" {
+			return &spans[i]
+		}
+		if root == nil || spans[i].StartTime.Before(root.StartTime) {
+			root = &spans[i]
+		}
+	}
+	return root
+}
+
+// lastUserPrompt returns the content of the last user message of the indexed gen_ai.prompt attributes,
+// or of the last message when no message has the user role
+func lastUserPrompt(attributes map[string]interface{}) string {
+	var last, lastUser string
+	for i := 0; ; i++ {
+		prefix := "gen_ai.prompt." + strconv.Itoa(i) + "."
+		content, ok := attributes[prefix+"content"]
+		if !ok {
+			break
+		}
+		last = attributeString(content)
+		if attributeString(attributes[prefix+"role"]) == "user" {
+			lastUser = last
+		}
+	}
+	if lastUser != "" {
+		return lastUser
+	}
+	return last
+}
+
+func firstAttribute(attributes map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		if value := attributeString(attributes[key]); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// attributeString returns string attributes as is and encodes structured values as JSON
+func attributeString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
+
+// getProject validates the organization and returns it with the project
+func (s *evalDatasetManagerService) getProject(ctx context.Context, userIdpId uuid.UUID, project ProjectRef) (*models.Organization, *models.Project, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, project.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", project.OrgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil, utils.ErrOrganizationNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find organization %s: %w", project.OrgName, err)
+	}
+	dbProject, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, project.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", project.ProjectName, "orgName", project.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil, utils.ErrProjectNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find project %s: %w", project.ProjectName, err)
+	}
+	return org, dbProject, nil
+}
+
+// getDataset returns a version of a dataset, or its latest version when version is 0
+func (s *evalDatasetManagerService) getDataset(ctx context.Context, projectId uuid.UUID, name string, version int) (*models.EvalDataset, error) {
+	var dataset *models.EvalDataset
+	var err error
+	if version == 0 {
+		dataset, err = s.EvalDatasetRepository.GetLatestDataset(ctx, projectId, name)
+	} else {
+		dataset, err = s.EvalDatasetRepository.GetDatasetVersion(ctx, projectId, name, version)
+	}
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrEvalDatasetNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch dataset %s: %w", name, err)
+	}
+	return dataset, nil
+}
+
+func toDatasetResponse(dataset *models.EvalDataset, items []models.EvalDatasetItem) *models.DatasetResponse {
+	response := &models.DatasetResponse{
+		Name:        dataset.Name,
+		Version:     dataset.Version,
+		Description: dataset.Description,
+		CreatedBy:   dataset.CreatedBy.String(),
+		CreatedAt:   dataset.CreatedAt,
+		ItemCount:   len(items),
+		Items:       make([]models.DatasetItem, len(items)),
+	}
+	for i, item := range items {
+		response.Items[i] = models.DatasetItem{
+			TraceID:   item.TraceID,
+			AgentName: item.AgentName,
+			Input:     item.Input,
+			Output:    item.Output,
+		}
+	}
+	return response
+}
@@ -0,0 +1,581 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/evaluators"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type EvalRunManagerService interface {
+	CreateEvalRun(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, req models.CreateEvalRunRequest) (*models.EvalRunResponse, error)
+	ListEvalRuns(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, datasetName string, agentName string) (*models.EvalRunListResponse, error)
+	GetEvalRun(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, runId uuid.UUID) (*models.EvalRunResponse, error)
+	CompareEvalRuns(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, baseRunId uuid.UUID, candidateRunId uuid.UUID) (*models.EvalRunComparisonResponse, error)
+	FailInterruptedEvalRuns(ctx context.Context) error
+}
+
+type evalRunManagerService struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ProjectRepository      repositories.ProjectRepository
+	AgentRepository        repositories.AgentRepository
+	EvalRunRepository      repositories.EvalRunRepository
+	EvalDatasetService     EvalDatasetManagerService
+	AgentManagerService    AgentManagerService
+	EvaluationClient       evaluationsvc.EvaluationClient
+	logger                 *slog.Logger
+}
+
+func NewEvalRunManagerService(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	evalRunRepo repositories.EvalRunRepository,
+	evalDatasetService EvalDatasetManagerService,
+	agentManagerService AgentManagerService,
+	evaluationClient evaluationsvc.EvaluationClient,
+	logger *slog.Logger,
+) EvalRunManagerService {
+	return &evalRunManagerService{
+		OrganizationRepository: orgRepo,
+		ProjectRepository:      projRepo,
+		AgentRepository:        agentRepo,
+		EvalRunRepository:      evalRunRepo,
+		EvalDatasetService:     evalDatasetService,
+		AgentManagerService:    agentManagerService,
+		EvaluationClient:       evaluationClient,
+		logger:                 logger,
+	}
+}
+
+// namedEvaluator is an evaluator of a run together with the name its scores are reported under
+type namedEvaluator struct {
+	name      string
+	evaluator evaluators.Evaluator
+}
+
+// CreateEvalRun starts running a dataset version against the endpoint of an agent in an environment. Items are sent
+// to the agent in the background and the returned run is updated as they finish.
+func (s *evalRunManagerService) CreateEvalRun(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, req models.CreateEvalRunRequest) (*models.EvalRunResponse, error) {
+	s.logger.Info("Creating evaluation run", "datasetName", req.DatasetName, "agentName", req.AgentName, "environment", req.Environment, "projectName", project.ProjectName, "orgName", project.OrgName)
+	org, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+	dataset, err := s.EvalDatasetService.GetDataset(ctx, userIdpId, project, req.DatasetName, req.DatasetVersion)
+	if err != nil {
+		return nil, err
+	}
+	runEvaluators, err := s.buildEvaluators(req.Evaluators)
+	if err != nil {
+		return nil, err
+	}
+
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, dbProject.ID, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", req.AgentName, "projectName", project.ProjectName, "orgName", project.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if agent.ProvisioningType != string(utils.InternalAgent) {
+		return nil, utils.ErrAgentNotInternal
+	}
+	endpoints, err := s.AgentManagerService.GetAgentEndpoints(ctx, userIdpId, project.OrgName, project.ProjectName, req.AgentName, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := selectEndpoint(endpoints, req.EndpointName)
+	if err != nil {
+		return nil, err
+	}
+
+	path := req.Path
+	if path == "" {
+		path = utils.EvalRunDefaultPath
+	}
+	concurrency := req.Concurrency
+	if concurrency == 0 {
+		concurrency = min(utils.EvalRunDefaultConcurrency, config.GetConfig().Evaluation.MaxConcurrency)
+	}
+	run := &models.EvalRun{
+		ID:             uuid.New(),
+		ProjectID:      dbProject.ID,
+		DatasetName:    dataset.Name,
+		DatasetVersion: dataset.Version,
+		AgentName:      req.AgentName,
+		Environment:    req.Environment,
+		EndpointURL:    strings.TrimSuffix(endpoint.URL, "/") + path,
+		Status:         utils.EvalRunStatusRunning,
+		Concurrency:    concurrency,
+		Evaluators:     req.Evaluators,
+		TotalItems:     len(dataset.Items),
+		CreatedBy:      userIdpId,
+		CreatedAt:      time.Now(),
+	}
+	for i := range run.Evaluators {
+		run.Evaluators[i].Name = runEvaluators[i].name
+	}
+	if err := s.EvalRunRepository.CreateEvalRun(ctx, run); err != nil {
+		s.logger.Error("Failed to create evaluation run", "datasetName", req.DatasetName, "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to create evaluation run: %w", err)
+	}
+
+	// The run outlives the request that started it
+	go s.executeRun(context.WithoutCancel(ctx), run, dataset.Items, runEvaluators)
+
+	s.logger.Info("Started evaluation run", "runId", run.ID, "endpointUrl", run.EndpointURL, "itemCount", run.TotalItems, "concurrency", concurrency)
+	return toEvalRunResponse(run, 0, nil), nil
+}
+
+// ListEvalRuns lists the runs of a project, most recent first, without their item results
+func (s *evalRunManagerService) ListEvalRuns(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, datasetName string, agentName string) (*models.EvalRunListResponse, error) {
+	s.logger.Info("Listing evaluation runs", "projectName", project.ProjectName, "orgName", project.OrgName, "datasetName", datasetName, "agentName", agentName)
+	_, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := s.EvalRunRepository.ListEvalRuns(ctx, dbProject.ID, datasetName, agentName)
+	if err != nil {
+		s.logger.Error("Failed to list evaluation runs", "projectName", project.ProjectName, "error", err)
+		return nil, fmt.Errorf("failed to list evaluation runs: %w", err)
+	}
+	runIds := make([]uuid.UUID, len(runs))
+	for i, run := range runs {
+		runIds[i] = run.ID
+	}
+	completedCounts, err := s.EvalRunRepository.CountEvalRunResults(ctx, runIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count evaluation run results: %w", err)
+	}
+
+	response := &models.EvalRunListResponse{
+		Runs:  make([]models.EvalRunResponse, len(runs)),
+		Total: len(runs),
+	}
+	for i := range runs {
+		response.Runs[i] = *toEvalRunResponse(&runs[i], completedCounts[runs[i].ID], nil)
+	}
+	return response, nil
+}
+
+// GetEvalRun returns a run with the results of the items that have finished so far
+func (s *evalRunManagerService) GetEvalRun(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, runId uuid.UUID) (*models.EvalRunResponse, error) {
+	s.logger.Info("Getting evaluation run", "runId", runId, "projectName", project.ProjectName, "orgName", project.OrgName)
+	_, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+	run, results, err := s.getEvalRunWithResults(ctx, dbProject.ID, runId)
+	if err != nil {
+		return nil, err
+	}
+	return toEvalRunResponse(run, len(results), results), nil
+}
+
+// CompareEvalRuns compares the results of a candidate run with those of a base run. Both runs must have finished.
+func (s *evalRunManagerService) CompareEvalRuns(ctx context.Context, userIdpId uuid.UUID, project ProjectRef, baseRunId uuid.UUID, candidateRunId uuid.UUID) (*models.EvalRunComparisonResponse, error) {
+	s.logger.Info("Comparing evaluation runs", "baseRunId", baseRunId, "candidateRunId", candidateRunId, "projectName", project.ProjectName, "orgName", project.OrgName)
+	_, dbProject, err := s.getProject(ctx, userIdpId, project)
+	if err != nil {
+		return nil, err
+	}
+	baseRun, baseResults, err := s.getEvalRunWithResults(ctx, dbProject.ID, baseRunId)
+	if err != nil {
+		return nil, err
+	}
+	candidateRun, candidateResults, err := s.getEvalRunWithResults(ctx, dbProject.ID, candidateRunId)
+	if err != nil {
+		return nil, err
+	}
+	if baseRun.Status == utils.EvalRunStatusRunning || candidateRun.Status == utils.EvalRunStatusRunning {
+		return nil, utils.ErrEvalRunNotFinished
+	}
+	return compareEvalRuns(baseRun, baseResults, candidateRun, candidateResults), nil
+}
+
+// FailInterruptedEvalRuns marks the runs left running by a previous instance of the service as failed. Runs are
+// executed in memory by the instance that created them, so they can no longer finish once it has stopped.
+func (s *evalRunManagerService) FailInterruptedEvalRuns(ctx context.Context) error {
+	count, err := s.EvalRunRepository.FailRunningEvalRuns(ctx, "run was interrupted by a restart of the service", time.Now())
+	if err != nil {
+		s.logger.Error("Failed to mark interrupted evaluation runs as failed", "error", err)
+		return fmt.Errorf("failed to mark interrupted evaluation runs as failed: %w", err)
+	}
+	if count > 0 {
+		s.logger.Info("Marked interrupted evaluation runs as failed", "runCount", count)
+	}
+	return nil
+}
+
+// buildEvaluators creates the evaluators of a run, naming each after its type unless a name is configured
+func (s *evalRunManagerService) buildEvaluators(configs []models.EvaluatorConfig) ([]namedEvaluator, error) {
+	runEvaluators := make([]namedEvaluator, len(configs))
+	names := make(map[string]bool, len(configs))
+	for i, cfg := range configs {
+		name := cfg.Name
+		if name == "" {
+			name = cfg.Type
+		}
+		if names[name] {
+			return nil, fmt.Errorf("%w: evaluator name %q is used more than once, set a unique name", utils.ErrInvalidEvaluator, name)
+		}
+		names[name] = true
+		evaluator, err := evaluators.New(cfg, s.EvaluationClient)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", utils.ErrInvalidEvaluator, name, err)
+		}
+		runEvaluators[i] = namedEvaluator{name: name, evaluator: evaluator}
+	}
+	return runEvaluators, nil
+}
+
+// executeRun sends the items of a run to the agent, at most run.Concurrency at a time, scores and stores each
+// result as it finishes, and finally records the run summary
+func (s *evalRunManagerService) executeRun(ctx context.Context, run *models.EvalRun, items []models.DatasetItem, runEvaluators []namedEvaluator) {
+	runTimeout := time.Duration(config.GetConfig().Evaluation.RunTimeoutMinutes) * time.Minute
+	runCtx, cancel := context.WithTimeout(ctx, runTimeout)
+	defer cancel()
+
+	results := make([]*models.EvalRunResult, len(items))
+	slots := make(chan struct{}, run.Concurrency)
+	var wg sync.WaitGroup
+dispatch:
+	for i, item := range items {
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+			break dispatch
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			result := s.runItem(runCtx, run, i, item, runEvaluators)
+			// Results are stored even when the run times out while the item is in flight
+			if err := s.EvalRunRepository.CreateEvalRunResult(ctx, result); err != nil {
+				s.logger.Error("Failed to store evaluation run result", "runId", run.ID, "position", i, "error", err)
+				return
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	finished := make([]models.EvalRunResult, 0, len(results))
+	for _, result := range results {
+		if result != nil {
+			finished = append(finished, *result)
+		}
+	}
+	evaluatorNames := make([]string, len(runEvaluators))
+	for i, runEvaluator := range runEvaluators {
+		evaluatorNames[i] = runEvaluator.name
+	}
+	completedAt := time.Now()
+	run.Summary = summarizeEvalRun(finished, evaluatorNames)
+	run.CompletedAt = &completedAt
+	run.Status = utils.EvalRunStatusCompleted
+	if runCtx.Err() != nil {
+		run.Status = utils.EvalRunStatusFailed
+		run.Error = fmt.Sprintf("run did not finish within %s, %d of %d items finished", runTimeout, len(finished), len(items))
+	} else if len(finished) < len(items) {
+		run.Status = utils.EvalRunStatusFailed
+		run.Error = fmt.Sprintf("failed to store the results of %d of %d items", len(items)-len(finished), len(items))
+	}
+	if err := s.EvalRunRepository.FinishEvalRun(ctx, run); err != nil {
+		s.logger.Error("Failed to store evaluation run summary", "runId", run.ID, "error", err)
+		return
+	}
+	s.logger.Info("Finished evaluation run", "runId", run.ID, "status", run.Status, "passedItems", run.Summary.PassedItems, "totalItems", run.Summary.TotalItems)
+}
+
+// runItem sends a dataset item to the agent and scores its reply with every evaluator of the run
+func (s *evalRunManagerService) runItem(ctx context.Context, run *models.EvalRun, position int, item models.DatasetItem, runEvaluators []namedEvaluator) *models.EvalRunResult {
+	result := &models.EvalRunResult{
+		ID:             uuid.New(),
+		RunID:          run.ID,
+		Position:       position,
+		Input:          item.Input,
+		ExpectedOutput: item.Output,
+		Scores:         []models.EvaluatorScore{},
+	}
+	start := time.Now()
+	output, err := s.EvaluationClient.InvokeAgent(ctx, evaluationsvc.InvokeAgentParams{
+		URL:       run.EndpointURL,
+		Message:   item.Input,
+		SessionID: fmt.Sprintf("eval-%s-%d", run.ID, position),
+	})
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		s.logger.Warn("Failed to invoke agent for evaluation run item", "runId", run.ID, "position", position, "error", err)
+		result.Error = err.Error()
+		return result
+	}
+	result.Output = output
+
+	sample := evaluators.Sample{Input: item.Input, Expected: item.Output, Output: output}
+	result.Passed = true
+	for _, runEvaluator := range runEvaluators {
+		score := models.EvaluatorScore{Evaluator: runEvaluator.name}
+		evaluation, err := runEvaluator.evaluator.Evaluate(ctx, sample)
+		if err != nil {
+			score.Error = err.Error()
+		} else {
+			score.Score = evaluation.Score
+			score.Passed = evaluation.Passed
+			score.Reason = evaluation.Reason
+		}
+		result.Passed = result.Passed && score.Passed
+		result.Scores = append(result.Scores, score)
+	}
+	return result
+}
+
+func (s *evalRunManagerService) getEvalRunWithResults(ctx context.Context, projectId uuid.UUID, runId uuid.UUID) (*models.EvalRun, []models.EvalRunResult, error) {
+	run, err := s.EvalRunRepository.GetEvalRun(ctx, projectId, runId)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil, utils.ErrEvalRunNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find evaluation run %s: %w", runId, err)
+	}
+	results, err := s.EvalRunRepository.ListEvalRunResults(ctx, run.ID)
+	if err != nil {
+		s.logger.Error("Failed to list evaluation run results", "runId", runId, "error", err)
+		return nil, nil, fmt.Errorf("failed to list evaluation run results: %w", err)
+	}
+	return run, results, nil
+}
+
+func (s *evalRunManagerService) getProject(ctx context.Context, userIdpId uuid.UUID, project ProjectRef) (*models.Organization, *models.Project, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, project.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", project.OrgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil, utils.ErrOrganizationNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find organization %s: %w", project.OrgName, err)
+	}
+	dbProject, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, project.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", project.ProjectName, "orgName", project.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil, utils.ErrProjectNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find project %s: %w", project.ProjectName, err)
+	}
+	return org, dbProject, nil
+}
+
+// selectEndpoint picks the named endpoint of an agent, or its only endpoint when no name is given
+func selectEndpoint(endpoints map[string]models.EndpointsResponse, endpointName string) (*models.EndpointsResponse, error) {
+	if endpointName != "" {
+		endpoint, ok := endpoints[endpointName]
+		if !ok {
+			return nil, utils.ErrAgentEndpointNotFound
+		}
+		return &endpoint, nil
+	}
+	if len(endpoints) != 1 {
+		return nil, utils.ErrAgentEndpointNotFound
+	}
+	for _, endpoint := range endpoints {
+		return &endpoint, nil
+	}
+	return nil, utils.ErrAgentEndpointNotFound
+}
+
+// summarizeEvalRun aggregates the results of the items of a run that finished
+func summarizeEvalRun(results []models.EvalRunResult, evaluatorNames []string) *models.EvalRunSummary {
+	summary := &models.EvalRunSummary{
+		TotalItems: len(results),
+		Evaluators: make([]models.EvaluatorSummary, len(evaluatorNames)),
+	}
+	evaluatorIndex := make(map[string]int, len(evaluatorNames))
+	scoreSums := make([]float64, len(evaluatorNames))
+	passCounts := make([]int, len(evaluatorNames))
+	for i, name := range evaluatorNames {
+		evaluatorIndex[name] = i
+		summary.Evaluators[i].Evaluator = name
+	}
+
+	var latencySum int64
+	for _, result := range results {
+		latencySum += result.LatencyMs
+		if result.Passed {
+			summary.PassedItems++
+		}
+		if result.Error != "" {
+			summary.ErroredItems++
+		}
+		for _, score := range result.Scores {
+			i, ok := evaluatorIndex[score.Evaluator]
+			if !ok || score.Error != "" {
+				continue
+			}
+			summary.Evaluators[i].ScoredItems++
+			scoreSums[i] += score.Score
+			if score.Passed {
+				passCounts[i]++
+			}
+		}
+	}
+	if len(results) > 0 {
+		summary.PassRate = float64(summary.PassedItems) / float64(len(results))
+		summary.AverageLatencyMs = float64(latencySum) / float64(len(results))
+	}
+	for i := range summary.Evaluators {
+		if scored := summary.Evaluators[i].ScoredItems; scored > 0 {
+			summary.Evaluators[i].AverageScore = scoreSums[i] / float64(scored)
+			summary.Evaluators[i].PassRate = float64(passCounts[i]) / float64(scored)
+		}
+	}
+	return summary
+}
+
+// compareEvalRuns matches the items of two runs by input, pairing repeated inputs in dataset order
+func compareEvalRuns(baseRun *models.EvalRun, baseResults []models.EvalRunResult, candidateRun *models.EvalRun, candidateResults []models.EvalRunResult) *models.EvalRunComparisonResponse {
+	response := &models.EvalRunComparisonResponse{
+		BaseRunID:          baseRun.ID.String(),
+		CandidateRunID:     candidateRun.ID.String(),
+		BaseSummary:        baseRun.Summary,
+		CandidateSummary:   candidateRun.Summary,
+		AverageScoreDeltas: map[string]float64{},
+		Items:              []models.EvalRunItemComparison{},
+	}
+	if baseRun.Summary != nil && candidateRun.Summary != nil {
+		response.PassRateDelta = candidateRun.Summary.PassRate - baseRun.Summary.PassRate
+		baseAverages := make(map[string]models.EvaluatorSummary, len(baseRun.Summary.Evaluators))
+		for _, evaluator := range baseRun.Summary.Evaluators {
+			baseAverages[evaluator.Evaluator] = evaluator
+		}
+		for _, evaluator := range candidateRun.Summary.Evaluators {
+			base, ok := baseAverages[evaluator.Evaluator]
+			if ok && base.ScoredItems > 0 && evaluator.ScoredItems > 0 {
+				response.AverageScoreDeltas[evaluator.Evaluator] = evaluator.AverageScore - base.AverageScore
+			}
+		}
+	}
+
+	baseByInput := make(map[string][]models.EvalRunResult, len(baseResults))
+	for _, result := range baseResults {
+		baseByInput[result.Input] = append(baseByInput[result.Input], result)
+	}
+	for _, candidate := range candidateResults {
+		matches := baseByInput[candidate.Input]
+		if len(matches) == 0 {
+			response.Unmatched++
+			continue
+		}
+		base := matches[0]
+		baseByInput[candidate.Input] = matches[1:]
+
+		item := models.EvalRunItemComparison{
+			Input:           candidate.Input,
+			BasePassed:      base.Passed,
+			CandidatePassed: candidate.Passed,
+			ScoreDeltas:     scoreDeltas(base.Scores, candidate.Scores),
+			Change:          utils.EvalRunChangeUnchanged,
+		}
+		switch {
+		case !base.Passed && candidate.Passed:
+			item.Change = utils.EvalRunChangeImproved
+			response.Improved++
+		case base.Passed && !candidate.Passed:
+			item.Change = utils.EvalRunChangeRegressed
+			response.Regressed++
+		default:
+			response.Unchanged++
+		}
+		response.Items = append(response.Items, item)
+	}
+	for _, remaining := range baseByInput {
+		response.Unmatched += len(remaining)
+	}
+	return response
+}
+
+// scoreDeltas returns the candidate score minus the base score of every evaluator that scored both outputs
+func scoreDeltas(baseScores []models.EvaluatorScore, candidateScores []models.EvaluatorScore) map[string]float64 {
+	base := make(map[string]models.EvaluatorScore, len(baseScores))
+	for _, score := range baseScores {
+		base[score.Evaluator] = score
+	}
+	deltas := map[string]float64{}
+	for _, candidate := range candidateScores {
+		baseScore, ok := base[candidate.Evaluator]
+		if !ok || baseScore.Error != "" || candidate.Error != "" {
+			continue
+		}
+		deltas[candidate.Evaluator] = candidate.Score - baseScore.Score
+	}
+	return deltas
+}
+
+func toEvalRunResponse(run *models.EvalRun, completedItems int, results []models.EvalRunResult) *models.EvalRunResponse {
+	response := &models.EvalRunResponse{
+		ID:             run.ID.String(),
+		DatasetName:    run.DatasetName,
+		DatasetVersion: run.DatasetVersion,
+		AgentName:      run.AgentName,
+		Environment:    run.Environment,
+		EndpointURL:    run.EndpointURL,
+		Status:         run.Status,
+		Concurrency:    run.Concurrency,
+		Evaluators:     run.Evaluators,
+		CompletedItems: completedItems,
+		TotalItems:     run.TotalItems,
+		Summary:        run.Summary,
+		Error:          run.Error,
+		CreatedBy:      run.CreatedBy.String(),
+		CreatedAt:      run.CreatedAt,
+		CompletedAt:    run.CompletedAt,
+	}
+	if results != nil {
+		response.Results = make([]models.EvalRunResultResponse, len(results))
+		for i, result := range results {
+			response.Results[i] = models.EvalRunResultResponse{
+				Position:       result.Position,
+				Input:          result.Input,
+				ExpectedOutput: result.ExpectedOutput,
+				Output:         result.Output,
+				Error:          result.Error,
+				LatencyMs:      result.LatencyMs,
+				Scores:         result.Scores,
+				Passed:         result.Passed,
+			}
+		}
+	}
+	return response
+}
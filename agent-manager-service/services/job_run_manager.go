@@ -0,0 +1,207 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Logs of a run are searched from a little before it started to a little after it completed
+const (
+	jobRunLogsWindowPadding = time.Minute
+	jobRunLogsLimit         = 1000
+)
+
+type JobRunManagerService interface {
+	TriggerJobRun(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) (*models.JobRunResponse, error)
+	ListJobRuns(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) (*models.JobRunsResponse, error)
+	GetJobRunLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, runName string, environment string) (*models.JobRunLogsResponse, error)
+}
+
+type jobRunManagerService struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ProjectRepository      repositories.ProjectRepository
+	AgentRepository        repositories.AgentRepository
+	OpenChoreoSvcClient    openchoreosvc.OpenChoreoSvcClient
+	ObservabilitySvcClient observabilitysvc.ObservabilitySvcClient
+	logger                 *slog.Logger
+}
+
+func NewJobRunManagerService(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	openChoreoSvcClient openchoreosvc.OpenChoreoSvcClient,
+	observabilitySvcClient observabilitysvc.ObservabilitySvcClient,
+	logger *slog.Logger,
+) JobRunManagerService {
+	return &jobRunManagerService{
+		OrganizationRepository: orgRepo,
+		ProjectRepository:      projRepo,
+		AgentRepository:        agentRepo,
+		OpenChoreoSvcClient:    openChoreoSvcClient,
+		ObservabilitySvcClient: observabilitySvcClient,
+		logger:                 logger,
+	}
+}
+
+// TriggerJobRun starts a run of a job agent in an environment outside its schedule
+func (s *jobRunManagerService) TriggerJobRun(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) (*models.JobRunResponse, error) {
+	s.logger.Info("Triggering job run", "agentName", agentName, "environment", environment, "orgName", orgName, "projectName", projectName, "userIdpId", userIdpId)
+	if err := s.validateJobAgent(ctx, userIdpId, orgName, projectName, agentName, environment); err != nil {
+		return nil, err
+	}
+	run, err := s.OpenChoreoSvcClient.TriggerJobRun(ctx, orgName, projectName, agentName, environment)
+	if err != nil {
+		s.logger.Error("Failed to trigger job run", "agentName", agentName, "environment", environment, "error", err)
+		return nil, err
+	}
+	s.logger.Info("Triggered job run", "agentName", agentName, "environment", environment, "runName", run.Name)
+	return run, nil
+}
+
+// ListJobRuns lists the runs of a job agent in an environment along with its schedule
+func (s *jobRunManagerService) ListJobRuns(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) (*models.JobRunsResponse, error) {
+	s.logger.Info("Listing job runs", "agentName", agentName, "environment", environment, "orgName", orgName, "projectName", projectName, "userIdpId", userIdpId)
+	if err := s.validateJobAgent(ctx, userIdpId, orgName, projectName, agentName, environment); err != nil {
+		return nil, err
+	}
+	runs, err := s.OpenChoreoSvcClient.ListJobRuns(ctx, orgName, projectName, agentName, environment)
+	if err != nil {
+		s.logger.Error("Failed to list job runs", "agentName", agentName, "environment", environment, "error", err)
+		return nil, err
+	}
+	return runs, nil
+}
+
+// GetJobRunLogs returns a run of a job agent together with the logs of its pods
+func (s *jobRunManagerService) GetJobRunLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, runName string, environment string) (*models.JobRunLogsResponse, error) {
+	s.logger.Info("Getting job run logs", "agentName", agentName, "runName", runName, "environment", environment, "orgName", orgName, "projectName", projectName, "userIdpId", userIdpId)
+	if err := s.validateJobAgent(ctx, userIdpId, orgName, projectName, agentName, environment); err != nil {
+		return nil, err
+	}
+	runs, err := s.OpenChoreoSvcClient.ListJobRuns(ctx, orgName, projectName, agentName, environment)
+	if err != nil {
+		s.logger.Error("Failed to list job runs", "agentName", agentName, "environment", environment, "error", err)
+		return nil, err
+	}
+	var run *models.JobRunResponse
+	for i := range runs.Runs {
+		if runs.Runs[i].Name == runName {
+			run = &runs.Runs[i]
+			break
+		}
+	}
+	if run == nil {
+		return nil, utils.ErrJobRunNotFound
+	}
+
+	response := &models.JobRunLogsResponse{
+		Run:  *run,
+		Logs: []models.LogEntry{},
+	}
+	// Runs that have not started have no logs yet
+	if run.StartedAt == nil {
+		return response, nil
+	}
+	component, err := s.OpenChoreoSvcClient.GetAgentComponent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", agentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+	env, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, orgName, environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", environment, "error", err)
+		return nil, err
+	}
+	endTime := time.Now()
+	if run.CompletedAt != nil {
+		endTime = run.CompletedAt.Add(jobRunLogsWindowPadding)
+	}
+	logs, err := s.ObservabilitySvcClient.GetComponentLogs(ctx, component.UUID, observabilitysvc.ComponentLogsParams{
+		EnvironmentId: env.UUID,
+		Namespace:     run.Namespace,
+		StartTime:     run.StartedAt.Add(-jobRunLogsWindowPadding),
+		EndTime:       endTime,
+		Limit:         jobRunLogsLimit,
+	})
+	if err != nil {
+		s.logger.Error("Failed to fetch job run logs from observability service", "runName", runName, "error", err)
+		return nil, fmt.Errorf("failed to fetch job run logs: %w", err)
+	}
+	// The component logs include every run in the window, pods are labelled with the name of their Job
+	for _, entry := range logs.Logs {
+		if entry.Labels[openchoreosvc.JobNameLabel] == runName {
+			response.Logs = append(response.Logs, entry)
+		}
+	}
+	response.TotalCount = len(response.Logs)
+	s.logger.Info("Fetched job run logs successfully", "agentName", agentName, "runName", runName, "logCount", response.TotalCount)
+	return response, nil
+}
+
+// validateJobAgent checks that the organization, project, internal agent and environment exist
+func (s *jobRunManagerService) validateJobAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) error {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgName", orgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrProjectNotFound
+		}
+		return fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", agentName, "projectName", projectName, "orgName", orgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if agent.ProvisioningType != string(utils.InternalAgent) {
+		return utils.ErrAgentNotJob
+	}
+	if _, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, orgName, environment); err != nil {
+		s.logger.Error("Failed to get environment", "environment", environment, "orgName", orgName, "error", err)
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			return utils.ErrEnvironmentNotFound
+		}
+		return fmt.Errorf("failed to get environment %s: %w", environment, err)
+	}
+	return nil
+}
@@ -18,51 +18,155 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"sort"
+	"strings"
 
+	"github.com/google/uuid"
+
+	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // Service-level request/response types (not exposing client types)
 type ListTracesRequest struct {
+	OrgName     string
+	ProjectName string
 	ServiceName string
 	StartTime   string
 	EndTime     string
 	Limit       int
 	Offset      int
 	SortOrder   string
+	// Only list traces that have an annotation with this label
+	Label string
 }
 
 type TraceDetailsRequest struct {
+	OrgName     string
+	ProjectName string
 	TraceID     string
 	ServiceName string
 }
 
+// AgentTraceScope identifies the agent and environment whose traces are queried
+type AgentTraceScope struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+}
+
+type ListSessionsRequest struct {
+	AgentTraceScope
+	StartTime string
+	EndTime   string
+	Limit     int
+	Offset    int
+}
+
+type SessionTracesRequest struct {
+	AgentTraceScope
+	SessionID string
+	StartTime string
+	EndTime   string
+}
+
+type TraceTreeRequest struct {
+	AgentTraceScope
+	TraceID string
+}
+
+type ExportTraceRequest struct {
+	AgentTraceScope
+	TraceID string
+	Format  string
+}
+
+type ExportTracesRequest struct {
+	AgentTraceScope
+	StartTime string
+	EndTime   string
+	Format    string
+}
+
+type TailTracesRequest struct {
+	AgentTraceScope
+	Attributes []string
+}
+
+// UsageReportRequest scopes a usage report to an organization, and optionally to a project and agent
+type UsageReportRequest struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	StartTime   string
+	EndTime     string
+	GroupBy     []utils.UsageGroupBy
+}
+
 type ObservabilityManagerService interface {
-	ListTraces(ctx context.Context, req ListTracesRequest) (*models.TraceOverviewResponse, error)
-	GetTraceDetails(ctx context.Context, req TraceDetailsRequest) (*models.TraceResponse, error)
+	ListTraces(ctx context.Context, userIdpId uuid.UUID, req ListTracesRequest) (*models.TraceOverviewResponse, error)
+	GetTraceDetails(ctx context.Context, userIdpId uuid.UUID, req TraceDetailsRequest) (*models.TraceResponse, error)
+	GetUsageReport(ctx context.Context, userIdpId uuid.UUID, req UsageReportRequest) (*models.UsageReportResponse, error)
+	ListSessions(ctx context.Context, userIdpId uuid.UUID, req ListSessionsRequest) (*models.SessionOverviewResponse, error)
+	GetSessionTraces(ctx context.Context, userIdpId uuid.UUID, req SessionTracesRequest) (*models.SessionTracesResponse, error)
+	GetTraceTree(ctx context.Context, userIdpId uuid.UUID, req TraceTreeRequest) (*models.TraceTreeResponse, error)
+	ExportTrace(ctx context.Context, userIdpId uuid.UUID, req ExportTraceRequest) (json.RawMessage, error)
+	ExportTraces(ctx context.Context, userIdpId uuid.UUID, req ExportTracesRequest) (io.ReadCloser, error)
+	TailTraces(ctx context.Context, userIdpId uuid.UUID, req TailTracesRequest) (io.ReadCloser, error)
 }
 
 type observabilityManagerService struct {
-	TraceObserverClient traceobserversvc.TraceObserverClient
-	logger              *slog.Logger
+	OrganizationRepository    repositories.OrganizationRepository
+	ProjectRepository         repositories.ProjectRepository
+	AgentRepository           repositories.AgentRepository
+	TraceAnnotationRepository repositories.TraceAnnotationRepository
+	OpenChoreoSvcClient       clients.OpenChoreoSvcClient
+	TraceObserverClient       traceobserversvc.TraceObserverClient
+	logger                    *slog.Logger
 }
 
 func NewObservabilityManager(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	traceAnnotationRepo repositories.TraceAnnotationRepository,
+	openChoreoSvcClient clients.OpenChoreoSvcClient,
 	traceObserverClient traceobserversvc.TraceObserverClient,
 	logger *slog.Logger,
 ) ObservabilityManagerService {
 	return &observabilityManagerService{
-		TraceObserverClient: traceObserverClient,
-		logger:              logger,
+		OrganizationRepository:    orgRepo,
+		ProjectRepository:         projRepo,
+		AgentRepository:           agentRepo,
+		TraceAnnotationRepository: traceAnnotationRepo,
+		OpenChoreoSvcClient:       openChoreoSvcClient,
+		TraceObserverClient:       traceObserverClient,
+		logger:                    logger,
 	}
 }
 
-// ListTraces retrieves trace overviews from the trace observer service
-func (s *observabilityManagerService) ListTraces(ctx context.Context, req ListTracesRequest) (*models.TraceOverviewResponse, error) {
-	s.logger.Info("Listing traces", "serviceName", req.ServiceName, "limit", req.Limit, "offset", req.Offset)
+// ListTraces retrieves trace overviews from the trace observer service, along with their annotations
+func (s *observabilityManagerService) ListTraces(ctx context.Context, userIdpId uuid.UUID, req ListTracesRequest) (*models.TraceOverviewResponse, error) {
+	s.logger.Info("Listing traces", "serviceName", req.ServiceName, "limit", req.Limit, "offset", req.Offset, "label", req.Label)
+
+	agent, err := s.findAnnotatedAgent(ctx, userIdpId, req.OrgName, req.ProjectName, req.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	if req.Label != "" {
+		return s.listTracesByLabel(ctx, agent, req)
+	}
 
 	// Convert service request to client params
 	clientParams := traceobserversvc.ListTracesParams{
@@ -82,8 +186,67 @@ func (s *observabilityManagerService) ListTraces(ctx context.Context, req ListTr
 	}
 
 	// Convert client response to service model
-	traces := make([]models.TraceOverview, len(clientResponse.Traces))
-	for i, trace := range clientResponse.Traces {
+	traces := toTraceOverviews(clientResponse.Traces)
+	if err := s.annotateTraceOverviews(ctx, agent, traces); err != nil {
+		return nil, err
+	}
+
+	response := &models.TraceOverviewResponse{
+		Traces:     traces,
+		TotalCount: clientResponse.TotalCount,
+		Truncated:  clientResponse.Truncated,
+	}
+
+	s.logger.Info("Retrieved traces successfully", "serviceName", req.ServiceName, "totalCount", response.TotalCount)
+	return response, nil
+}
+
+// listTracesByLabel lists the traces that have an annotation with the requested label. The labelled trace IDs
+// are looked up first and only those traces are requested from the trace observer, which paginates them.
+func (s *observabilityManagerService) listTracesByLabel(ctx context.Context, agent *models.Agent, req ListTracesRequest) (*models.TraceOverviewResponse, error) {
+	response := &models.TraceOverviewResponse{Traces: []models.TraceOverview{}}
+	// Unregistered agents cannot have annotations
+	if agent == nil {
+		return response, nil
+	}
+	labelledTraceIds, err := s.TraceAnnotationRepository.ListTraceIDsByLabel(ctx, agent.ID, req.Label)
+	if err != nil {
+		s.logger.Error("Failed to list labelled traces", "serviceName", req.ServiceName, "label", req.Label, "error", err)
+		return nil, fmt.Errorf("failed to list labelled traces: %w", err)
+	}
+	if len(labelledTraceIds) == 0 {
+		return response, nil
+	}
+
+	clientResponse, err := s.TraceObserverClient.ListTraces(ctx, traceobserversvc.ListTracesParams{
+		ServiceName: req.ServiceName,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+		SortOrder:   req.SortOrder,
+		TraceIDs:    labelledTraceIds,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list traces", "serviceName", req.ServiceName, "error", err)
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+
+	response.TotalCount = clientResponse.TotalCount
+	response.Truncated = clientResponse.Truncated
+	response.Traces = toTraceOverviews(clientResponse.Traces)
+	if err := s.annotateTraceOverviews(ctx, agent, response.Traces); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Retrieved labelled traces successfully", "serviceName", req.ServiceName, "label", req.Label, "totalCount", response.TotalCount)
+	return response, nil
+}
+
+// toTraceOverviews converts trace overviews from the trace observer to the service model
+func toTraceOverviews(overviews []traceobserversvc.TraceOverview) []models.TraceOverview {
+	traces := make([]models.TraceOverview, len(overviews))
+	for i, trace := range overviews {
 		traces[i] = models.TraceOverview{
 			TraceID:         trace.TraceID,
 			RootSpanID:      trace.RootSpanID,
@@ -94,20 +257,51 @@ func (s *observabilityManagerService) ListTraces(ctx context.Context, req ListTr
 			SpanCount:       trace.SpanCount,
 		}
 	}
+	return traces
+}
 
-	response := &models.TraceOverviewResponse{
-		Traces:     traces,
-		TotalCount: clientResponse.TotalCount,
+// annotateTraceOverviews attaches the annotations of the agent's traces to their overviews
+func (s *observabilityManagerService) annotateTraceOverviews(ctx context.Context, agent *models.Agent, traces []models.TraceOverview) error {
+	if agent == nil || len(traces) == 0 {
+		return nil
+	}
+	traceIds := make([]string, len(traces))
+	for i, trace := range traces {
+		traceIds[i] = trace.TraceID
 	}
+	annotations, err := s.listTraceAnnotations(ctx, agent, traceIds)
+	if err != nil {
+		return err
+	}
+	for i := range traces {
+		traces[i].Annotations = annotations[traces[i].TraceID]
+	}
+	return nil
+}
 
-	s.logger.Info("Retrieved traces successfully", "serviceName", req.ServiceName, "totalCount", response.TotalCount)
-	return response, nil
+// listTraceAnnotations returns the annotations of the agent's traces grouped by trace ID
+func (s *observabilityManagerService) listTraceAnnotations(ctx context.Context, agent *models.Agent, traceIds []string) (map[string][]models.TraceAnnotationResponse, error) {
+	annotations, err := s.TraceAnnotationRepository.ListTraceAnnotations(ctx, agent.ID, traceIds)
+	if err != nil {
+		s.logger.Error("Failed to list trace annotations", "agentName", agent.Name, "error", err)
+		return nil, fmt.Errorf("failed to list trace annotations: %w", err)
+	}
+	byTrace := make(map[string][]models.TraceAnnotationResponse)
+	for i := range annotations {
+		byTrace[annotations[i].TraceID] = append(byTrace[annotations[i].TraceID], *toTraceAnnotationResponse(&annotations[i]))
+	}
+	return byTrace, nil
 }
 
-// GetTraceDetails retrieves detailed trace information by trace ID
-func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req TraceDetailsRequest) (*models.TraceResponse, error) {
+// GetTraceDetails retrieves detailed trace information by trace ID, along with the trace's annotations
+func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, userIdpId uuid.UUID, req TraceDetailsRequest) (*models.TraceResponse, error) {
 	s.logger.Info("Getting trace details", "traceId", req.TraceID, "serviceName", req.ServiceName)
 
+	agent, err := s.findAnnotatedAgent(ctx, userIdpId, req.OrgName, req.ProjectName, req.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert service request to client params
 	clientParams := traceobserversvc.TraceDetailsByIdParams{
 		TraceID:     req.TraceID,
@@ -124,27 +318,589 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 	// Convert client response to service model
 	spans := make([]models.Span, len(clientResponse.Spans))
 	for i, span := range clientResponse.Spans {
-		spans[i] = models.Span{
-			TraceID:         span.TraceID,
-			SpanID:          span.SpanID,
-			ParentSpanID:    span.ParentSpanID,
-			Name:            span.Name,
-			Service:         span.Service,
-			Kind:            span.Kind,
-			StartTime:       span.StartTime,
-			EndTime:         span.EndTime,
-			DurationInNanos: span.DurationInNanos,
-			Status:          span.Status,
-			Attributes:      span.Attributes,
-			Resource:        span.Resource,
-		}
+		spans[i] = toSpan(span)
 	}
 
 	response := &models.TraceResponse{
 		Spans:      spans,
 		TotalCount: clientResponse.TotalCount,
 	}
+	if agent != nil {
+		annotations, err := s.listTraceAnnotations(ctx, agent, []string{req.TraceID})
+		if err != nil {
+			return nil, err
+		}
+		response.Annotations = annotations[req.TraceID]
+	}
 
 	s.logger.Info("Retrieved trace details successfully", "traceId", req.TraceID, "spanCount", response.TotalCount)
 	return response, nil
 }
+
+// GetTraceTree retrieves a trace of an agent as a span tree
+func (s *observabilityManagerService) GetTraceTree(ctx context.Context, userIdpId uuid.UUID, req TraceTreeRequest) (*models.TraceTreeResponse, error) {
+	s.logger.Info("Getting trace tree", "traceId", req.TraceID, "agentName", req.AgentName, "environment", req.Environment)
+
+	agent, err := s.findAgent(ctx, userIdpId, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		return nil, err
+	}
+	componentUID, environmentUID, err := s.resolveTraceUIDs(ctx, req.AgentTraceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	clientResponse, err := s.TraceObserverClient.GetTraceTree(ctx, traceobserversvc.TraceTreeParams{
+		TraceID:        req.TraceID,
+		ComponentUID:   componentUID,
+		EnvironmentUID: environmentUID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get trace tree", "traceId", req.TraceID, "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get trace tree: %w", err)
+	}
+
+	annotations, err := s.listTraceAnnotations(ctx, agent, []string{req.TraceID})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Retrieved trace tree successfully", "traceId", req.TraceID, "spanCount", clientResponse.TotalCount)
+	return &models.TraceTreeResponse{
+		TraceID:         clientResponse.TraceID,
+		StartTime:       clientResponse.StartTime,
+		DurationInNanos: clientResponse.DurationInNanos,
+		Roots:           toSpanNodes(clientResponse.Roots),
+		TotalCount:      clientResponse.TotalCount,
+		Annotations:     annotations[req.TraceID],
+	}, nil
+}
+
+// toSpanNodes converts span tree nodes from the trace observer to the service model
+func toSpanNodes(nodes []*traceobserversvc.SpanNode) []*models.SpanNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+	spanNodes := make([]*models.SpanNode, len(nodes))
+	for i, node := range nodes {
+		spanNodes[i] = &models.SpanNode{
+			Span:            toSpan(node.Span),
+			Depth:           node.Depth,
+			OffsetInNanos:   node.OffsetInNanos,
+			SelfTimeInNanos: node.SelfTimeInNanos,
+			CriticalPath:    node.CriticalPath,
+			Orphan:          node.Orphan,
+			Children:        toSpanNodes(node.Children),
+		}
+	}
+	return spanNodes
+}
+
+// toSpan converts a span from the trace observer to the service model
+func toSpan(span traceobserversvc.Span) models.Span {
+	return models.Span{
+		TraceID:         span.TraceID,
+		SpanID:          span.SpanID,
+		ParentSpanID:    span.ParentSpanID,
+		Name:            span.Name,
+		Service:         span.Service,
+		Kind:            span.Kind,
+		StartTime:       span.StartTime,
+		EndTime:         span.EndTime,
+		DurationInNanos: span.DurationInNanos,
+		Status:          span.Status,
+		StatusDetails:   toSpanStatus(span.Status, span.StatusMessage),
+		Attributes:      span.Attributes,
+		Resource:        span.Resource,
+		Events:          toSpanEvents(span.Events),
+		Links:           toSpanLinks(span.Links),
+	}
+}
+
+// toSpanStatus normalizes the status code reported by the trace observer, which is either the
+// numeric OTLP code or its enum name, to UNSET, OK or ERROR
+func toSpanStatus(code string, message string) *models.SpanStatus {
+	if code == "" && message == "" {
+		return nil
+	}
+	status := &models.SpanStatus{Code: "UNSET", Message: message}
+	switch strings.TrimPrefix(strings.ToUpper(code), "STATUS_CODE_") {
+	case "1", "OK":
+		status.Code = "OK"
+	case "2", "ERROR":
+		status.Code = "ERROR"
+	}
+	return status
+}
+
+// toSpanEvents converts span events from the trace observer to the service model
+func toSpanEvents(events []traceobserversvc.SpanEvent) []models.SpanEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	spanEvents := make([]models.SpanEvent, len(events))
+	for i, event := range events {
+		spanEvents[i] = models.SpanEvent{
+			Name:       event.Name,
+			Timestamp:  event.Time,
+			Attributes: event.Attributes,
+		}
+		if event.Exception != nil {
+			spanEvents[i].Exception = &models.SpanException{
+				Type:       event.Exception.Type,
+				Message:    event.Exception.Message,
+				Stacktrace: event.Exception.Stacktrace,
+				Escaped:    event.Exception.Escaped,
+			}
+		}
+	}
+	return spanEvents
+}
+
+// toSpanLinks converts span links from the trace observer to the service model
+func toSpanLinks(links []traceobserversvc.SpanLink) []models.SpanLink {
+	if len(links) == 0 {
+		return nil
+	}
+	spanLinks := make([]models.SpanLink, len(links))
+	for i, link := range links {
+		spanLinks[i] = models.SpanLink{
+			TraceID:    link.TraceID,
+			SpanID:     link.SpanID,
+			TraceState: link.TraceState,
+			Attributes: link.Attributes,
+		}
+	}
+	return spanLinks
+}
+
+// ListSessions retrieves the conversations of an agent in an environment, most recently active first
+func (s *observabilityManagerService) ListSessions(ctx context.Context, userIdpId uuid.UUID, req ListSessionsRequest) (*models.SessionOverviewResponse, error) {
+	s.logger.Info("Listing sessions", "agentName", req.AgentName, "environment", req.Environment, "limit", req.Limit, "offset", req.Offset)
+
+	componentUID, environmentUID, err := s.resolveAgentTraceScope(ctx, userIdpId, req.AgentTraceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	clientResponse, err := s.TraceObserverClient.ListSessions(ctx, traceobserversvc.ListSessionsParams{
+		ComponentUID:   componentUID,
+		EnvironmentUID: environmentUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list sessions", "agentName", req.AgentName, "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	// Convert client response to service model
+	sessions := make([]models.SessionOverview, len(clientResponse.Sessions))
+	for i, session := range clientResponse.Sessions {
+		sessions[i] = models.SessionOverview{
+			SessionID:       session.SessionID,
+			TurnCount:       session.TurnCount,
+			SpanCount:       session.SpanCount,
+			StartTime:       session.StartTime,
+			EndTime:         session.EndTime,
+			DurationInNanos: session.DurationInNanos,
+			InputTokens:     session.InputTokens,
+			OutputTokens:    session.OutputTokens,
+			TotalTokens:     session.TotalTokens,
+			Truncated:       session.Truncated,
+		}
+	}
+
+	s.logger.Info("Retrieved sessions successfully", "agentName", req.AgentName, "totalCount", clientResponse.TotalCount)
+	return &models.SessionOverviewResponse{
+		Sessions:   sessions,
+		TotalCount: clientResponse.TotalCount,
+	}, nil
+}
+
+// GetSessionTraces retrieves the traces of a session in the order the turns happened
+func (s *observabilityManagerService) GetSessionTraces(ctx context.Context, userIdpId uuid.UUID, req SessionTracesRequest) (*models.SessionTracesResponse, error) {
+	s.logger.Info("Getting session traces", "sessionId", req.SessionID, "agentName", req.AgentName, "environment", req.Environment)
+
+	componentUID, environmentUID, err := s.resolveAgentTraceScope(ctx, userIdpId, req.AgentTraceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	clientResponse, err := s.TraceObserverClient.GetSessionTraces(ctx, traceobserversvc.SessionTracesParams{
+		SessionID:      req.SessionID,
+		ComponentUID:   componentUID,
+		EnvironmentUID: environmentUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get session traces", "sessionId", req.SessionID, "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get session traces: %w", err)
+	}
+
+	traces := toTraceOverviews(clientResponse.Traces)
+
+	s.logger.Info("Retrieved session traces successfully", "sessionId", req.SessionID, "traceCount", len(traces))
+	return &models.SessionTracesResponse{
+		SessionID:  clientResponse.SessionID,
+		Traces:     traces,
+		TotalCount: clientResponse.TotalCount,
+		Truncated:  clientResponse.Truncated,
+	}, nil
+}
+
+// ExportTrace retrieves a trace in OTLP JSON or Jaeger format
+func (s *observabilityManagerService) ExportTrace(ctx context.Context, userIdpId uuid.UUID, req ExportTraceRequest) (json.RawMessage, error) {
+	s.logger.Info("Exporting trace", "traceId", req.TraceID, "agentName", req.AgentName, "environment", req.Environment, "format", req.Format)
+
+	componentUID, environmentUID, err := s.resolveAgentTraceScope(ctx, userIdpId, req.AgentTraceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	exported, err := s.TraceObserverClient.ExportTrace(ctx, traceobserversvc.ExportTraceParams{
+		TraceID:        req.TraceID,
+		ComponentUID:   componentUID,
+		EnvironmentUID: environmentUID,
+		Format:         req.Format,
+	})
+	if err != nil {
+		s.logger.Error("Failed to export trace", "traceId", req.TraceID, "agentName", req.AgentName, "error", err)
+		if errors.Is(err, utils.ErrTraceNotFound) {
+			return nil, utils.ErrTraceNotFound
+		}
+		return nil, fmt.Errorf("failed to export trace: %w", err)
+	}
+
+	return exported, nil
+}
+
+// ExportTraces opens an NDJSON stream of the agent's traces in a time range. The caller must close the stream.
+func (s *observabilityManagerService) ExportTraces(ctx context.Context, userIdpId uuid.UUID, req ExportTracesRequest) (io.ReadCloser, error) {
+	s.logger.Info("Exporting traces", "agentName", req.AgentName, "environment", req.Environment, "startTime", req.StartTime, "endTime", req.EndTime, "format", req.Format)
+
+	componentUID, environmentUID, err := s.resolveAgentTraceScope(ctx, userIdpId, req.AgentTraceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.TraceObserverClient.ExportTraces(ctx, traceobserversvc.ExportTracesParams{
+		ComponentUID:   componentUID,
+		EnvironmentUID: environmentUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Format:         req.Format,
+	})
+	if err != nil {
+		s.logger.Error("Failed to export traces", "agentName", req.AgentName, "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to export traces: %w", err)
+	}
+
+	return stream, nil
+}
+
+// TailTraces opens a server-sent event stream of the agent's new traces. The stream ends when ctx is done and the caller must close it.
+func (s *observabilityManagerService) TailTraces(ctx context.Context, userIdpId uuid.UUID, req TailTracesRequest) (io.ReadCloser, error) {
+	s.logger.Info("Tailing traces", "agentName", req.AgentName, "environment", req.Environment, "attributes", req.Attributes)
+
+	componentUID, environmentUID, err := s.resolveAgentTraceScope(ctx, userIdpId, req.AgentTraceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.TraceObserverClient.TailTraces(ctx, traceobserversvc.TailTracesParams{
+		ComponentUID:   componentUID,
+		EnvironmentUID: environmentUID,
+		Attributes:     req.Attributes,
+	})
+	if err != nil {
+		s.logger.Error("Failed to tail traces", "agentName", req.AgentName, "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to tail traces: %w", err)
+	}
+
+	return stream, nil
+}
+
+// resolveAgentTraceScope validates the agent and returns the component and environment UIDs its traces are stamped with
+func (s *observabilityManagerService) resolveAgentTraceScope(ctx context.Context, userIdpId uuid.UUID, scope AgentTraceScope) (string, string, error) {
+	if _, err := s.findAgent(ctx, userIdpId, scope.OrgName, scope.ProjectName, scope.AgentName); err != nil {
+		return "", "", err
+	}
+	return s.resolveTraceUIDs(ctx, scope)
+}
+
+// findAgent validates the organization and project and returns the agent
+func (s *observabilityManagerService) findAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) (*models.Agent, error) {
+	// Validate organization exists
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgName", orgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", agentName, "projectName", projectName, "orgName", orgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	return agent, nil
+}
+
+// findAnnotatedAgent returns the agent whose trace annotations are attached to trace listings. Traces are
+// looked up by service name, so a service that is not registered as an agent has no annotations and nil is returned.
+func (s *observabilityManagerService) findAnnotatedAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) (*models.Agent, error) {
+	agent, err := s.findAgent(ctx, userIdpId, orgName, projectName, agentName)
+	if errors.Is(err, utils.ErrAgentNotFound) {
+		return nil, nil
+	}
+	return agent, err
+}
+
+// resolveTraceUIDs returns the OpenChoreo component and environment UIDs the agent's traces are stamped with
+func (s *observabilityManagerService) resolveTraceUIDs(ctx context.Context, scope AgentTraceScope) (string, string, error) {
+	agentComponent, err := s.OpenChoreoSvcClient.GetAgentComponent(ctx, scope.OrgName, scope.ProjectName, scope.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from OpenChoreo", "agentName", scope.AgentName, "projectName", scope.ProjectName, "orgName", scope.OrgName, "error", err)
+		return "", "", fmt.Errorf("failed to fetch agent from oc: %w", err)
+	}
+	environment, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, scope.OrgName, scope.Environment)
+	if err != nil {
+		s.logger.Error("Failed to validate environment", "environment", scope.Environment, "orgName", scope.OrgName, "error", err)
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			return "", "", utils.ErrEnvironmentNotFound
+		}
+		return "", "", fmt.Errorf("failed to get environment %s: %w", scope.Environment, err)
+	}
+	return agentComponent.UUID, environment.UUID, nil
+}
+
+// usageScope holds the OpenChoreo UIDs a usage report is filtered on and the names they resolve to
+type usageScope struct {
+	projectUIDs   []string
+	componentUIDs []string
+	projectNames  map[string]string
+	agentNames    map[string]string
+}
+
+// GetUsageReport rolls up GenAI token usage and estimated cost for an org, project or agent
+func (s *observabilityManagerService) GetUsageReport(ctx context.Context, userIdpId uuid.UUID, req UsageReportRequest) (*models.UsageReportResponse, error) {
+	s.logger.Info("Getting usage report", "orgName", req.OrgName, "projectName", req.ProjectName, "agentName", req.AgentName, "groupBy", req.GroupBy, "userIdpId", userIdpId)
+	// Validate organization exists
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, req.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", req.OrgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", req.OrgName, err)
+	}
+
+	scope, err := s.resolveUsageScope(ctx, org.ID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := []traceobserversvc.TokenUsage{}
+	if len(scope.projectUIDs) > 0 || len(scope.componentUIDs) > 0 {
+		clientResponse, err := s.TraceObserverClient.GetTokenUsage(ctx, traceobserversvc.TokenUsageParams{
+			ProjectUIDs:   scope.projectUIDs,
+			ComponentUIDs: scope.componentUIDs,
+			StartTime:     req.StartTime,
+			EndTime:       req.EndTime,
+		})
+		if err != nil {
+			s.logger.Error("Failed to get token usage", "orgName", req.OrgName, "projectName", req.ProjectName, "agentName", req.AgentName, "error", err)
+			return nil, fmt.Errorf("failed to get token usage: %w", err)
+		}
+		usage = clientResponse.Usage
+	}
+
+	response := buildUsageReport(req, scope, usage, config.GetConfig().TokenPricing)
+	s.logger.Info("Built usage report successfully", "orgName", req.OrgName, "itemCount", len(response.Items), "totalTokens", response.Total.TotalTokens)
+	return response, nil
+}
+
+func (s *observabilityManagerService) resolveUsageScope(ctx context.Context, orgID uuid.UUID, req UsageReportRequest) (*usageScope, error) {
+	scope := &usageScope{
+		projectNames: map[string]string{},
+		agentNames:   map[string]string{},
+	}
+
+	// Org wide report - include every project of the organization
+	if req.ProjectName == "" {
+		projects, err := s.OpenChoreoSvcClient.ListProjects(ctx, req.OrgName)
+		if err != nil {
+			s.logger.Error("Failed to list projects", "orgName", req.OrgName, "error", err)
+			return nil, fmt.Errorf("failed to list projects for organization %s: %w", req.OrgName, err)
+		}
+		for _, project := range projects {
+			scope.projectUIDs = append(scope.projectUIDs, project.UUID)
+			scope.projectNames[project.UUID] = project.Name
+			if err := s.addAgentNames(ctx, scope, req.OrgName, project.Name); err != nil {
+				return nil, err
+			}
+		}
+		return scope, nil
+	}
+
+	project, err := s.ProjectRepository.GetProjectByName(ctx, orgID, req.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", req.ProjectName, "orgName", req.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", req.ProjectName, err)
+	}
+	ocProject, err := s.OpenChoreoSvcClient.GetProject(ctx, req.ProjectName, req.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to fetch project from OpenChoreo", "projectName", req.ProjectName, "orgName", req.OrgName, "error", err)
+		return nil, fmt.Errorf("failed to get project %s: %w", req.ProjectName, err)
+	}
+	scope.projectNames[ocProject.UUID] = ocProject.Name
+
+	// Project wide report
+	if req.AgentName == "" {
+		scope.projectUIDs = []string{ocProject.UUID}
+		if err := s.addAgentNames(ctx, scope, req.OrgName, req.ProjectName); err != nil {
+			return nil, err
+		}
+		return scope, nil
+	}
+
+	// Single agent report
+	if _, err := s.AgentRepository.GetAgentByName(ctx, orgID, project.ID, req.AgentName); err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", req.AgentName, "projectName", req.ProjectName, "orgName", req.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	agentComponent, err := s.OpenChoreoSvcClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from OpenChoreo", "agentName", req.AgentName, "projectName", req.ProjectName, "orgName", req.OrgName, "error", err)
+		return nil, fmt.Errorf("failed to fetch agent from oc: %w", err)
+	}
+	scope.componentUIDs = []string{agentComponent.UUID}
+	scope.agentNames[agentComponent.UUID] = agentComponent.Name
+	return scope, nil
+}
+
+func (s *observabilityManagerService) addAgentNames(ctx context.Context, scope *usageScope, orgName string, projectName string) error {
+	agents, err := s.OpenChoreoSvcClient.ListAgentComponents(ctx, orgName, projectName)
+	if err != nil {
+		s.logger.Error("Failed to list agents", "orgName", orgName, "projectName", projectName, "error", err)
+		return fmt.Errorf("failed to list agents for project %s: %w", projectName, err)
+	}
+	for _, agent := range agents {
+		scope.agentNames[agent.UUID] = agent.Name
+	}
+	return nil
+}
+
+// buildUsageReport groups token usage by the requested dimensions and applies model pricing
+func buildUsageReport(req UsageReportRequest, scope *usageScope, usage []traceobserversvc.TokenUsage, pricing config.TokenPricingConfig) *models.UsageReportResponse {
+	groupBy := make([]string, len(req.GroupBy))
+	for i, dimension := range req.GroupBy {
+		groupBy[i] = string(dimension)
+	}
+
+	items := map[string]*models.UsageReportItem{}
+	unpriced := map[string]bool{}
+	total := models.UsageReportItem{}
+	for _, entry := range usage {
+		cost, priced := estimateTokenCost(pricing, entry.Model, entry.InputTokens, entry.OutputTokens)
+		if !priced && entry.Model != "" && entry.TotalTokens > 0 {
+			unpriced[entry.Model] = true
+		}
+
+		item := models.UsageReportItem{}
+		for _, dimension := range req.GroupBy {
+			switch dimension {
+			case utils.UsageGroupByOrg:
+				item.OrgName = req.OrgName
+			case utils.UsageGroupByProject:
+				item.ProjectName = lookupName(scope.projectNames, entry.ProjectUID)
+			case utils.UsageGroupByAgent:
+				item.AgentName = lookupName(scope.agentNames, entry.ComponentUID)
+			case utils.UsageGroupByModel:
+				item.Model = entry.Model
+			}
+		}
+		key := strings.Join([]string{item.OrgName, item.ProjectName, item.AgentName, item.Model}, "\x00")
+		existing, ok := items[key]
+		if !ok {
+			existing = &item
+			items[key] = existing
+		}
+		existing.InputTokens += entry.InputTokens
+		existing.OutputTokens += entry.OutputTokens
+		existing.TotalTokens += entry.TotalTokens
+		existing.EstimatedCost += cost
+
+		total.InputTokens += entry.InputTokens
+		total.OutputTokens += entry.OutputTokens
+		total.TotalTokens += entry.TotalTokens
+		total.EstimatedCost += cost
+	}
+
+	reportItems := make([]models.UsageReportItem, 0, len(items))
+	for _, item := range items {
+		reportItems = append(reportItems, *item)
+	}
+	// Highest spend first, then by total tokens for unpriced models
+	sort.Slice(reportItems, func(i, j int) bool {
+		if reportItems[i].EstimatedCost != reportItems[j].EstimatedCost {
+			return reportItems[i].EstimatedCost > reportItems[j].EstimatedCost
+		}
+		return reportItems[i].TotalTokens > reportItems[j].TotalTokens
+	})
+
+	unpricedModels := make([]string, 0, len(unpriced))
+	for model := range unpriced {
+		unpricedModels = append(unpricedModels, model)
+	}
+	sort.Strings(unpricedModels)
+
+	return &models.UsageReportResponse{
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		GroupBy:        groupBy,
+		Currency:       pricing.Currency,
+		Items:          reportItems,
+		Total:          total,
+		UnpricedModels: unpricedModels,
+	}
+}
+
+// estimateTokenCost returns the cost of the given tokens and whether a price is configured for the model
+func estimateTokenCost(pricing config.TokenPricingConfig, model string, inputTokens int64, outputTokens int64) (float64, bool) {
+	price, ok := pricing.Models[model]
+	if !ok {
+		return 0, false
+	}
+	return float64(inputTokens)/1_000_000*price.InputPerMillion + float64(outputTokens)/1_000_000*price.OutputPerMillion, true
+}
+
+// lookupName resolves a UID to a resource name, falling back to the UID for deleted resources
+func lookupName(names map[string]string, uid string) string {
+	if name, ok := names[uid]; ok {
+		return name
+	}
+	return uid
+}
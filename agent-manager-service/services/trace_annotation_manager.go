@@ -0,0 +1,211 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type TraceAnnotationManagerService interface {
+	CreateTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, req models.TraceAnnotationRequest) (*models.TraceAnnotationResponse, error)
+	ListTraceAnnotations(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, spanId string) (*models.TraceAnnotationListResponse, error)
+	UpdateTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, annotationId uuid.UUID, req models.TraceAnnotationRequest) (*models.TraceAnnotationResponse, error)
+	DeleteTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, annotationId uuid.UUID) error
+}
+
+type traceAnnotationManagerService struct {
+	OrganizationRepository    repositories.OrganizationRepository
+	ProjectRepository         repositories.ProjectRepository
+	AgentRepository           repositories.AgentRepository
+	TraceAnnotationRepository repositories.TraceAnnotationRepository
+	logger                    *slog.Logger
+}
+
+func NewTraceAnnotationManagerService(
+	orgRepo repositories.OrganizationRepository,
+	projRepo repositories.ProjectRepository,
+	agentRepo repositories.AgentRepository,
+	traceAnnotationRepo repositories.TraceAnnotationRepository,
+	logger *slog.Logger,
+) TraceAnnotationManagerService {
+	return &traceAnnotationManagerService{
+		OrganizationRepository:    orgRepo,
+		ProjectRepository:         projRepo,
+		AgentRepository:           agentRepo,
+		TraceAnnotationRepository: traceAnnotationRepo,
+		logger:                    logger,
+	}
+}
+
+// CreateTraceAnnotation records a reviewer's score, label and comment for a trace or one of its spans
+func (s *traceAnnotationManagerService) CreateTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, req models.TraceAnnotationRequest) (*models.TraceAnnotationResponse, error) {
+	s.logger.Info("Creating trace annotation", "traceId", traceId, "spanId", req.SpanID, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName)
+	dbAgent, err := s.getAgent(ctx, userIdpId, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	annotation := &models.TraceAnnotation{
+		ID:        uuid.New(),
+		AgentID:   dbAgent.ID,
+		TraceID:   traceId,
+		SpanID:    req.SpanID,
+		Score:     req.Score,
+		Label:     req.Label,
+		Comment:   req.Comment,
+		CreatedBy: userIdpId,
+	}
+	if err := s.TraceAnnotationRepository.CreateTraceAnnotation(ctx, annotation); err != nil {
+		s.logger.Error("Failed to store trace annotation", "traceId", traceId, "agentName", agent.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to store trace annotation: %w", err)
+	}
+
+	s.logger.Info("Created trace annotation successfully", "annotationId", annotation.ID, "traceId", traceId)
+	return toTraceAnnotationResponse(annotation), nil
+}
+
+// ListTraceAnnotations lists the annotations of a trace, optionally limited to a span
+func (s *traceAnnotationManagerService) ListTraceAnnotations(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, spanId string) (*models.TraceAnnotationListResponse, error) {
+	s.logger.Info("Listing trace annotations", "traceId", traceId, "spanId", spanId, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName)
+	dbAgent, err := s.getAgent(ctx, userIdpId, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := s.TraceAnnotationRepository.ListTraceAnnotations(ctx, dbAgent.ID, []string{traceId})
+	if err != nil {
+		s.logger.Error("Failed to list trace annotations", "traceId", traceId, "agentName", agent.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to list trace annotations: %w", err)
+	}
+
+	response := &models.TraceAnnotationListResponse{
+		Annotations: make([]models.TraceAnnotationResponse, 0, len(annotations)),
+	}
+	for i := range annotations {
+		if spanId != "" && annotations[i].SpanID != spanId {
+			continue
+		}
+		response.Annotations = append(response.Annotations, *toTraceAnnotationResponse(&annotations[i]))
+	}
+	response.Total = len(response.Annotations)
+	return response, nil
+}
+
+// UpdateTraceAnnotation replaces the score, label and comment of an annotation. The annotated span cannot be changed.
+func (s *traceAnnotationManagerService) UpdateTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, annotationId uuid.UUID, req models.TraceAnnotationRequest) (*models.TraceAnnotationResponse, error) {
+	s.logger.Info("Updating trace annotation", "annotationId", annotationId, "traceId", traceId, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName)
+	annotation, err := s.getTraceAnnotation(ctx, userIdpId, agent, traceId, annotationId)
+	if err != nil {
+		return nil, err
+	}
+
+	annotation.Score = req.Score
+	annotation.Label = req.Label
+	annotation.Comment = req.Comment
+	if err := s.TraceAnnotationRepository.UpdateTraceAnnotation(ctx, annotation); err != nil {
+		s.logger.Error("Failed to update trace annotation", "annotationId", annotationId, "error", err)
+		return nil, fmt.Errorf("failed to update trace annotation: %w", err)
+	}
+
+	// Read back the annotation to pick up the new update time
+	updated, err := s.TraceAnnotationRepository.GetTraceAnnotation(ctx, annotation.AgentID, traceId, annotationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trace annotation: %w", err)
+	}
+	return toTraceAnnotationResponse(updated), nil
+}
+
+func (s *traceAnnotationManagerService) DeleteTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, annotationId uuid.UUID) error {
+	s.logger.Info("Deleting trace annotation", "annotationId", annotationId, "traceId", traceId, "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName)
+	annotation, err := s.getTraceAnnotation(ctx, userIdpId, agent, traceId, annotationId)
+	if err != nil {
+		return err
+	}
+	if err := s.TraceAnnotationRepository.DeleteTraceAnnotation(ctx, annotation.ID); err != nil {
+		s.logger.Error("Failed to delete trace annotation", "annotationId", annotationId, "error", err)
+		return fmt.Errorf("failed to delete trace annotation: %w", err)
+	}
+	return nil
+}
+
+// getAgent validates the organization and project and returns the agent
+func (s *traceAnnotationManagerService) getAgent(ctx context.Context, userIdpId uuid.UUID, agent AgentRef) (*models.Agent, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, agent.OrgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", agent.OrgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", agent.OrgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, agent.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", agent.ProjectName, err)
+	}
+	dbAgent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agent.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent", "agentName", agent.AgentName, "projectName", agent.ProjectName, "orgName", agent.OrgName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	return dbAgent, nil
+}
+
+// getTraceAnnotation returns an annotation of a trace of the agent
+func (s *traceAnnotationManagerService) getTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, agent AgentRef, traceId string, annotationId uuid.UUID) (*models.TraceAnnotation, error) {
+	dbAgent, err := s.getAgent(ctx, userIdpId, agent)
+	if err != nil {
+		return nil, err
+	}
+	annotation, err := s.TraceAnnotationRepository.GetTraceAnnotation(ctx, dbAgent.ID, traceId, annotationId)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrTraceAnnotationNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch trace annotation: %w", err)
+	}
+	return annotation, nil
+}
+
+func toTraceAnnotationResponse(annotation *models.TraceAnnotation) *models.TraceAnnotationResponse {
+	return &models.TraceAnnotationResponse{
+		ID:        annotation.ID.String(),
+		TraceID:   annotation.TraceID,
+		SpanID:    annotation.SpanID,
+		Score:     annotation.Score,
+		Label:     annotation.Label,
+		Comment:   annotation.Comment,
+		Author:    annotation.CreatedBy.String(),
+		CreatedAt: annotation.CreatedAt,
+		UpdatedAt: annotation.UpdatedAt,
+	}
+}
@@ -23,6 +23,8 @@ type ConfigurationItem struct {
 	Key string `json:"key"`
 	// Configuration value
 	Value string `json:"value"`
+	// Where the value comes from, base for the runtime configuration of the agent and override for an override of the environment
+	Source *string `json:"source,omitempty"`
 }
 
 // NewConfigurationItem instantiates a new ConfigurationItem object
@@ -92,6 +94,38 @@ func (o *ConfigurationItem) SetValue(v string) {
 	o.Value = v
 }
 
+// GetSource returns the Source field value if set, zero value otherwise.
+func (o *ConfigurationItem) GetSource() string {
+	if o == nil || IsNil(o.Source) {
+		var ret string
+		return ret
+	}
+	return *o.Source
+}
+
+// GetSourceOk returns a tuple with the Source field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ConfigurationItem) GetSourceOk() (*string, bool) {
+	if o == nil || IsNil(o.Source) {
+		return nil, false
+	}
+	return o.Source, true
+}
+
+// HasSource returns a boolean if a field has been set.
+func (o *ConfigurationItem) HasSource() bool {
+	if o != nil && !IsNil(o.Source) {
+		return true
+	}
+
+	return false
+}
+
+// SetSource gets a reference to the given string and assigns it to the Source field.
+func (o *ConfigurationItem) SetSource(v string) {
+	o.Source = &v
+}
+
 func (o ConfigurationItem) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -104,6 +138,9 @@ func (o ConfigurationItem) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["key"] = o.Key
 	toSerialize["value"] = o.Value
+	if !IsNil(o.Source) {
+		toSerialize["source"] = o.Source
+	}
 	return toSerialize, nil
 }
 
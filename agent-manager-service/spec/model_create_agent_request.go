@@ -29,6 +29,10 @@ type CreateAgentRequest struct {
 	AgentType      AgentType             `json:"agentType"`
 	RuntimeConfigs *RuntimeConfiguration `json:"runtimeConfigs,omitempty"`
 	InputInterface *InputInterface       `json:"inputInterface,omitempty"`
+	AgentCard      *AgentCard            `json:"agentCard,omitempty"`
+	JobConfig      *JobConfig            `json:"jobConfig,omitempty"`
+	// Endpoints the agent exposes in addition to the one described by its input interface
+	Endpoints []AgentEndpoint `json:"endpoints,omitempty"`
 }
 
 // NewCreateAgentRequest instantiates a new CreateAgentRequest object
@@ -244,6 +248,102 @@ func (o *CreateAgentRequest) SetInputInterface(v InputInterface) {
 	o.InputInterface = &v
 }
 
+// GetAgentCard returns the AgentCard field value if set, zero value otherwise.
+func (o *CreateAgentRequest) GetAgentCard() AgentCard {
+	if o == nil || IsNil(o.AgentCard) {
+		var ret AgentCard
+		return ret
+	}
+	return *o.AgentCard
+}
+
+// GetAgentCardOk returns a tuple with the AgentCard field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateAgentRequest) GetAgentCardOk() (*AgentCard, bool) {
+	if o == nil || IsNil(o.AgentCard) {
+		return nil, false
+	}
+	return o.AgentCard, true
+}
+
+// HasAgentCard returns a boolean if a field has been set.
+func (o *CreateAgentRequest) HasAgentCard() bool {
+	if o != nil && !IsNil(o.AgentCard) {
+		return true
+	}
+
+	return false
+}
+
+// SetAgentCard gets a reference to the given AgentCard and assigns it to the AgentCard field.
+func (o *CreateAgentRequest) SetAgentCard(v AgentCard) {
+	o.AgentCard = &v
+}
+
+// GetJobConfig returns the JobConfig field value if set, zero value otherwise.
+func (o *CreateAgentRequest) GetJobConfig() JobConfig {
+	if o == nil || IsNil(o.JobConfig) {
+		var ret JobConfig
+		return ret
+	}
+	return *o.JobConfig
+}
+
+// GetJobConfigOk returns a tuple with the JobConfig field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateAgentRequest) GetJobConfigOk() (*JobConfig, bool) {
+	if o == nil || IsNil(o.JobConfig) {
+		return nil, false
+	}
+	return o.JobConfig, true
+}
+
+// HasJobConfig returns a boolean if a field has been set.
+func (o *CreateAgentRequest) HasJobConfig() bool {
+	if o != nil && !IsNil(o.JobConfig) {
+		return true
+	}
+
+	return false
+}
+
+// SetJobConfig gets a reference to the given JobConfig and assigns it to the JobConfig field.
+func (o *CreateAgentRequest) SetJobConfig(v JobConfig) {
+	o.JobConfig = &v
+}
+
+// GetEndpoints returns the Endpoints field value if set, zero value otherwise.
+func (o *CreateAgentRequest) GetEndpoints() []AgentEndpoint {
+	if o == nil || IsNil(o.Endpoints) {
+		var ret []AgentEndpoint
+		return ret
+	}
+	return o.Endpoints
+}
+
+// GetEndpointsOk returns a tuple with the Endpoints field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateAgentRequest) GetEndpointsOk() ([]AgentEndpoint, bool) {
+	if o == nil || IsNil(o.Endpoints) {
+		return nil, false
+	}
+	return o.Endpoints, true
+}
+
+// HasEndpoints returns a boolean if a field has been set.
+func (o *CreateAgentRequest) HasEndpoints() bool {
+	if o != nil && !IsNil(o.Endpoints) {
+		return true
+	}
+
+	return false
+}
+
+// SetEndpoints gets a reference to the given []AgentEndpoint and assigns it to the Endpoints field.
+func (o *CreateAgentRequest) SetEndpoints(v []AgentEndpoint) {
+	o.Endpoints = v
+}
+
 func (o CreateAgentRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -267,6 +367,15 @@ func (o CreateAgentRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.InputInterface) {
 		toSerialize["inputInterface"] = o.InputInterface
 	}
+	if !IsNil(o.AgentCard) {
+		toSerialize["agentCard"] = o.AgentCard
+	}
+	if !IsNil(o.JobConfig) {
+		toSerialize["jobConfig"] = o.JobConfig
+	}
+	if !IsNil(o.Endpoints) {
+		toSerialize["endpoints"] = o.Endpoints
+	}
 	return toSerialize, nil
 }
 
@@ -22,7 +22,11 @@ type DeployAgentRequest struct {
 	// Container image ID to deploy
 	ImageId string `json:"imageId"`
 	// Environment variables
-	Env []EnvironmentVariable `json:"env,omitempty"`
+	Env       []EnvironmentVariable `json:"env,omitempty"`
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+	// Fixed number of replicas, for agents that are not autoscaled
+	Replicas    *int32             `json:"replicas,omitempty"`
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
 }
 
 // NewDeployAgentRequest instantiates a new DeployAgentRequest object
@@ -99,6 +103,102 @@ func (o *DeployAgentRequest) SetEnv(v []EnvironmentVariable) {
 	o.Env = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *DeployAgentRequest) GetResources() ResourceRequirements {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceRequirements
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeployAgentRequest) GetResourcesOk() (*ResourceRequirements, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *DeployAgentRequest) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceRequirements and assigns it to the Resources field.
+func (o *DeployAgentRequest) SetResources(v ResourceRequirements) {
+	o.Resources = &v
+}
+
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *DeployAgentRequest) GetReplicas() int32 {
+	if o == nil || IsNil(o.Replicas) {
+		var ret int32
+		return ret
+	}
+	return *o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeployAgentRequest) GetReplicasOk() (*int32, bool) {
+	if o == nil || IsNil(o.Replicas) {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *DeployAgentRequest) HasReplicas() bool {
+	if o != nil && !IsNil(o.Replicas) {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given int32 and assigns it to the Replicas field.
+func (o *DeployAgentRequest) SetReplicas(v int32) {
+	o.Replicas = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *DeployAgentRequest) GetAutoscaling() AutoscalingConfig {
+	if o == nil || IsNil(o.Autoscaling) {
+		var ret AutoscalingConfig
+		return ret
+	}
+	return *o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeployAgentRequest) GetAutoscalingOk() (*AutoscalingConfig, bool) {
+	if o == nil || IsNil(o.Autoscaling) {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *DeployAgentRequest) HasAutoscaling() bool {
+	if o != nil && !IsNil(o.Autoscaling) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given AutoscalingConfig and assigns it to the Autoscaling field.
+func (o *DeployAgentRequest) SetAutoscaling(v AutoscalingConfig) {
+	o.Autoscaling = &v
+}
+
 func (o DeployAgentRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -113,6 +213,15 @@ func (o DeployAgentRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Env) {
 		toSerialize["env"] = o.Env
 	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Replicas) {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if !IsNil(o.Autoscaling) {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
 	return toSerialize, nil
 }
 
@@ -31,6 +31,11 @@ type DeploymentDetailsResponse struct {
 	// Environment display name
 	EnvironmentDisplayName     *string                                              `json:"environmentDisplayName,omitempty"`
 	PromotionTargetEnvironment *DeploymentDetailsResponsePromotionTargetEnvironment `json:"promotionTargetEnvironment,omitempty"`
+	Queue                      *DeploymentQueueStatus                               `json:"queue,omitempty"`
+	Resources                  *ResourceRequirements                                `json:"resources,omitempty"`
+	// Number of replicas the agent runs when it is not autoscaled
+	Replicas    *int32             `json:"replicas,omitempty"`
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
 }
 
 // NewDeploymentDetailsResponse instantiates a new DeploymentDetailsResponse object
@@ -214,6 +219,134 @@ func (o *DeploymentDetailsResponse) SetPromotionTargetEnvironment(v DeploymentDe
 	o.PromotionTargetEnvironment = &v
 }
 
+// GetQueue returns the Queue field value if set, zero value otherwise.
+func (o *DeploymentDetailsResponse) GetQueue() DeploymentQueueStatus {
+	if o == nil || IsNil(o.Queue) {
+		var ret DeploymentQueueStatus
+		return ret
+	}
+	return *o.Queue
+}
+
+// GetQueueOk returns a tuple with the Queue field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentDetailsResponse) GetQueueOk() (*DeploymentQueueStatus, bool) {
+	if o == nil || IsNil(o.Queue) {
+		return nil, false
+	}
+	return o.Queue, true
+}
+
+// HasQueue returns a boolean if a field has been set.
+func (o *DeploymentDetailsResponse) HasQueue() bool {
+	if o != nil && !IsNil(o.Queue) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueue gets a reference to the given DeploymentQueueStatus and assigns it to the Queue field.
+func (o *DeploymentDetailsResponse) SetQueue(v DeploymentQueueStatus) {
+	o.Queue = &v
+}
+
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *DeploymentDetailsResponse) GetResources() ResourceRequirements {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceRequirements
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentDetailsResponse) GetResourcesOk() (*ResourceRequirements, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *DeploymentDetailsResponse) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceRequirements and assigns it to the Resources field.
+func (o *DeploymentDetailsResponse) SetResources(v ResourceRequirements) {
+	o.Resources = &v
+}
+
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *DeploymentDetailsResponse) GetReplicas() int32 {
+	if o == nil || IsNil(o.Replicas) {
+		var ret int32
+		return ret
+	}
+	return *o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentDetailsResponse) GetReplicasOk() (*int32, bool) {
+	if o == nil || IsNil(o.Replicas) {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *DeploymentDetailsResponse) HasReplicas() bool {
+	if o != nil && !IsNil(o.Replicas) {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given int32 and assigns it to the Replicas field.
+func (o *DeploymentDetailsResponse) SetReplicas(v int32) {
+	o.Replicas = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *DeploymentDetailsResponse) GetAutoscaling() AutoscalingConfig {
+	if o == nil || IsNil(o.Autoscaling) {
+		var ret AutoscalingConfig
+		return ret
+	}
+	return *o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentDetailsResponse) GetAutoscalingOk() (*AutoscalingConfig, bool) {
+	if o == nil || IsNil(o.Autoscaling) {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *DeploymentDetailsResponse) HasAutoscaling() bool {
+	if o != nil && !IsNil(o.Autoscaling) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given AutoscalingConfig and assigns it to the Autoscaling field.
+func (o *DeploymentDetailsResponse) SetAutoscaling(v AutoscalingConfig) {
+	o.Autoscaling = &v
+}
+
 func (o DeploymentDetailsResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -234,6 +367,18 @@ func (o DeploymentDetailsResponse) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.PromotionTargetEnvironment) {
 		toSerialize["promotionTargetEnvironment"] = o.PromotionTargetEnvironment
 	}
+	if !IsNil(o.Queue) {
+		toSerialize["queue"] = o.Queue
+	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Replicas) {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if !IsNil(o.Autoscaling) {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
 	return toSerialize, nil
 }
 
@@ -22,10 +22,13 @@ type EndpointConfiguration struct {
 	// URL of the endpoint
 	Url string `json:"url"`
 	// Name of the endpoint
-	EndpointName string         `json:"endpointName"`
-	Schema       EndpointSchema `json:"schema"`
+	EndpointName string `json:"endpointName"`
+	// Protocol the endpoint is served over
+	Type   *string        `json:"type,omitempty"`
+	Schema EndpointSchema `json:"schema"`
 	// Visibility level of the endpoint
-	Visibility string `json:"visibility"`
+	Visibility string            `json:"visibility"`
+	Mcp        *MCPServerDetails `json:"mcp,omitempty"`
 }
 
 // NewEndpointConfiguration instantiates a new EndpointConfiguration object
@@ -97,6 +100,38 @@ func (o *EndpointConfiguration) SetEndpointName(v string) {
 	o.EndpointName = v
 }
 
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *EndpointConfiguration) GetType() string {
+	if o == nil || IsNil(o.Type) {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetTypeOk returns a tuple with the Type field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EndpointConfiguration) GetTypeOk() (*string, bool) {
+	if o == nil || IsNil(o.Type) {
+		return nil, false
+	}
+	return o.Type, true
+}
+
+// HasType returns a boolean if a field has been set.
+func (o *EndpointConfiguration) HasType() bool {
+	if o != nil && !IsNil(o.Type) {
+		return true
+	}
+
+	return false
+}
+
+// SetType gets a reference to the given string and assigns it to the Type field.
+func (o *EndpointConfiguration) SetType(v string) {
+	o.Type = &v
+}
+
 // GetSchema returns the Schema field value
 func (o *EndpointConfiguration) GetSchema() EndpointSchema {
 	if o == nil {
@@ -145,6 +180,38 @@ func (o *EndpointConfiguration) SetVisibility(v string) {
 	o.Visibility = v
 }
 
+// GetMcp returns the Mcp field value if set, zero value otherwise.
+func (o *EndpointConfiguration) GetMcp() MCPServerDetails {
+	if o == nil || IsNil(o.Mcp) {
+		var ret MCPServerDetails
+		return ret
+	}
+	return *o.Mcp
+}
+
+// GetMcpOk returns a tuple with the Mcp field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EndpointConfiguration) GetMcpOk() (*MCPServerDetails, bool) {
+	if o == nil || IsNil(o.Mcp) {
+		return nil, false
+	}
+	return o.Mcp, true
+}
+
+// HasMcp returns a boolean if a field has been set.
+func (o *EndpointConfiguration) HasMcp() bool {
+	if o != nil && !IsNil(o.Mcp) {
+		return true
+	}
+
+	return false
+}
+
+// SetMcp gets a reference to the given MCPServerDetails and assigns it to the Mcp field.
+func (o *EndpointConfiguration) SetMcp(v MCPServerDetails) {
+	o.Mcp = &v
+}
+
 func (o EndpointConfiguration) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -157,8 +224,14 @@ func (o EndpointConfiguration) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["url"] = o.Url
 	toSerialize["endpointName"] = o.EndpointName
+	if !IsNil(o.Type) {
+		toSerialize["type"] = o.Type
+	}
 	toSerialize["schema"] = o.Schema
 	toSerialize["visibility"] = o.Visibility
+	if !IsNil(o.Mcp) {
+		toSerialize["mcp"] = o.Mcp
+	}
 	return toSerialize, nil
 }
 
@@ -21,6 +21,8 @@ var _ MappedNullable = &EndpointSchema{}
 type EndpointSchema struct {
 	// Schema content
 	Content string `json:"content"`
+	// Set for gRPC endpoints that are described by server reflection instead of a proto file
+	Reflection *bool `json:"reflection,omitempty"`
 }
 
 // NewEndpointSchema instantiates a new EndpointSchema object
@@ -65,6 +67,38 @@ func (o *EndpointSchema) SetContent(v string) {
 	o.Content = v
 }
 
+// GetReflection returns the Reflection field value if set, zero value otherwise.
+func (o *EndpointSchema) GetReflection() bool {
+	if o == nil || IsNil(o.Reflection) {
+		var ret bool
+		return ret
+	}
+	return *o.Reflection
+}
+
+// GetReflectionOk returns a tuple with the Reflection field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EndpointSchema) GetReflectionOk() (*bool, bool) {
+	if o == nil || IsNil(o.Reflection) {
+		return nil, false
+	}
+	return o.Reflection, true
+}
+
+// HasReflection returns a boolean if a field has been set.
+func (o *EndpointSchema) HasReflection() bool {
+	if o != nil && !IsNil(o.Reflection) {
+		return true
+	}
+
+	return false
+}
+
+// SetReflection gets a reference to the given bool and assigns it to the Reflection field.
+func (o *EndpointSchema) SetReflection(v bool) {
+	o.Reflection = &v
+}
+
 func (o EndpointSchema) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -76,6 +110,9 @@ func (o EndpointSchema) MarshalJSON() ([]byte, error) {
 func (o EndpointSchema) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["content"] = o.Content
+	if !IsNil(o.Reflection) {
+		toSerialize["reflection"] = o.Reflection
+	}
 	return toSerialize, nil
 }
 
@@ -25,7 +25,9 @@ type InputInterface struct {
 	Port   int32                `json:"port"`
 	Schema InputInterfaceSchema `json:"schema"`
 	// Base path for the endpoint
-	BasePath string `json:"basePath"`
+	BasePath  string                   `json:"basePath"`
+	Transport *InputInterfaceTransport `json:"transport,omitempty"`
+	Queue     *InputInterfaceQueue     `json:"queue,omitempty"`
 }
 
 // NewInputInterface instantiates a new InputInterface object
@@ -145,6 +147,70 @@ func (o *InputInterface) SetBasePath(v string) {
 	o.BasePath = v
 }
 
+// GetTransport returns the Transport field value if set, zero value otherwise.
+func (o *InputInterface) GetTransport() InputInterfaceTransport {
+	if o == nil || IsNil(o.Transport) {
+		var ret InputInterfaceTransport
+		return ret
+	}
+	return *o.Transport
+}
+
+// GetTransportOk returns a tuple with the Transport field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InputInterface) GetTransportOk() (*InputInterfaceTransport, bool) {
+	if o == nil || IsNil(o.Transport) {
+		return nil, false
+	}
+	return o.Transport, true
+}
+
+// HasTransport returns a boolean if a field has been set.
+func (o *InputInterface) HasTransport() bool {
+	if o != nil && !IsNil(o.Transport) {
+		return true
+	}
+
+	return false
+}
+
+// SetTransport gets a reference to the given InputInterfaceTransport and assigns it to the Transport field.
+func (o *InputInterface) SetTransport(v InputInterfaceTransport) {
+	o.Transport = &v
+}
+
+// GetQueue returns the Queue field value if set, zero value otherwise.
+func (o *InputInterface) GetQueue() InputInterfaceQueue {
+	if o == nil || IsNil(o.Queue) {
+		var ret InputInterfaceQueue
+		return ret
+	}
+	return *o.Queue
+}
+
+// GetQueueOk returns a tuple with the Queue field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InputInterface) GetQueueOk() (*InputInterfaceQueue, bool) {
+	if o == nil || IsNil(o.Queue) {
+		return nil, false
+	}
+	return o.Queue, true
+}
+
+// HasQueue returns a boolean if a field has been set.
+func (o *InputInterface) HasQueue() bool {
+	if o != nil && !IsNil(o.Queue) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueue gets a reference to the given InputInterfaceQueue and assigns it to the Queue field.
+func (o *InputInterface) SetQueue(v InputInterfaceQueue) {
+	o.Queue = &v
+}
+
 func (o InputInterface) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -159,6 +225,12 @@ func (o InputInterface) ToMap() (map[string]interface{}, error) {
 	toSerialize["port"] = o.Port
 	toSerialize["schema"] = o.Schema
 	toSerialize["basePath"] = o.BasePath
+	if !IsNil(o.Transport) {
+		toSerialize["transport"] = o.Transport
+	}
+	if !IsNil(o.Queue) {
+		toSerialize["queue"] = o.Queue
+	}
 	return toSerialize, nil
 }
 
@@ -19,8 +19,12 @@ var _ MappedNullable = &InputInterfaceSchema{}
 
 // InputInterfaceSchema struct for InputInterfaceSchema
 type InputInterfaceSchema struct {
-	// Path to OpenAPI schema file
+	// Path to OpenAPI schema file, or to the proto file of a gRPC endpoint
 	Path string `json:"path"`
+	// Describe a gRPC endpoint through server reflection instead of a proto file
+	Reflection *bool `json:"reflection,omitempty"`
+	// Inline OpenAPI 3.0 or 3.1 document describing an HTTP endpoint, used instead of a schema file
+	Content *string `json:"content,omitempty"`
 }
 
 // NewInputInterfaceSchema instantiates a new InputInterfaceSchema object
@@ -65,6 +69,70 @@ func (o *InputInterfaceSchema) SetPath(v string) {
 	o.Path = v
 }
 
+// GetReflection returns the Reflection field value if set, zero value otherwise.
+func (o *InputInterfaceSchema) GetReflection() bool {
+	if o == nil || IsNil(o.Reflection) {
+		var ret bool
+		return ret
+	}
+	return *o.Reflection
+}
+
+// GetReflectionOk returns a tuple with the Reflection field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InputInterfaceSchema) GetReflectionOk() (*bool, bool) {
+	if o == nil || IsNil(o.Reflection) {
+		return nil, false
+	}
+	return o.Reflection, true
+}
+
+// HasReflection returns a boolean if a field has been set.
+func (o *InputInterfaceSchema) HasReflection() bool {
+	if o != nil && !IsNil(o.Reflection) {
+		return true
+	}
+
+	return false
+}
+
+// SetReflection gets a reference to the given bool and assigns it to the Reflection field.
+func (o *InputInterfaceSchema) SetReflection(v bool) {
+	o.Reflection = &v
+}
+
+// GetContent returns the Content field value if set, zero value otherwise.
+func (o *InputInterfaceSchema) GetContent() string {
+	if o == nil || IsNil(o.Content) {
+		var ret string
+		return ret
+	}
+	return *o.Content
+}
+
+// GetContentOk returns a tuple with the Content field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InputInterfaceSchema) GetContentOk() (*string, bool) {
+	if o == nil || IsNil(o.Content) {
+		return nil, false
+	}
+	return o.Content, true
+}
+
+// HasContent returns a boolean if a field has been set.
+func (o *InputInterfaceSchema) HasContent() bool {
+	if o != nil && !IsNil(o.Content) {
+		return true
+	}
+
+	return false
+}
+
+// SetContent gets a reference to the given string and assigns it to the Content field.
+func (o *InputInterfaceSchema) SetContent(v string) {
+	o.Content = &v
+}
+
 func (o InputInterfaceSchema) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -76,6 +144,12 @@ func (o InputInterfaceSchema) MarshalJSON() ([]byte, error) {
 func (o InputInterfaceSchema) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["path"] = o.Path
+	if !IsNil(o.Reflection) {
+		toSerialize["reflection"] = o.Reflection
+	}
+	if !IsNil(o.Content) {
+		toSerialize["content"] = o.Content
+	}
 	return toSerialize, nil
 }
 
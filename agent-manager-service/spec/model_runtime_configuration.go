@@ -24,6 +24,11 @@ type RuntimeConfiguration struct {
 	RunCommand      *string               `json:"runCommand,omitempty"`
 	LanguageVersion *string               `json:"languageVersion,omitempty"`
 	Language        string                `json:"language"`
+	Resources       *ResourceRequirements `json:"resources,omitempty"`
+	// Fixed number of replicas, for agents that are not autoscaled
+	Replicas    *int32             `json:"replicas,omitempty"`
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+	Probes      *AgentProbes       `json:"probes,omitempty"`
 }
 
 // NewRuntimeConfiguration instantiates a new RuntimeConfiguration object
@@ -164,6 +169,134 @@ func (o *RuntimeConfiguration) SetLanguage(v string) {
 	o.Language = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *RuntimeConfiguration) GetResources() ResourceRequirements {
+	if o == nil || IsNil(o.Resources) {
+		var ret ResourceRequirements
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *RuntimeConfiguration) GetResourcesOk() (*ResourceRequirements, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *RuntimeConfiguration) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ResourceRequirements and assigns it to the Resources field.
+func (o *RuntimeConfiguration) SetResources(v ResourceRequirements) {
+	o.Resources = &v
+}
+
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *RuntimeConfiguration) GetReplicas() int32 {
+	if o == nil || IsNil(o.Replicas) {
+		var ret int32
+		return ret
+	}
+	return *o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *RuntimeConfiguration) GetReplicasOk() (*int32, bool) {
+	if o == nil || IsNil(o.Replicas) {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *RuntimeConfiguration) HasReplicas() bool {
+	if o != nil && !IsNil(o.Replicas) {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given int32 and assigns it to the Replicas field.
+func (o *RuntimeConfiguration) SetReplicas(v int32) {
+	o.Replicas = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *RuntimeConfiguration) GetAutoscaling() AutoscalingConfig {
+	if o == nil || IsNil(o.Autoscaling) {
+		var ret AutoscalingConfig
+		return ret
+	}
+	return *o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *RuntimeConfiguration) GetAutoscalingOk() (*AutoscalingConfig, bool) {
+	if o == nil || IsNil(o.Autoscaling) {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *RuntimeConfiguration) HasAutoscaling() bool {
+	if o != nil && !IsNil(o.Autoscaling) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given AutoscalingConfig and assigns it to the Autoscaling field.
+func (o *RuntimeConfiguration) SetAutoscaling(v AutoscalingConfig) {
+	o.Autoscaling = &v
+}
+
+// GetProbes returns the Probes field value if set, zero value otherwise.
+func (o *RuntimeConfiguration) GetProbes() AgentProbes {
+	if o == nil || IsNil(o.Probes) {
+		var ret AgentProbes
+		return ret
+	}
+	return *o.Probes
+}
+
+// GetProbesOk returns a tuple with the Probes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *RuntimeConfiguration) GetProbesOk() (*AgentProbes, bool) {
+	if o == nil || IsNil(o.Probes) {
+		return nil, false
+	}
+	return o.Probes, true
+}
+
+// HasProbes returns a boolean if a field has been set.
+func (o *RuntimeConfiguration) HasProbes() bool {
+	if o != nil && !IsNil(o.Probes) {
+		return true
+	}
+
+	return false
+}
+
+// SetProbes gets a reference to the given AgentProbes and assigns it to the Probes field.
+func (o *RuntimeConfiguration) SetProbes(v AgentProbes) {
+	o.Probes = &v
+}
+
 func (o RuntimeConfiguration) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -184,6 +317,18 @@ func (o RuntimeConfiguration) ToMap() (map[string]interface{}, error) {
 		toSerialize["languageVersion"] = o.LanguageVersion
 	}
 	toSerialize["language"] = o.Language
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Replicas) {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if !IsNil(o.Autoscaling) {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
+	if !IsNil(o.Probes) {
+		toSerialize["probes"] = o.Probes
+	}
 	return toSerialize, nil
 }
 
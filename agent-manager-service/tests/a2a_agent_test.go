@@ -0,0 +1,310 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestA2AAgent(t *testing.T) {
+	a2aOrgId := uuid.New()
+	a2aProjId := uuid.New()
+	a2aOtherProjId := uuid.New()
+	a2aUserIdpId := uuid.New()
+	a2aOrgName := fmt.Sprintf("a2a-org-%s", uuid.New().String()[:5])
+	a2aProjName := fmt.Sprintf("a2a-project-%s", uuid.New().String()[:5])
+	a2aOtherProjName := fmt.Sprintf("a2a-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, a2aOrgId, a2aUserIdpId, a2aOrgName)
+	_ = apitestutils.CreateProject(t, a2aProjId, a2aOrgId, a2aProjName)
+	_ = apitestutils.CreateProject(t, a2aOtherProjId, a2aOrgId, a2aOtherProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, a2aOrgId, a2aUserIdpId)
+
+	// Agents are deployed to the environments listed here, keyed by agent name
+	deployedEnvironments := map[string][]string{}
+	// Looking up the endpoints of the agents in this environment fails
+	var unreachableEnvironment string
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.ListOrgEnvironmentsFunc = func(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
+		return []*models.EnvironmentResponse{{Name: "development"}, {Name: "production"}}, nil
+	}
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		return &models.EnvironmentResponse{Name: environmentName, UUID: uuid.New().String()}, nil
+	}
+	openChoreoClient.GetAgentEndpointsFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.EndpointsResponse, error) {
+		if environment == unreachableEnvironment {
+			return nil, fmt.Errorf("failed to list release: connection refused")
+		}
+		for _, env := range deployedEnvironments[agentName] {
+			if env == environment {
+				name := fmt.Sprintf("%s-endpoint", agentName)
+				url := fmt.Sprintf("http://%s.%s.example.com/%s/", environment, projName, agentName)
+				return map[string]models.EndpointsResponse{
+					name: {Endpoint: models.Endpoint{Name: name, URL: url, Visibility: "Public"}},
+				}, nil
+			}
+		}
+		return nil, utils.ErrAgentNotDeployed
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	agentCard := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "Travel Planner",
+			"description": "Plans trips end to end",
+			"version":     "1.0.0",
+			"capabilities": map[string]interface{}{
+				"streaming": true,
+			},
+			"securitySchemes": map[string]interface{}{
+				"bearer": map[string]interface{}{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+			"security":           []interface{}{map[string]interface{}{"bearer": []string{}}},
+			"defaultInputModes":  []string{"text/plain"},
+			"defaultOutputModes": []string{"text/plain", "application/json"},
+			"skills": []interface{}{
+				map[string]interface{}{
+					"id":          "plan-trip",
+					"name":        "Plan trip",
+					"description": "Builds an itinerary for a destination",
+					"tags":        []string{"travel"},
+					"examples":    []string{"Plan a weekend in Lisbon"},
+				},
+			},
+		}
+	}
+
+	a2aAgentPayload := func(name string, subType string, card map[string]interface{}) map[string]interface{} {
+		payload := map[string]interface{}{
+			"name":        name,
+			"displayName": "Travel Planner",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/travel-planner",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": map[string]interface{}{"type": "api", "subType": subType},
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+			"inputInterface": map[string]interface{}{
+				"type": "HTTP",
+				"port": 9999,
+			},
+		}
+		if card != nil {
+			payload["agentCard"] = card
+		}
+		return payload
+	}
+
+	listA2AAgents := func(t *testing.T, query string) models.A2AAgentListResponse {
+		rr := send(t, http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/a2a-agents%s", a2aOrgName, query), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.A2AAgentListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		return response
+	}
+
+	plannerName := fmt.Sprintf("a2a-agent-%s", uuid.New().String()[:5])
+	bookerName := fmt.Sprintf("a2a-agent-%s", uuid.New().String()[:5])
+	undeployedName := fmt.Sprintf("a2a-agent-%s", uuid.New().String()[:5])
+
+	t.Run("Creating an a2a agent should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", a2aOrgName, a2aProjName),
+			a2aAgentPayload(plannerName, "a2a", agentCard()))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, plannerName, createComponentCall.Req.Name)
+		require.Equal(t, "a2a", *createComponentCall.Req.AgentType.SubType)
+		require.NotNil(t, createComponentCall.Req.AgentCard)
+		require.Equal(t, "plan-trip", createComponentCall.Req.AgentCard.Skills[0].Id)
+	})
+
+	t.Run("Creating a2a agents in other projects should return 202", func(t *testing.T) {
+		for _, name := range []string{bookerName, undeployedName} {
+			rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", a2aOrgName, a2aOtherProjName),
+				a2aAgentPayload(name, "a2a", agentCard()))
+			require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("Listing a2a agents should return deployed agents across projects with their endpoint URLs", func(t *testing.T) {
+		deployedEnvironments[plannerName] = []string{"development", "production"}
+		deployedEnvironments[bookerName] = []string{"development"}
+		// Agents without an agent card are not listed even when deployed
+		plainAgentName := fmt.Sprintf("agent-%s", uuid.New().String()[:5])
+		_ = apitestutils.CreateAgent(t, uuid.New(), a2aOrgId, a2aProjId, plainAgentName, "internal")
+		deployedEnvironments[plainAgentName] = []string{"development"}
+
+		response := listA2AAgents(t, "")
+		require.Equal(t, 2, response.Total)
+		agents := map[string]models.A2AAgentResponse{}
+		for _, agent := range response.Agents {
+			agents[agent.Name] = agent
+		}
+		require.NotContains(t, agents, undeployedName)
+		require.NotContains(t, agents, plainAgentName)
+
+		planner, ok := agents[plannerName]
+		require.True(t, ok)
+		require.Equal(t, a2aProjName, planner.ProjectName)
+		require.Equal(t, "Travel Planner", planner.AgentCard.Name)
+		require.True(t, planner.AgentCard.Capabilities.Streaming)
+		require.Equal(t, "bearer", planner.AgentCard.SecuritySchemes["bearer"].Scheme)
+		require.Len(t, planner.AgentCard.Skills, 1)
+		require.Equal(t, []string{"travel"}, planner.AgentCard.Skills[0].Tags)
+		require.Len(t, planner.Deployments, 2)
+		require.Equal(t, "development", planner.Deployments[0].Environment)
+		expectedURL := fmt.Sprintf("http://development.%s.example.com/%s", a2aProjName, plannerName)
+		require.Equal(t, expectedURL, planner.Deployments[0].URL)
+		require.Equal(t, expectedURL+"/.well-known/agent.json", planner.Deployments[0].AgentCardURL)
+		require.Equal(t, "production", planner.Deployments[1].Environment)
+
+		booker, ok := agents[bookerName]
+		require.True(t, ok)
+		require.Equal(t, a2aOtherProjName, booker.ProjectName)
+		require.Len(t, booker.Deployments, 1)
+	})
+
+	t.Run("Listing a2a agents for an environment should only return agents deployed there", func(t *testing.T) {
+		response := listA2AAgents(t, "?environment=production")
+		require.Equal(t, 1, response.Total)
+		require.Equal(t, plannerName, response.Agents[0].Name)
+		require.Len(t, response.Agents[0].Deployments, 1)
+		require.Equal(t, "production", response.Agents[0].Deployments[0].Environment)
+	})
+
+	t.Run("Listing a2a agents should fail when their endpoints cannot be looked up", func(t *testing.T) {
+		unreachableEnvironment = "production"
+		t.Cleanup(func() { unreachableEnvironment = "" })
+
+		rr := send(t, http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/a2a-agents", a2aOrgName), nil)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Listing a2a agents of an unknown organization should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodGet, "/api/v1/orgs/unknown-org/a2a-agents", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	duplicateSkillCard := agentCard()
+	skill := duplicateSkillCard["skills"].([]interface{})[0]
+	duplicateSkillCard["skills"] = []interface{}{skill, skill}
+
+	unknownSchemeCard := agentCard()
+	unknownSchemeCard["security"] = []interface{}{map[string]interface{}{"oauth": []string{"read"}}}
+
+	invalidSchemeCard := agentCard()
+	invalidSchemeCard["securitySchemes"] = map[string]interface{}{
+		"apiKey": map[string]interface{}{"type": "apiKey", "name": "X-API-Key", "in": "body"},
+	}
+	invalidSchemeCard["security"] = []interface{}{map[string]interface{}{"apiKey": []string{}}}
+
+	noSkillsCard := agentCard()
+	noSkillsCard["skills"] = []interface{}{}
+
+	validationTests := []struct {
+		name       string
+		subType    string
+		card       map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 on a2a agent without an agent card",
+			subType:    "a2a",
+			wantErrMsg: "agentCard is required for a2a agents",
+		},
+		{
+			name:       "return 400 on agent card without skills",
+			subType:    "a2a",
+			card:       noSkillsCard,
+			wantErrMsg: "at least one skill is required",
+		},
+		{
+			name:       "return 400 on duplicate skill ids",
+			subType:    "a2a",
+			card:       duplicateSkillCard,
+			wantErrMsg: "duplicate skill id 'plan-trip'",
+		},
+		{
+			name:       "return 400 on security requirement with an unknown scheme",
+			subType:    "a2a",
+			card:       unknownSchemeCard,
+			wantErrMsg: "security[0]: unknown security scheme 'oauth'",
+		},
+		{
+			name:       "return 400 on invalid apiKey security scheme",
+			subType:    "a2a",
+			card:       invalidSchemeCard,
+			wantErrMsg: "securitySchemes.apiKey",
+		},
+		{
+			name:       "return 400 on agent card for a non a2a agent",
+			subType:    "chat-api",
+			card:       agentCard(),
+			wantErrMsg: "agentCard is only supported for a2a agents",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := a2aAgentPayload(fmt.Sprintf("a2a-agent-%s", uuid.New().String()[:5]), tt.subType, tt.card)
+			rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", a2aOrgName, a2aProjName), payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentConfigurations(t *testing.T) {
+	configOrgId := uuid.New()
+	configProjId := uuid.New()
+	configUserIdpId := uuid.New()
+	configOrgName := fmt.Sprintf("config-org-%s", uuid.New().String()[:5])
+	configProjName := fmt.Sprintf("config-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, configOrgId, configUserIdpId, configOrgName)
+	_ = apitestutils.CreateProject(t, configProjId, configOrgId, configProjName)
+	externalAgentName := fmt.Sprintf("config-external-%s", uuid.New().String()[:5])
+	_ = apitestutils.CreateAgent(t, uuid.New(), configOrgId, configProjId, externalAgentName, "external")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, configOrgId, configUserIdpId)
+
+	var updateOverridesErr error
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		if environmentName != "development" && environmentName != "production" {
+			return nil, utils.ErrEnvironmentNotFound
+		}
+		return &models.EnvironmentResponse{Name: environmentName, UUID: uuid.New().String()}, nil
+	}
+	openChoreoClient.UpdateAgentConfigOverridesFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string, overrides []models.EnvVars) error {
+		return updateOverridesErr
+	}
+	var deployErr error
+	openChoreoClient.DeployAgentComponentFunc = func(ctx context.Context, orgName string, projName string, componentName string, req *spec.DeployAgentRequest) error {
+		return deployErr
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+	getConfigurations := func(t *testing.T, path string) map[string]*spec.ConfigurationItem {
+		rr := send(t, http.MethodGet, path, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response spec.ConfigurationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		items := make(map[string]*spec.ConfigurationItem, len(response.Configurations))
+		for i := range response.Configurations {
+			items[response.Configurations[i].Key] = &response.Configurations[i]
+		}
+		return items
+	}
+
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", configOrgName, configProjName)
+	agentName := fmt.Sprintf("config-agent-%s", uuid.New().String()[:5])
+	configurationsPath := fmt.Sprintf("%s/%s/configurations", agentsPath, agentName)
+	overridesPath := configurationsPath + "/overrides"
+
+	rr := send(t, http.MethodPost, agentsPath, map[string]interface{}{
+		"name":        agentName,
+		"displayName": "Config Agent",
+		"provisioning": map[string]interface{}{
+			"type": "internal",
+			"repository": map[string]interface{}{
+				"url":     "https://github.com/test/config-agent",
+				"branch":  "main",
+				"appPath": "agent",
+			},
+		},
+		"agentType": map[string]interface{}{"type": "api", "subType": "chat-api"},
+		"runtimeConfigs": map[string]interface{}{
+			"runCommand":      "python main.py",
+			"language":        "python",
+			"languageVersion": "3.11",
+			"env": []map[string]interface{}{
+				{"key": "MODEL", "value": "small"},
+				{"key": "LOG_LEVEL", "value": "debug"},
+			},
+		},
+		"inputInterface": map[string]interface{}{"type": "HTTP"},
+	})
+	require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+	t.Run("Getting the configurations of an environment without overrides should return the base values", func(t *testing.T) {
+		items := getConfigurations(t, configurationsPath+"?environment=production")
+		require.Len(t, items, 2)
+		require.Equal(t, "small", items["MODEL"].Value)
+		require.Equal(t, utils.ConfigSourceBase, items["MODEL"].GetSource())
+	})
+
+	t.Run("Creating an override should return 201", func(t *testing.T) {
+		rr := send(t, http.MethodPost, overridesPath+"?environment=production", map[string]interface{}{"key": "MODEL", "value": "large"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var response models.ConfigOverrideResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "MODEL", response.Key)
+		require.Equal(t, "large", response.Value)
+		require.Equal(t, "production", response.Environment)
+		require.Empty(t, openChoreoClient.UpdateAgentConfigOverridesCalls())
+	})
+
+	t.Run("Creating an override of a key that is already overridden should return 409", func(t *testing.T) {
+		rr := send(t, http.MethodPost, overridesPath+"?environment=production", map[string]interface{}{"key": "MODEL", "value": "medium"})
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Creating an override with redeploy should apply the overrides of the environment", func(t *testing.T) {
+		rr := send(t, http.MethodPost, overridesPath+"?environment=production&redeploy=true", map[string]interface{}{"key": "REGION", "value": "eu"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.UpdateAgentConfigOverridesCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, agentName, calls[0].AgentName)
+		require.Equal(t, "production", calls[0].Environment)
+		require.Equal(t, []models.EnvVars{{Key: "MODEL", Value: "large"}, {Key: "REGION", Value: "eu"}}, calls[0].Overrides)
+	})
+
+	t.Run("Getting the configurations of an environment should merge its overrides over the base values", func(t *testing.T) {
+		items := getConfigurations(t, configurationsPath+"?environment=production")
+		require.Len(t, items, 3)
+		require.Equal(t, "large", items["MODEL"].Value)
+		require.Equal(t, utils.ConfigSourceOverride, items["MODEL"].GetSource())
+		require.Equal(t, "debug", items["LOG_LEVEL"].Value)
+		require.Equal(t, utils.ConfigSourceBase, items["LOG_LEVEL"].GetSource())
+		require.Equal(t, "eu", items["REGION"].Value)
+
+		// Overrides only apply to their own environment
+		items = getConfigurations(t, configurationsPath+"?environment=development")
+		require.Len(t, items, 2)
+		require.Equal(t, "small", items["MODEL"].Value)
+	})
+
+	t.Run("Listing the overrides of an environment should return them ordered by key", func(t *testing.T) {
+		rr := send(t, http.MethodGet, overridesPath+"?environment=production", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.ConfigOverrideListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 2, response.Total)
+		require.Equal(t, "MODEL", response.Overrides[0].Key)
+		require.Equal(t, "REGION", response.Overrides[1].Key)
+	})
+
+	t.Run("Updating an override should return 200", func(t *testing.T) {
+		rr := send(t, http.MethodPut, overridesPath+"/MODEL?environment=production", map[string]interface{}{"value": "xlarge"})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.ConfigOverrideResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "xlarge", response.Value)
+	})
+
+	t.Run("Updating a missing override should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodPut, overridesPath+"/MISSING?environment=production", map[string]interface{}{"value": "x"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deleting an override should fall back to the base value", func(t *testing.T) {
+		rr := send(t, http.MethodDelete, overridesPath+"/MODEL?environment=production", nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		items := getConfigurations(t, configurationsPath+"?environment=production")
+		require.Equal(t, "small", items["MODEL"].Value)
+		require.Equal(t, utils.ConfigSourceBase, items["MODEL"].GetSource())
+	})
+
+	t.Run("Redeploying the configurations should apply the overrides and return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, configurationsPath+"/redeploy?environment=production", nil)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.UpdateAgentConfigOverridesCalls()
+		require.Equal(t, []models.EnvVars{{Key: "REGION", Value: "eu"}}, calls[len(calls)-1].Overrides)
+	})
+
+	t.Run("Redeploying to an environment the agent is not deployed to should return 400", func(t *testing.T) {
+		updateOverridesErr = utils.ErrAgentNotDeployed
+		defer func() { updateOverridesErr = nil }()
+
+		rr := send(t, http.MethodPost, configurationsPath+"/redeploy?environment=production", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "not deployed")
+	})
+
+	t.Run("Creating an override with redeploy that fails should not store it", func(t *testing.T) {
+		updateOverridesErr = utils.ErrAgentNotDeployed
+		defer func() { updateOverridesErr = nil }()
+
+		rr := send(t, http.MethodPost, overridesPath+"?environment=production&redeploy=true", map[string]interface{}{"key": "TIMEOUT", "value": "30"})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		items := getConfigurations(t, configurationsPath+"?environment=production")
+		require.NotContains(t, items, "TIMEOUT")
+	})
+
+	t.Run("Updating an override with redeploy that fails should keep its value", func(t *testing.T) {
+		updateOverridesErr = utils.ErrAgentNotDeployed
+		defer func() { updateOverridesErr = nil }()
+
+		rr := send(t, http.MethodPut, overridesPath+"/REGION?environment=production&redeploy=true", map[string]interface{}{"value": "us"})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		items := getConfigurations(t, configurationsPath+"?environment=production")
+		require.Equal(t, "eu", items["REGION"].Value)
+	})
+
+	t.Run("Deleting an override with redeploy that fails should keep it", func(t *testing.T) {
+		updateOverridesErr = utils.ErrAgentNotDeployed
+		defer func() { updateOverridesErr = nil }()
+
+		rr := send(t, http.MethodDelete, overridesPath+"/REGION?environment=production&redeploy=true", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		items := getConfigurations(t, configurationsPath+"?environment=production")
+		require.Equal(t, "eu", items["REGION"].Value)
+		require.Equal(t, utils.ConfigSourceOverride, items["REGION"].GetSource())
+	})
+
+	t.Run("Storing an override of a key that is already overridden should violate its unique constraint", func(t *testing.T) {
+		ctx := context.Background()
+		dbAgent, err := repositories.NewAgentRepository().GetAgentByName(ctx, configOrgId, configProjId, agentName)
+		require.NoError(t, err)
+
+		err = repositories.NewAgentConfigOverrideRepository().CreateConfigOverride(ctx, &models.AgentConfigOverride{
+			ID:          uuid.New(),
+			AgentID:     dbAgent.ID,
+			Environment: "production",
+			Key:         "REGION",
+			Value:       "us",
+			CreatedBy:   configUserIdpId,
+		})
+		require.True(t, db.IsUniqueViolationError(err, "uk_agent_config_overrides_agent_environment_key"), "unexpected error: %v", err)
+	})
+
+	deploymentsPath := fmt.Sprintf("%s/%s/deployments", agentsPath, agentName)
+
+	t.Run("Deploying an agent that fails should keep its base configuration", func(t *testing.T) {
+		deployErr = errors.New("openchoreo unavailable")
+		defer func() { deployErr = nil }()
+
+		rr := send(t, http.MethodPost, deploymentsPath, map[string]interface{}{
+			"imageId": "registry.example.com/config-agent:v2",
+			"env":     []map[string]interface{}{{"key": "MODEL", "value": "tiny"}},
+		})
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		items := getConfigurations(t, configurationsPath+"?environment=development")
+		require.Equal(t, "small", items["MODEL"].Value)
+	})
+
+	t.Run("Deploying an agent should replace its base configuration", func(t *testing.T) {
+		rr := send(t, http.MethodPost, deploymentsPath, map[string]interface{}{
+			"imageId": "registry.example.com/config-agent:v2",
+			"env":     []map[string]interface{}{{"key": "MODEL", "value": "tiny"}},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		items := getConfigurations(t, configurationsPath+"?environment=development")
+		require.Len(t, items, 1)
+		require.Equal(t, "tiny", items["MODEL"].Value)
+	})
+
+	errorTests := []struct {
+		name       string
+		method     string
+		path       string
+		payload    interface{}
+		wantStatus int
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 when the environment is missing",
+			method:     http.MethodGet,
+			path:       overridesPath,
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "environment",
+		},
+		{
+			name:       "return 404 for an unknown environment",
+			method:     http.MethodGet,
+			path:       configurationsPath + "?environment=staging",
+			wantStatus: http.StatusNotFound,
+			wantErrMsg: "Environment not found",
+		},
+		{
+			name:       "return 400 for an invalid key",
+			method:     http.MethodPost,
+			path:       overridesPath + "?environment=production",
+			payload:    map[string]interface{}{"key": "1MODEL", "value": "x"},
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "key must start with a letter or underscore",
+		},
+		{
+			name:       "return 400 for a missing key",
+			method:     http.MethodPost,
+			path:       overridesPath + "?environment=production",
+			payload:    map[string]interface{}{"value": "x"},
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "key is required",
+		},
+		{
+			name:       "return 400 for a value that is too long",
+			method:     http.MethodPost,
+			path:       overridesPath + "?environment=production",
+			payload:    map[string]interface{}{"key": "PROMPT", "value": strings.Repeat("a", utils.ConfigOverrideMaxValueLength+1)},
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "value must be at most",
+		},
+		{
+			name:       "return 400 for an invalid redeploy parameter",
+			method:     http.MethodPost,
+			path:       overridesPath + "?environment=production&redeploy=yes-please",
+			payload:    map[string]interface{}{"key": "MODEL", "value": "x"},
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid redeploy parameter",
+		},
+		{
+			name:       "return 400 for an external agent",
+			method:     http.MethodGet,
+			path:       fmt.Sprintf("%s/%s/configurations?environment=production", agentsPath, externalAgentName),
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "only supported for agents deployed by the platform",
+		},
+		{
+			name:       "return 404 for a missing agent",
+			method:     http.MethodGet,
+			path:       fmt.Sprintf("%s/missing-agent/configurations/overrides?environment=production", agentsPath),
+			wantStatus: http.StatusNotFound,
+			wantErrMsg: "Agent not found",
+		},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := send(t, tt.method, tt.path, tt.payload)
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
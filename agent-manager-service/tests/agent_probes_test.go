@@ -0,0 +1,175 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentProbes(t *testing.T) {
+	probesOrgId := uuid.New()
+	probesProjId := uuid.New()
+	probesUserIdpId := uuid.New()
+	probesOrgName := fmt.Sprintf("probes-org-%s", uuid.New().String()[:5])
+	probesProjName := fmt.Sprintf("probes-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, probesOrgId, probesUserIdpId, probesOrgName)
+	_ = apitestutils.CreateProject(t, probesProjId, probesOrgId, probesProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, probesOrgId, probesUserIdpId)
+
+	openChoreoClient := createMockOpenChoreoClient()
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	createAgent := func(t *testing.T, agentType map[string]interface{}, probes map[string]interface{}) *httptest.ResponseRecorder {
+		payload := map[string]interface{}{
+			"name":        fmt.Sprintf("probes-agent-%s", uuid.New().String()[:5]),
+			"displayName": "Probes Agent",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/probes-agent",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": agentType,
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+				"probes":          probes,
+			},
+		}
+		reqBody := new(bytes.Buffer)
+		require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", probesOrgName, probesProjName), reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+	chatAPI := map[string]interface{}{"type": "api", "subType": "chat-api"}
+	httpProbe := map[string]interface{}{"httpGet": map[string]interface{}{"path": "/healthz", "port": 8000}}
+
+	t.Run("Creating an agent with liveness, readiness and startup probes should return 202", func(t *testing.T) {
+		rr := createAgent(t, chatAPI, map[string]interface{}{
+			"liveness":  map[string]interface{}{"httpGet": map[string]interface{}{"path": "/healthz", "port": 8000}, "periodSeconds": 20},
+			"readiness": map[string]interface{}{"exec": map[string]interface{}{"command": []string{"cat", "/tmp/ready"}}},
+			// Allows the agent ten minutes to load its models before the liveness probe takes over
+			"startup": map[string]interface{}{"httpGet": map[string]interface{}{"path": "/healthz", "port": 8000}, "periodSeconds": 10, "failureThreshold": 60},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		probes := calls[len(calls)-1].Req.RuntimeConfigs.Probes
+		require.NotNil(t, probes)
+		require.Equal(t, "/healthz", probes.Liveness.HttpGet.Path)
+		require.Equal(t, int32(8000), probes.Liveness.HttpGet.Port)
+		require.Equal(t, int32(20), probes.Liveness.GetPeriodSeconds())
+		require.Equal(t, []string{"cat", "/tmp/ready"}, probes.Readiness.Exec.Command)
+		require.Equal(t, int32(60), probes.Startup.GetFailureThreshold())
+	})
+
+	validationTests := []struct {
+		name       string
+		agentType  map[string]interface{}
+		probes     map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 on a probe without an action",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"readiness": map[string]interface{}{"periodSeconds": 5}},
+			wantErrMsg: "probes.readiness must set exactly one of httpGet and exec",
+		},
+		{
+			name:      "return 400 on a probe with both an HTTP request and a command",
+			agentType: chatAPI,
+			probes: map[string]interface{}{"liveness": map[string]interface{}{
+				"httpGet": map[string]interface{}{"path": "/healthz", "port": 8000},
+				"exec":    map[string]interface{}{"command": []string{"true"}},
+			}},
+			wantErrMsg: "probes.liveness must set exactly one of httpGet and exec",
+		},
+		{
+			name:       "return 400 on a relative probe path",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"startup": map[string]interface{}{"httpGet": map[string]interface{}{"path": "healthz", "port": 8000}}},
+			wantErrMsg: "probes.startup.httpGet.path must start with /",
+		},
+		{
+			name:       "return 400 on an out of range probe port",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"readiness": map[string]interface{}{"httpGet": map[string]interface{}{"path": "/ready", "port": 70000}}},
+			wantErrMsg: "probes.readiness.httpGet.port must be between 1 and 65535",
+		},
+		{
+			name:       "return 400 on an empty probe command",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"liveness": map[string]interface{}{"exec": map[string]interface{}{"command": []string{}}}},
+			wantErrMsg: "probes.liveness.exec.command must not be empty",
+		},
+		{
+			name:       "return 400 on a negative initial delay",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"liveness": map[string]interface{}{"httpGet": httpProbe["httpGet"], "initialDelaySeconds": -1}},
+			wantErrMsg: "probes.liveness.initialDelaySeconds must not be negative",
+		},
+		{
+			name:       "return 400 on a zero failure threshold",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"startup": map[string]interface{}{"httpGet": httpProbe["httpGet"], "failureThreshold": 0}},
+			wantErrMsg: "probes.startup.failureThreshold must be at least 1",
+		},
+		{
+			name:       "return 400 on a liveness success threshold above 1",
+			agentType:  chatAPI,
+			probes:     map[string]interface{}{"liveness": map[string]interface{}{"httpGet": httpProbe["httpGet"], "successThreshold": 2}},
+			wantErrMsg: "probes.liveness.successThreshold must be 1",
+		},
+		{
+			name:       "return 400 on probes for a job agent",
+			agentType:  map[string]interface{}{"type": "job"},
+			probes:     map[string]interface{}{"liveness": httpProbe},
+			wantErrMsg: "probes are not supported for job agents",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := createAgent(t, tt.agentType, tt.probes)
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
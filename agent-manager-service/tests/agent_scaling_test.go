@@ -0,0 +1,414 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentScaling(t *testing.T) {
+	scalingOrgId := uuid.New()
+	scalingProjId := uuid.New()
+	scalingUserIdpId := uuid.New()
+	scalingOrgName := fmt.Sprintf("scaling-org-%s", uuid.New().String()[:5])
+	scalingProjName := fmt.Sprintf("scaling-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, scalingOrgId, scalingUserIdpId, scalingOrgName)
+	_ = apitestutils.CreateProject(t, scalingProjId, scalingOrgId, scalingProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, scalingOrgId, scalingUserIdpId)
+
+	// Development is capped below production, so settings that fit production are rejected for development
+	agentScalingConfig := config.GetConfig().AgentScaling
+	t.Cleanup(func() { config.GetConfig().AgentScaling = agentScalingConfig })
+	config.GetConfig().AgentScaling.EnvironmentCaps = map[string]config.ResourceCaps{
+		"development": {MaxCPU: resource.MustParse("1"), MaxMemory: resource.MustParse("2Gi"), MaxReplicas: 3},
+		"production":  {MaxCPU: resource.MustParse("4"), MaxMemory: resource.MustParse("8Gi"), MaxReplicas: 20},
+	}
+
+	targetCPU, maxReplicas := int32(70), int32(3)
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetDeploymentPipelineFunc = func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
+		return &models.DeploymentPipelineResponse{
+			Name:    deploymentPipelineName,
+			OrgName: orgName,
+			PromotionPaths: []models.PromotionPath{
+				{SourceEnvironmentRef: "development", TargetEnvironmentRefs: []models.TargetEnvironmentRef{{Name: "production"}}},
+			},
+		}, nil
+	}
+	openChoreoClient.UpdateAgentScalingFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+		return nil
+	}
+	openChoreoClient.DeployAgentComponentFunc = func(ctx context.Context, orgName string, projName string, componentName string, req *spec.DeployAgentRequest) error {
+		return nil
+	}
+	openChoreoClient.GetAgentDeploymentsFunc = func(ctx context.Context, orgName string, pipelineName string, projName string, componentName string) ([]*models.DeploymentResponse, error) {
+		return []*models.DeploymentResponse{
+			{
+				AgentName:      componentName,
+				ProjectName:    projName,
+				ImageId:        "registry.local/scaling-agent:latest",
+				Status:         openchoreosvc.DeploymentStatusActive,
+				Environment:    "development",
+				LastDeployedAt: time.Now(),
+				Endpoints:      []models.Endpoint{},
+				Resources: &models.ResourceRequirements{
+					Requests: models.ResourceQuantity{CPU: "250m", Memory: "1Gi"},
+					Limits:   models.ResourceQuantity{CPU: "1", Memory: "2Gi"},
+				},
+				Autoscaling: &models.AutoscalingConfig{MinReplicas: 1, MaxReplicas: maxReplicas, TargetCPUUtilizationPercentage: &targetCPU},
+			},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	agentPayload := func(name string, agentType map[string]interface{}, runtimeConfigs map[string]interface{}) map[string]interface{} {
+		runtimeConfigs["runCommand"] = "python main.py"
+		runtimeConfigs["language"] = "python"
+		runtimeConfigs["languageVersion"] = "3.11"
+		return map[string]interface{}{
+			"name":        name,
+			"displayName": "Scaling Agent",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/scaling-agent",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType":      agentType,
+			"runtimeConfigs": runtimeConfigs,
+		}
+	}
+	chatAPI := map[string]interface{}{"type": "api", "subType": "chat-api"}
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", scalingOrgName, scalingProjName)
+	agentName := fmt.Sprintf("scaling-agent-%s", uuid.New().String()[:5])
+
+	t.Run("Creating an agent with resources and autoscaling should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, agentsPath, agentPayload(agentName, chatAPI, map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "250m", "memory": "1Gi"},
+				"limits":   map[string]interface{}{"cpu": "1", "memory": "2Gi"},
+			},
+			"autoscaling": map[string]interface{}{"minReplicas": 1, "maxReplicas": 3, "targetCPUUtilizationPercentage": 70},
+		}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		// The agent is not deployed anywhere yet, so the scaling of the request goes with the component it is first
+		// released with
+		require.Empty(t, openChoreoClient.UpdateAgentScalingCalls())
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		runtimeConfigs := calls[len(calls)-1].Req.RuntimeConfigs
+		require.Equal(t, "1Gi", runtimeConfigs.Resources.Requests.GetMemory())
+		require.Equal(t, "1", runtimeConfigs.Resources.Limits.GetCpu())
+		require.Equal(t, int32(3), runtimeConfigs.Autoscaling.MaxReplicas)
+		require.Equal(t, int32(70), runtimeConfigs.Autoscaling.GetTargetCPUUtilizationPercentage())
+	})
+
+	t.Run("Deploying an agent for the first time with a replica count should release it with the replica count", func(t *testing.T) {
+		openChoreoClient.UpdateAgentScalingFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+			return utils.ErrAgentNotDeployed
+		}
+		t.Cleanup(func() {
+			openChoreoClient.UpdateAgentScalingFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+				return nil
+			}
+		})
+
+		rr := send(t, http.MethodPost, fmt.Sprintf("%s/%s/deployments", agentsPath, agentName), map[string]interface{}{
+			"imageId":  "registry.local/scaling-agent:v1",
+			"replicas": 2,
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.DeployAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		deployCall := calls[len(calls)-1]
+		require.Equal(t, int32(2), *deployCall.Req.Replicas)
+		require.Nil(t, deployCall.Req.Resources)
+	})
+
+	t.Run("Deploying an agent with a replica count should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, fmt.Sprintf("%s/%s/deployments", agentsPath, agentName), map[string]interface{}{
+			"imageId":   "registry.local/scaling-agent:v2",
+			"replicas":  2,
+			"resources": map[string]interface{}{"limits": map[string]interface{}{"memory": "2Gi"}},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		// The agent is deployed to the first environment, so the scaling is set on its release binding
+		deployCalls := openChoreoClient.DeployAgentComponentCalls()
+		require.NotEmpty(t, deployCalls)
+		require.Nil(t, deployCalls[len(deployCalls)-1].Req.Replicas)
+		calls := openChoreoClient.UpdateAgentScalingCalls()
+		require.NotEmpty(t, calls)
+		scalingCall := calls[len(calls)-1]
+		require.Equal(t, "development", scalingCall.Environment)
+		require.Equal(t, int32(2), *scalingCall.Replicas)
+		require.Equal(t, "2Gi", scalingCall.Resources.Limits.GetMemory())
+		require.Nil(t, scalingCall.Autoscaling)
+	})
+
+	t.Run("Getting deployments should show the resources and autoscaling", func(t *testing.T) {
+		rr := send(t, http.MethodGet, fmt.Sprintf("%s/%s/deployments", agentsPath, agentName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response map[string]spec.DeploymentDetailsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Contains(t, response, "development")
+		deployment := response["development"]
+		require.NotNil(t, deployment.Resources)
+		require.Equal(t, "250m", deployment.Resources.Requests.GetCpu())
+		require.Equal(t, "2Gi", deployment.Resources.Limits.GetMemory())
+		require.False(t, deployment.HasReplicas())
+		require.NotNil(t, deployment.Autoscaling)
+		require.Equal(t, int32(3), deployment.Autoscaling.MaxReplicas)
+		require.Equal(t, int32(70), deployment.Autoscaling.GetTargetCPUUtilizationPercentage())
+		require.False(t, deployment.Autoscaling.HasTargetConcurrency())
+	})
+
+	createValidationTests := []struct {
+		name           string
+		agentType      map[string]interface{}
+		runtimeConfigs map[string]interface{}
+		wantErrMsg     string
+	}{
+		{
+			name:           "return 400 on requests above limits",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": "2"}, "limits": map[string]interface{}{"cpu": "1"}}},
+			wantErrMsg:     "resources.requests.cpu must not exceed resources.limits.cpu",
+		},
+		{
+			name:           "return 400 on an invalid memory quantity",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{"memory": "lots"}}},
+			wantErrMsg:     "resources.limits.memory is not a valid quantity",
+		},
+		{
+			name:           "return 400 on both replicas and autoscaling",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"replicas": 2, "autoscaling": map[string]interface{}{"minReplicas": 1, "maxReplicas": 2, "targetConcurrency": 10}},
+			wantErrMsg:     "replicas and autoscaling must not both be set",
+		},
+		{
+			name:           "return 400 on autoscaling without a target",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"autoscaling": map[string]interface{}{"minReplicas": 1, "maxReplicas": 2}},
+			wantErrMsg:     "autoscaling must set targetCPUUtilizationPercentage or targetConcurrency",
+		},
+		{
+			name:           "return 400 on autoscaling with maxReplicas below minReplicas",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"autoscaling": map[string]interface{}{"minReplicas": 3, "maxReplicas": 2, "targetConcurrency": 10}},
+			wantErrMsg:     "autoscaling.maxReplicas must not be less than autoscaling.minReplicas",
+		},
+		{
+			name:           "return 400 on replicas for a job agent",
+			agentType:      map[string]interface{}{"type": "job"},
+			runtimeConfigs: map[string]interface{}{"replicas": 2},
+			wantErrMsg:     "replicas and autoscaling are not supported for job agents",
+		},
+		{
+			name:           "return 400 on a cpu limit above the development cap",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "2"}}},
+			wantErrMsg:     "resources.limits.cpu 2 exceeds the cap of 1 in environment development",
+		},
+		{
+			name:           "return 400 on a memory request above the development cap when no limit is set",
+			agentType:      chatAPI,
+			runtimeConfigs: map[string]interface{}{"resources": map[string]interface{}{"requests": map[string]interface{}{"memory": "4Gi"}}},
+			wantErrMsg:     "resources.requests.memory 4Gi exceeds the cap of 2Gi in environment development",
+		},
+	}
+
+	for _, tt := range createValidationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := send(t, http.MethodPost, agentsPath, agentPayload(fmt.Sprintf("scaling-agent-%s", uuid.New().String()[:5]), tt.agentType, tt.runtimeConfigs))
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+
+	deployValidationTests := []struct {
+		name       string
+		payload    map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 on replicas above the development cap",
+			payload:    map[string]interface{}{"imageId": "registry.local/scaling-agent:v3", "replicas": 5},
+			wantErrMsg: "replicas 5 exceeds the cap of 3 in environment development",
+		},
+		{
+			name: "return 400 on autoscaling above the development cap",
+			payload: map[string]interface{}{
+				"imageId":     "registry.local/scaling-agent:v3",
+				"autoscaling": map[string]interface{}{"minReplicas": 1, "maxReplicas": 30, "targetConcurrency": 10},
+			},
+			wantErrMsg: "autoscaling.maxReplicas 30 exceeds the cap of 3 in environment development",
+		},
+		{
+			name:       "return 400 on zero replicas",
+			payload:    map[string]interface{}{"imageId": "registry.local/scaling-agent:v3", "replicas": 0},
+			wantErrMsg: "replicas must be at least 1",
+		},
+		{
+			name: "return 400 on a CPU target above 100 percent",
+			payload: map[string]interface{}{
+				"imageId":     "registry.local/scaling-agent:v3",
+				"autoscaling": map[string]interface{}{"minReplicas": 1, "maxReplicas": 2, "targetCPUUtilizationPercentage": 150},
+			},
+			wantErrMsg: "autoscaling.targetCPUUtilizationPercentage must be between 1 and 100",
+		},
+	}
+
+	for _, tt := range deployValidationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployCalls := len(openChoreoClient.DeployAgentComponentCalls())
+			scalingCalls := len(openChoreoClient.UpdateAgentScalingCalls())
+			rr := send(t, http.MethodPost, fmt.Sprintf("%s/%s/deployments", agentsPath, agentName), tt.payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+			require.Len(t, openChoreoClient.DeployAgentComponentCalls(), deployCalls)
+			require.Len(t, openChoreoClient.UpdateAgentScalingCalls(), scalingCalls)
+		})
+	}
+
+	scalingPath := fmt.Sprintf("%s/%s/scaling", agentsPath, agentName)
+	productionScaling := map[string]interface{}{
+		"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "2", "memory": "4Gi"}},
+		"replicas":  5,
+	}
+
+	t.Run("Updating scaling should only check the caps of the given environment", func(t *testing.T) {
+		rr := send(t, http.MethodPut, scalingPath+"?environment=production", productionScaling)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.UpdateAgentScalingCalls()
+		require.NotEmpty(t, calls)
+		scalingCall := calls[len(calls)-1]
+		require.Equal(t, "production", scalingCall.Environment)
+		require.Equal(t, int32(5), *scalingCall.Replicas)
+		require.Equal(t, "2", scalingCall.Resources.Limits.GetCpu())
+		require.Equal(t, "4Gi", scalingCall.Resources.Limits.GetMemory())
+	})
+
+	t.Run("Updating scaling of an environment the agent is not deployed to should return 400", func(t *testing.T) {
+		openChoreoClient.UpdateAgentScalingFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+			return utils.ErrAgentNotDeployed
+		}
+		t.Cleanup(func() {
+			openChoreoClient.UpdateAgentScalingFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+				return nil
+			}
+		})
+
+		rr := send(t, http.MethodPut, scalingPath+"?environment=production", productionScaling)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "Agent is not deployed to the environment")
+	})
+
+	scalingValidationTests := []struct {
+		name        string
+		environment string
+		payload     map[string]interface{}
+		wantStatus  int
+		wantErrMsg  string
+	}{
+		{
+			name:        "return 400 on scaling above the caps of the given environment",
+			environment: "development",
+			payload:     productionScaling,
+			wantStatus:  http.StatusBadRequest,
+			wantErrMsg:  "resources.limits.cpu 2 exceeds the cap of 1 in environment development",
+		},
+		{
+			name:        "return 400 on replicas above the production cap",
+			environment: "production",
+			payload:     map[string]interface{}{"replicas": 25},
+			wantStatus:  http.StatusBadRequest,
+			wantErrMsg:  "replicas 25 exceeds the cap of 20 in environment production",
+		},
+		{
+			name:        "return 400 without an environment",
+			environment: "",
+			payload:     productionScaling,
+			wantStatus:  http.StatusBadRequest,
+			wantErrMsg:  "Missing required query parameter 'environment'",
+		},
+		{
+			name:        "return 404 on an environment outside the deployment pipeline",
+			environment: "staging",
+			payload:     productionScaling,
+			wantStatus:  http.StatusNotFound,
+			wantErrMsg:  "Environment not found",
+		},
+	}
+
+	for _, tt := range scalingValidationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			scalingCalls := len(openChoreoClient.UpdateAgentScalingCalls())
+			path := scalingPath
+			if tt.environment != "" {
+				path = fmt.Sprintf("%s?environment=%s", scalingPath, tt.environment)
+			}
+			rr := send(t, http.MethodPut, path, tt.payload)
+			require.Equal(t, tt.wantStatus, rr.Code, rr.Body.String())
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+			require.Len(t, openChoreoClient.UpdateAgentScalingCalls(), scalingCalls)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const (
+	apiKeyProjectUID     = "project-uid-1"
+	apiKeyComponentUID   = "component-uid-1"
+	apiKeyEnvironmentUID = "environment-uid-1"
+)
+
+func createMockOpenChoreoClientForAPIKeys() *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetProjectFunc: func(ctx context.Context, projectName string, orgName string) (*models.ProjectResponse, error) {
+			return &models.ProjectResponse{UUID: apiKeyProjectUID, Name: projectName, OrgName: orgName}, nil
+		},
+		GetAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
+			return &openchoreosvc.AgentComponent{UUID: apiKeyComponentUID, Name: agentName, ProjectName: projName}, nil
+		},
+		GetEnvironmentFunc: func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+			if environmentName != "development" {
+				return nil, utils.ErrEnvironmentNotFound
+			}
+			return &models.EnvironmentResponse{UUID: apiKeyEnvironmentUID, Name: environmentName}, nil
+		},
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	// Create unique test data for this test suite
+	apiKeyOrgId := uuid.New()
+	apiKeyUserIdpId := uuid.New()
+	apiKeyProjId := uuid.New()
+	apiKeyOrgName := fmt.Sprintf("api-key-org-%s", uuid.New().String()[:5])
+	apiKeyProjName := fmt.Sprintf("api-key-project-%s", uuid.New().String()[:5])
+	externalAgentName := fmt.Sprintf("api-key-agent-%s", uuid.New().String()[:5])
+	internalAgentName := fmt.Sprintf("api-key-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, apiKeyOrgId, apiKeyUserIdpId, apiKeyOrgName)
+	_ = apitestutils.CreateProject(t, apiKeyProjId, apiKeyOrgId, apiKeyProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), apiKeyOrgId, apiKeyProjId, externalAgentName, "external")
+	_ = apitestutils.CreateAgent(t, uuid.New(), apiKeyOrgId, apiKeyProjId, internalAgentName, "internal")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, apiKeyOrgId, apiKeyUserIdpId)
+
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: createMockOpenChoreoClientForAPIKeys(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	basePath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/api-keys", apiKeyOrgName, apiKeyProjName, externalAgentName)
+
+	createKey := func(t *testing.T, path string, payload map[string]interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		req := httptest.NewRequest(http.MethodPost, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	verifyKey := func(t *testing.T, key string) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		require.NoError(t, json.NewEncoder(reqBody).Encode(models.VerifyAPIKeyRequest{Key: key}))
+		req := httptest.NewRequest(http.MethodPost, "/internal/api-keys/verify", reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(config.GetConfig().APIKeyHeader, config.GetConfig().APIKeyValue)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	var issued models.APIKeySecretResponse
+
+	t.Run("Creating an API key should return 201 with the plaintext key", func(t *testing.T) {
+		rr := createKey(t, basePath, map[string]interface{}{"name": "ci", "environment": "development"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&issued))
+		require.Equal(t, "ci", issued.Name)
+		require.Equal(t, "development", issued.Environment)
+		require.True(t, strings.HasPrefix(issued.Key, utils.APIKeyPrefix))
+		require.True(t, strings.HasPrefix(issued.Key, issued.KeyPrefix))
+		require.Nil(t, issued.LastUsedAt)
+	})
+
+	t.Run("Listing API keys should not return the plaintext key", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, basePath+"?environment=development", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NotContains(t, rr.Body.String(), issued.Key)
+
+		var response models.APIKeyListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 1, response.Total)
+		require.Equal(t, issued.ID, response.APIKeys[0].ID)
+	})
+
+	t.Run("Verifying an API key should return its scope and record its use", func(t *testing.T) {
+		rr := verifyKey(t, issued.Key)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.VerifyAPIKeyResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, issued.ID, response.KeyID)
+		require.Equal(t, apiKeyProjectUID, response.ProjectUID)
+		require.Equal(t, apiKeyComponentUID, response.ComponentUID)
+		require.Equal(t, apiKeyEnvironmentUID, response.EnvironmentUID)
+
+		req := httptest.NewRequest(http.MethodGet, basePath, nil)
+		listRR := httptest.NewRecorder()
+		app.ServeHTTP(listRR, req)
+		var list models.APIKeyListResponse
+		require.NoError(t, json.NewDecoder(listRR.Body).Decode(&list))
+		require.NotNil(t, list.APIKeys[0].LastUsedAt)
+	})
+
+	t.Run("Verifying an unknown API key should return 401", func(t *testing.T) {
+		rr := verifyKey(t, utils.APIKeyPrefix+"unknown")
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Rotating an API key should invalidate the previous key", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/rotate", basePath, issued.ID), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var rotated models.APIKeySecretResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&rotated))
+		require.Equal(t, issued.ID, rotated.ID)
+		require.NotEqual(t, issued.Key, rotated.Key)
+		require.NotNil(t, rotated.RotatedAt)
+
+		require.Equal(t, http.StatusUnauthorized, verifyKey(t, issued.Key).Code)
+		require.Equal(t, http.StatusOK, verifyKey(t, rotated.Key).Code)
+		issued = rotated
+	})
+
+	t.Run("Revoking an API key should return 204", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", basePath, issued.ID), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		require.Equal(t, http.StatusUnauthorized, verifyKey(t, issued.Key).Code)
+
+		// Revoking it again should return 404
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", basePath, issued.ID), nil))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	validationTests := []struct {
+		name       string
+		path       string
+		payload    map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "return 400 on missing environment",
+			path:       basePath,
+			payload:    map[string]interface{}{"name": "ci"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "return 404 on unknown environment",
+			path:       basePath,
+			payload:    map[string]interface{}{"environment": "production"},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "return 400 for an internal agent",
+			path:       fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/api-keys", apiKeyOrgName, apiKeyProjName, internalAgentName),
+			payload:    map[string]interface{}{"environment": "development"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "return 404 for an unknown agent",
+			path:       fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/unknown-agent/api-keys", apiKeyOrgName, apiKeyProjName),
+			payload:    map[string]interface{}{"environment": "development"},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := createKey(t, tt.path, tt.payload)
+			require.Equal(t, tt.wantStatus, rr.Code)
+		})
+	}
+}
@@ -74,3 +74,30 @@ func CreateAgent(t *testing.T, agentID uuid.UUID, orgID uuid.UUID, projectID uui
 	t.Logf("Created Agent: %s", str)
 	return *agent
 }
+
+func CreateEvalDataset(t *testing.T, projectID uuid.UUID, createdBy uuid.UUID, name string, items []models.DatasetItem) models.EvalDataset {
+	dataset := &models.EvalDataset{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		Name:      name,
+		Version:   1,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+	err := db.DB(context.Background()).Create(dataset).Error
+	require.NoError(t, err)
+	for i, item := range items {
+		err := db.DB(context.Background()).Create(&models.EvalDatasetItem{
+			ID:        uuid.New(),
+			DatasetID: dataset.ID,
+			Position:  i,
+			TraceID:   item.TraceID,
+			AgentName: item.AgentName,
+			Input:     item.Input,
+			Output:    item.Output,
+		}).Error
+		require.NoError(t, err)
+	}
+	t.Logf("Created Dataset: %s with %d items", name, len(items))
+	return *dataset
+}
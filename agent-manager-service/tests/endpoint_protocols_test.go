@@ -0,0 +1,215 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestEndpointProtocols(t *testing.T) {
+	protocolOrgId := uuid.New()
+	protocolProjId := uuid.New()
+	protocolUserIdpId := uuid.New()
+	protocolOrgName := fmt.Sprintf("protocol-org-%s", uuid.New().String()[:5])
+	protocolProjName := fmt.Sprintf("protocol-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, protocolOrgId, protocolUserIdpId, protocolOrgName)
+	_ = apitestutils.CreateProject(t, protocolProjId, protocolOrgId, protocolProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, protocolOrgId, protocolUserIdpId)
+
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		return &models.EnvironmentResponse{Name: environmentName, UUID: uuid.New().String()}, nil
+	}
+	openChoreoClient.GetAgentEndpointsFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.EndpointsResponse, error) {
+		primaryName := fmt.Sprintf("%s-endpoint", agentName)
+		return map[string]models.EndpointsResponse{
+			primaryName: {Endpoint: models.Endpoint{Name: primaryName, URL: "https://dev.example.com/" + agentName, Visibility: "Public"}, Type: "GRPC"},
+			"chat":      {Endpoint: models.Endpoint{Name: "chat", URL: "https://dev.example.com/" + agentName + "/chat", Visibility: "Public"}, Type: "WEBSOCKET"},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	customAPIPayload := func(name string, inputInterface map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"displayName": "Streaming Agent",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/streaming-agent",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": map[string]interface{}{"type": "api", "subType": "custom-api"},
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+			"inputInterface": inputInterface,
+		}
+	}
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", protocolOrgName, protocolProjName)
+
+	t.Run("Creating a gRPC agent described by a proto file should return 202", func(t *testing.T) {
+		agentName := fmt.Sprintf("grpc-agent-%s", uuid.New().String()[:5])
+		rr := send(t, http.MethodPost, agentsPath, customAPIPayload(agentName, map[string]interface{}{
+			"type":     "GRPC",
+			"port":     50051,
+			"basePath": "/",
+			"schema":   map[string]interface{}{"path": "proto/agent.proto"},
+		}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, agentName, createComponentCall.Req.Name)
+		require.Equal(t, "GRPC", createComponentCall.Req.InputInterface.Type)
+		require.Equal(t, "proto/agent.proto", createComponentCall.Req.InputInterface.Schema.Path)
+	})
+
+	reflectionAgentName := fmt.Sprintf("grpc-agent-%s", uuid.New().String()[:5])
+
+	t.Run("Creating a gRPC agent described by reflection with a WebSocket endpoint should return 202", func(t *testing.T) {
+		payload := customAPIPayload(reflectionAgentName, map[string]interface{}{
+			"type":     "GRPC",
+			"port":     50051,
+			"basePath": "/",
+			"schema":   map[string]interface{}{"path": "", "reflection": true},
+		})
+		payload["endpoints"] = []map[string]interface{}{
+			{"name": "chat", "port": 8080, "type": "WEBSOCKET", "basePath": "/ws"},
+		}
+		rr := send(t, http.MethodPost, agentsPath, payload)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.True(t, createComponentCall.Req.InputInterface.Schema.GetReflection())
+		require.Len(t, createComponentCall.Req.Endpoints, 1)
+		require.Equal(t, "WEBSOCKET", createComponentCall.Req.Endpoints[0].Type)
+	})
+
+	t.Run("Getting the endpoints of a gRPC agent should return their types and reflection", func(t *testing.T) {
+		rr := send(t, http.MethodGet, fmt.Sprintf("%s/%s/endpoints?environment=development", agentsPath, reflectionAgentName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response map[string]spec.EndpointConfiguration
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		primary, ok := response[fmt.Sprintf("%s-endpoint", reflectionAgentName)]
+		require.True(t, ok)
+		require.Equal(t, "GRPC", primary.GetType())
+		require.True(t, primary.Schema.GetReflection())
+		chat, ok := response["chat"]
+		require.True(t, ok)
+		require.Equal(t, "WEBSOCKET", chat.GetType())
+		require.False(t, chat.Schema.GetReflection())
+	})
+
+	validationTests := []struct {
+		name           string
+		inputInterface map[string]interface{}
+		extra          map[string]interface{}
+		wantErrMsg     string
+	}{
+		{
+			name:           "return 400 on gRPC endpoint without a proto file or reflection",
+			inputInterface: map[string]interface{}{"type": "GRPC", "port": 50051, "basePath": "/", "schema": map[string]interface{}{"path": ""}},
+			wantErrMsg:     "inputInterface.schema must set either the path of a proto file or reflection",
+		},
+		{
+			name:           "return 400 on gRPC endpoint with a schema that is not a proto file",
+			inputInterface: map[string]interface{}{"type": "GRPC", "port": 50051, "basePath": "/", "schema": map[string]interface{}{"path": "openapi.yaml"}},
+			wantErrMsg:     "inputInterface.schema.path must point to a .proto file",
+		},
+		{
+			name:           "return 400 on gRPC endpoint with both a proto file and reflection",
+			inputInterface: map[string]interface{}{"type": "GRPC", "port": 50051, "basePath": "/", "schema": map[string]interface{}{"path": "agent.proto", "reflection": true}},
+			wantErrMsg:     "must not set both a path and reflection",
+		},
+		{
+			name:           "return 400 on reflection for an HTTP endpoint",
+			inputInterface: map[string]interface{}{"type": "HTTP", "port": 8000, "basePath": "/", "schema": map[string]interface{}{"path": "openapi.yaml", "reflection": true}},
+			wantErrMsg:     "inputInterface.schema.reflection is only supported for GRPC endpoints",
+		},
+		{
+			name:           "return 400 on unsupported endpoint type",
+			inputInterface: map[string]interface{}{"type": "TCP", "port": 9000, "basePath": "/", "schema": map[string]interface{}{"path": ""}},
+			wantErrMsg:     "unsupported inputInterface type: TCP",
+		},
+		{
+			name:           "return 400 on WebSocket endpoint for a chat agent",
+			inputInterface: map[string]interface{}{"type": "WEBSOCKET", "port": 8000, "basePath": "/", "schema": map[string]interface{}{"path": ""}},
+			extra:          map[string]interface{}{"agentType": map[string]interface{}{"type": "api", "subType": "chat-api"}},
+			wantErrMsg:     "inputInterface type WEBSOCKET is only supported for custom-api agents",
+		},
+		{
+			name:           "return 400 on additional gRPC endpoint without a schema",
+			inputInterface: map[string]interface{}{"type": "WEBSOCKET", "port": 8000, "basePath": "/", "schema": map[string]interface{}{"path": ""}},
+			extra: map[string]interface{}{
+				"endpoints": []map[string]interface{}{{"name": "rpc", "port": 50051, "type": "GRPC"}},
+			},
+			wantErrMsg: "endpoints[0]: schema must set either the path of a proto file or reflection",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := customAPIPayload(fmt.Sprintf("protocol-agent-%s", uuid.New().String()[:5]), tt.inputInterface)
+			for key, value := range tt.extra {
+				payload[key] = value
+			}
+			rr := send(t, http.MethodPost, agentsPath, payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
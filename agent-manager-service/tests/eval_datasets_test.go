@@ -0,0 +1,240 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// createMockTraceObserverClientForDatasets returns traces whose root spans record their input and output
+// in different GenAI conventions, and one trace without any GenAI attributes
+func createMockTraceObserverClientForDatasets() *clientmocks.TraceObserverClientMock {
+	rootAttributes := map[string]map[string]interface{}{
+		"trace-id-1": {
+			"traceloop.entity.input":  `{"question":"What is the capital of France?"}`,
+			"traceloop.entity.output": `{"answer":"Paris"}`,
+		},
+		"trace-id-2": {
+			"gen_ai.prompt.0.role":        "system",
+			"gen_ai.prompt.0.content":     "You are a helpful assistant",
+			"gen_ai.prompt.1.role":        "user",
+			"gen_ai.prompt.1.content":     "What is 2 + 2?",
+			"gen_ai.completion.0.content": "4",
+		},
+		"trace-id-3": {
+			"http.method": "GET",
+		},
+	}
+	return &clientmocks.TraceObserverClientMock{
+		ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+			return &traceobserversvc.TraceOverviewResponse{
+				Traces: []traceobserversvc.TraceOverview{
+					{TraceID: "trace-id-1", RootSpanID: "root-span", SpanCount: 2},
+					{TraceID: "trace-id-2", RootSpanID: "root-span", SpanCount: 2},
+					{TraceID: "trace-id-3", RootSpanID: "root-span", SpanCount: 2},
+				},
+				TotalCount: 3,
+			}, nil
+		},
+		TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			return &traceobserversvc.TraceResponse{
+				Spans: []traceobserversvc.Span{
+					{TraceID: params.TraceID, SpanID: "child-span", ParentSpanID: "root-span", Name: "llm.call"},
+					{TraceID: params.TraceID, SpanID: "root-span", Name: "agent.run", Attributes: rootAttributes[params.TraceID]},
+				},
+				TotalCount: 2,
+			}, nil
+		},
+	}
+}
+
+func TestEvalDatasets(t *testing.T) {
+	// Create unique test data for this test suite
+	datasetOrgId := uuid.New()
+	datasetUserIdpId := uuid.New()
+	datasetProjId := uuid.New()
+	datasetOrgName := fmt.Sprintf("dataset-org-%s", uuid.New().String()[:5])
+	datasetProjName := fmt.Sprintf("dataset-project-%s", uuid.New().String()[:5])
+	datasetAgentName := fmt.Sprintf("dataset-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, datasetOrgId, datasetUserIdpId, datasetOrgName)
+	_ = apitestutils.CreateProject(t, datasetProjId, datasetOrgId, datasetProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), datasetOrgId, datasetProjId, datasetAgentName, "external")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, datasetOrgId, datasetUserIdpId)
+
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: createMockOpenChoreoClient(),
+		TraceObserverClient: createMockTraceObserverClientForDatasets(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	basePath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/datasets", datasetOrgName, datasetProjName)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Creating a dataset from a trace filter should save the root span input and output", func(t *testing.T) {
+		rr := send(t, http.MethodPost, basePath, map[string]interface{}{
+			"name":        "regression",
+			"description": "Answers reviewed by the team",
+			"traces":      map[string]interface{}{"agentName": datasetAgentName, "limit": 10},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var response models.DatasetResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "regression", response.Name)
+		require.Equal(t, 1, response.Version)
+		require.Equal(t, datasetUserIdpId.String(), response.CreatedBy)
+		require.Equal(t, 2, response.ItemCount)
+		require.Equal(t, `{"question":"What is the capital of France?"}`, response.Items[0].Input)
+		require.Equal(t, `{"answer":"Paris"}`, response.Items[0].Output)
+		require.Equal(t, "What is 2 + 2?", response.Items[1].Input)
+		require.Equal(t, "4", response.Items[1].Output)
+		require.Equal(t, []string{"trace-id-3"}, response.SkippedTraceIDs)
+	})
+
+	t.Run("Creating a dataset with an existing name should return 409", func(t *testing.T) {
+		rr := send(t, http.MethodPost, basePath, map[string]interface{}{
+			"name":   "regression",
+			"traces": map[string]interface{}{"agentName": datasetAgentName, "traceIds": []string{"trace-id-1"}},
+		})
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Creating a dataset from traces without GenAI input should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodPost, basePath, map[string]interface{}{
+			"name":   "empty",
+			"traces": map[string]interface{}{"agentName": datasetAgentName, "traceIds": []string{"trace-id-3"}},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Creating a dataset for an unknown agent should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodPost, basePath, map[string]interface{}{
+			"name":   "unknown",
+			"traces": map[string]interface{}{"agentName": "unknown-agent", "traceIds": []string{"trace-id-1"}},
+		})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Combining trace IDs with a filter should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodPost, basePath, map[string]interface{}{
+			"name":   "mixed",
+			"traces": map[string]interface{}{"agentName": datasetAgentName, "traceIds": []string{"trace-id-1"}, "label": "good"},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Editing a dataset should create a new version", func(t *testing.T) {
+		items := []models.DatasetItem{{Input: "What is 3 + 3?", Output: "6"}}
+		rr := send(t, http.MethodPut, basePath+"/regression", map[string]interface{}{
+			"items":     items,
+			"addTraces": map[string]interface{}{"agentName": datasetAgentName, "traceIds": []string{"trace-id-1"}},
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.DatasetResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 2, response.Version)
+		require.Equal(t, "Answers reviewed by the team", response.Description)
+		require.Equal(t, 2, response.ItemCount)
+		require.Equal(t, "What is 3 + 3?", response.Items[0].Input)
+		require.Equal(t, "trace-id-1", response.Items[1].TraceID)
+	})
+
+	t.Run("Getting a dataset should return the requested version", func(t *testing.T) {
+		rr := send(t, http.MethodGet, basePath+"/regression", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var latest models.DatasetResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&latest))
+		require.Equal(t, 2, latest.Version)
+
+		rr = send(t, http.MethodGet, basePath+"/regression?version=1", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var first models.DatasetResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&first))
+		require.Equal(t, 1, first.Version)
+		require.Equal(t, "What is 2 + 2?", first.Items[1].Input)
+
+		rr = send(t, http.MethodGet, basePath+"/regression?version=5", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Listing datasets should return the latest versions", func(t *testing.T) {
+		rr := send(t, http.MethodGet, basePath, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.DatasetListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 1, response.Total)
+		require.Equal(t, "regression", response.Datasets[0].Name)
+		require.Equal(t, 2, response.Datasets[0].LatestVersion)
+		require.Equal(t, 2, response.Datasets[0].ItemCount)
+	})
+
+	t.Run("Exporting a dataset should return one JSON item per line", func(t *testing.T) {
+		rr := send(t, http.MethodGet, basePath+"/regression/export?version=1", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		require.Contains(t, rr.Header().Get("Content-Disposition"), "regression-v1.jsonl")
+
+		var lines []models.DatasetItem
+		scanner := bufio.NewScanner(rr.Body)
+		for scanner.Scan() {
+			var item models.DatasetItem
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &item))
+			lines = append(lines, item)
+		}
+		require.Len(t, lines, 2)
+		require.Equal(t, "trace-id-2", lines[1].TraceID)
+	})
+
+	t.Run("Deleting a dataset should remove all versions", func(t *testing.T) {
+		rr := send(t, http.MethodDelete, basePath+"/regression", nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = send(t, http.MethodGet, basePath+"/regression?version=1", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
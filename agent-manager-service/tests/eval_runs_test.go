@@ -0,0 +1,472 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// stubAgent is a local agent serving the default chat API. It answers 2 + 2 wrong until fixed,
+// and records how many requests it handles at once.
+type stubAgent struct {
+	fixed      atomic.Bool
+	inFlight   atomic.Int32
+	mu         sync.Mutex
+	maxFlight  int32
+	sessionIds []string
+}
+
+func (a *stubAgent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	current := a.inFlight.Add(1)
+	defer a.inFlight.Add(-1)
+
+	var req struct {
+		Message   string `json:"message"`
+		SessionID string `json:"session_id"`
+	}
+	if r.URL.Path != "/chat" || json.NewDecoder(r.Body).Decode(&req) != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	a.mu.Lock()
+	a.maxFlight = max(a.maxFlight, current)
+	a.sessionIds = append(a.sessionIds, req.SessionID)
+	a.mu.Unlock()
+	// Keep the request in flight long enough for concurrent requests to overlap
+	time.Sleep(20 * time.Millisecond)
+
+	answer := "I don't know"
+	switch {
+	case strings.Contains(req.Message, "capital of France"):
+		answer = `{"answer":"Paris"}`
+	case strings.Contains(req.Message, "2 + 2") && a.fixed.Load():
+		answer = "4"
+	case strings.Contains(req.Message, "2 + 2"):
+		answer = "5"
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"response": answer})
+}
+
+// newStubEmbeddingServer serves an OpenAI compatible embeddings API that embeds equal texts identically
+// and different texts orthogonally
+func newStubEmbeddingServer(t *testing.T) *httptest.Server {
+	vectors := map[string][]float64{
+		`{"answer":"Paris"}`: {1, 0, 0},
+		"4":                  {0, 1, 0},
+		"5":                  {0, 0, 1},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []string `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		data := make([]map[string]interface{}, len(req.Input))
+		for i, input := range req.Input {
+			data[i] = map[string]interface{}{"index": i, "embedding": vectors[input]}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newStubJudgeServer serves an OpenAI compatible chat completions API whose judge scores a response 1 when it
+// repeats the expected response and 0 otherwise
+func newStubJudgeServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Messages []struct {
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		prompt := req.Messages[len(req.Messages)-1].Content
+		verdict := `{"score": 0, "reason": "The response is wrong"}`
+		if strings.Contains(prompt, "Expected response:\n4\n") && strings.HasSuffix(prompt, "Response:\n4") ||
+			strings.HasSuffix(prompt, `Response:
+{"answer":"Paris"}`) {
+			verdict = `{"score": 1, "reason": "The response is correct"}`
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{
+				{"message": map[string]string{"role": "assistant", "content": verdict}},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEvalRuns(t *testing.T) {
+	// Create unique test data for this test suite
+	runOrgId := uuid.New()
+	runUserIdpId := uuid.New()
+	runProjId := uuid.New()
+	runOrgName := fmt.Sprintf("eval-run-org-%s", uuid.New().String()[:5])
+	runProjName := fmt.Sprintf("eval-run-project-%s", uuid.New().String()[:5])
+	runAgentName := fmt.Sprintf("eval-run-agent-%s", uuid.New().String()[:5])
+	externalAgentName := fmt.Sprintf("eval-run-ext-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, runOrgId, runUserIdpId, runOrgName)
+	_ = apitestutils.CreateProject(t, runProjId, runOrgId, runProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), runOrgId, runProjId, runAgentName, "internal")
+	_ = apitestutils.CreateAgent(t, uuid.New(), runOrgId, runProjId, externalAgentName, "external")
+	_ = apitestutils.CreateEvalDataset(t, runProjId, runUserIdpId, "regression", []models.DatasetItem{
+		{Input: "What is the capital of France?", Output: `{"answer":"Paris"}`},
+		{Input: "What is 2 + 2?", Output: "4"},
+	})
+	authMiddleware := jwtassertion.NewMockMiddleware(t, runOrgId, runUserIdpId)
+
+	agent := &stubAgent{}
+	agentServer := httptest.NewServer(agent)
+	t.Cleanup(agentServer.Close)
+
+	evaluationConfig := config.GetConfig().Evaluation
+	t.Cleanup(func() { config.GetConfig().Evaluation = evaluationConfig })
+	config.GetConfig().Evaluation.Embedding.URL = newStubEmbeddingServer(t).URL
+	config.GetConfig().Evaluation.Judge.URL = newStubJudgeServer(t).URL
+
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		if environmentName != "development" {
+			return nil, utils.ErrEnvironmentNotFound
+		}
+		return &models.EnvironmentResponse{Name: environmentName, UUID: uuid.New().String()}, nil
+	}
+	openChoreoClient.GetAgentEndpointsFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.EndpointsResponse, error) {
+		return map[string]models.EndpointsResponse{
+			"default": {Endpoint: models.Endpoint{Name: "default", URL: agentServer.URL + "/", Visibility: "Public"}},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	basePath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/eval-runs", runOrgName, runProjName)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// startRun creates a run and waits for it to finish
+	startRun := func(t *testing.T, payload map[string]interface{}) models.EvalRunResponse {
+		rr := send(t, http.MethodPost, basePath, payload)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		var created models.EvalRunResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&created))
+		require.Equal(t, utils.EvalRunStatusRunning, created.Status)
+
+		var run models.EvalRunResponse
+		require.Eventually(t, func() bool {
+			rr := send(t, http.MethodGet, basePath+"/"+created.ID, nil)
+			require.Equal(t, http.StatusOK, rr.Code)
+			run = models.EvalRunResponse{}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&run))
+			return run.Status != utils.EvalRunStatusRunning
+		}, 10*time.Second, 50*time.Millisecond)
+		return run
+	}
+
+	allEvaluators := []map[string]interface{}{
+		{"type": "exact_match"},
+		{"type": "regex", "name": "mentions-answer", "pattern": `Paris|\d`},
+		{"type": "embedding_similarity", "threshold": 0.9},
+		{"type": "llm_judge", "criteria": "Is the answer correct?"},
+	}
+	var baseRunId string
+
+	t.Run("Running a dataset should score every output with each evaluator", func(t *testing.T) {
+		run := startRun(t, map[string]interface{}{
+			"datasetName": "regression",
+			"agentName":   runAgentName,
+			"environment": "development",
+			"concurrency": 1,
+			"evaluators":  allEvaluators,
+		})
+		baseRunId = run.ID
+
+		require.Equal(t, utils.EvalRunStatusCompleted, run.Status)
+		require.Equal(t, agentServer.URL+"/chat", run.EndpointURL)
+		require.Equal(t, 1, run.DatasetVersion)
+		require.Equal(t, 2, run.TotalItems)
+		require.Equal(t, 2, run.CompletedItems)
+		require.NotNil(t, run.CompletedAt)
+		require.Len(t, run.Results, 2)
+
+		require.Equal(t, `{"answer":"Paris"}`, run.Results[0].Output)
+		require.True(t, run.Results[0].Passed)
+		require.Len(t, run.Results[0].Scores, 4)
+		for _, score := range run.Results[0].Scores {
+			require.True(t, score.Passed, score.Evaluator)
+			require.Equal(t, 1.0, score.Score, score.Evaluator)
+		}
+
+		require.Equal(t, "5", run.Results[1].Output)
+		require.False(t, run.Results[1].Passed)
+		scores := map[string]models.EvaluatorScore{}
+		for _, score := range run.Results[1].Scores {
+			scores[score.Evaluator] = score
+		}
+		require.False(t, scores["exact_match"].Passed)
+		require.True(t, scores["mentions-answer"].Passed)
+		require.False(t, scores["embedding_similarity"].Passed)
+		require.Equal(t, 0.0, scores["embedding_similarity"].Score)
+		require.False(t, scores["llm_judge"].Passed)
+		require.Equal(t, "The response is wrong", scores["llm_judge"].Reason)
+
+		require.NotNil(t, run.Summary)
+		require.Equal(t, 2, run.Summary.TotalItems)
+		require.Equal(t, 1, run.Summary.PassedItems)
+		require.Equal(t, 0.5, run.Summary.PassRate)
+		require.Len(t, run.Summary.Evaluators, 4)
+		require.Equal(t, "exact_match", run.Summary.Evaluators[0].Evaluator)
+		require.Equal(t, 0.5, run.Summary.Evaluators[0].AverageScore)
+
+		agent.mu.Lock()
+		defer agent.mu.Unlock()
+		require.Equal(t, int32(1), agent.maxFlight)
+		require.Contains(t, agent.sessionIds, fmt.Sprintf("eval-%s-1", run.ID))
+	})
+
+	t.Run("Comparing runs should report items that improved", func(t *testing.T) {
+		agent.fixed.Store(true)
+		run := startRun(t, map[string]interface{}{
+			"datasetName": "regression",
+			"agentName":   runAgentName,
+			"environment": "development",
+			"evaluators":  allEvaluators,
+		})
+		require.Equal(t, utils.EvalRunStatusCompleted, run.Status)
+		require.Equal(t, utils.EvalRunDefaultConcurrency, run.Concurrency)
+		require.Equal(t, 1.0, run.Summary.PassRate)
+
+		rr := send(t, http.MethodGet, fmt.Sprintf("%s/%s/compare?baseRunId=%s", basePath, run.ID, baseRunId), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var comparison models.EvalRunComparisonResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&comparison))
+		require.Equal(t, baseRunId, comparison.BaseRunID)
+		require.Equal(t, run.ID, comparison.CandidateRunID)
+		require.Equal(t, 0.5, comparison.PassRateDelta)
+		require.Equal(t, 0.5, comparison.AverageScoreDeltas["exact_match"])
+		require.Equal(t, 1, comparison.Improved)
+		require.Equal(t, 1, comparison.Unchanged)
+		require.Equal(t, 0, comparison.Regressed)
+		require.Equal(t, 0, comparison.Unmatched)
+		for _, item := range comparison.Items {
+			if item.Input == "What is 2 + 2?" {
+				require.Equal(t, utils.EvalRunChangeImproved, item.Change)
+				require.Equal(t, 1.0, item.ScoreDeltas["exact_match"])
+			}
+		}
+	})
+
+	t.Run("A JSON schema evaluator should pass only outputs valid against the schema", func(t *testing.T) {
+		run := startRun(t, map[string]interface{}{
+			"datasetName": "regression",
+			"agentName":   runAgentName,
+			"environment": "development",
+			"evaluators": []map[string]interface{}{
+				{"type": "json_schema", "schema": map[string]interface{}{
+					"type":       "object",
+					"required":   []string{"answer"},
+					"properties": map[string]interface{}{"answer": map[string]interface{}{"type": "string"}},
+				}},
+			},
+		})
+		require.Equal(t, utils.EvalRunStatusCompleted, run.Status)
+		require.True(t, run.Results[0].Passed)
+		require.False(t, run.Results[1].Passed)
+		require.NotEmpty(t, run.Results[1].Scores[0].Reason)
+	})
+
+	t.Run("Items the agent fails on should be recorded with their error", func(t *testing.T) {
+		run := startRun(t, map[string]interface{}{
+			"datasetName": "regression",
+			"agentName":   runAgentName,
+			"environment": "development",
+			"path":        "/missing",
+			"evaluators":  []map[string]interface{}{{"type": "exact_match"}},
+		})
+		require.Equal(t, utils.EvalRunStatusCompleted, run.Status)
+		require.Equal(t, 2, run.Summary.ErroredItems)
+		require.Equal(t, 0, run.Summary.PassedItems)
+		require.Contains(t, run.Results[0].Error, "500")
+		require.Empty(t, run.Results[0].Scores)
+	})
+
+	t.Run("Listing runs should return the runs of a dataset", func(t *testing.T) {
+		rr := send(t, http.MethodGet, basePath+"?datasetName=regression", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response models.EvalRunListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 4, response.Total)
+		require.Empty(t, response.Runs[0].Results)
+
+		rr = send(t, http.MethodGet, basePath+"?datasetName=other", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		response = models.EvalRunListResponse{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 0, response.Total)
+	})
+
+	t.Run("Runs interrupted by a restart should be marked as failed at startup", func(t *testing.T) {
+		interrupted := &models.EvalRun{
+			ID:             uuid.New(),
+			ProjectID:      runProjId,
+			DatasetName:    "regression",
+			DatasetVersion: 1,
+			AgentName:      runAgentName,
+			Environment:    "development",
+			EndpointURL:    agentServer.URL + "/chat",
+			Status:         utils.EvalRunStatusRunning,
+			Concurrency:    1,
+			Evaluators:     []models.EvaluatorConfig{{Type: "exact_match", Name: "exact_match"}},
+			TotalItems:     2,
+			CreatedBy:      runUserIdpId,
+			CreatedAt:      time.Now(),
+		}
+		require.NoError(t, db.DB(context.Background()).Create(interrupted).Error)
+
+		appParams, err := wiring.InitializeTestAppParamsWithClientMocks(config.GetConfig(), authMiddleware, testClients)
+		require.NoError(t, err)
+		require.NoError(t, appParams.EvalRunManagerService.FailInterruptedEvalRuns(context.Background()))
+
+		rr := send(t, http.MethodGet, basePath+"/"+interrupted.ID.String(), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var run models.EvalRunResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&run))
+		require.Equal(t, utils.EvalRunStatusFailed, run.Status)
+		require.Contains(t, run.Error, "interrupted")
+		require.NotNil(t, run.CompletedAt)
+
+		rr = send(t, http.MethodGet, fmt.Sprintf("%s/%s/compare?baseRunId=%s", basePath, interrupted.ID, baseRunId), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	})
+
+	validationTests := []struct {
+		name           string
+		payload        map[string]interface{}
+		wantStatus     int
+		wantErrMessage string
+	}{
+		{
+			name:           "Creating a run without evaluators should return 400",
+			payload:        map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "development"},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "evaluators must have between 1 and",
+		},
+		{
+			name: "Creating a run with an invalid regex should return 400",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "development",
+				"evaluators": []map[string]interface{}{{"type": "regex", "pattern": "("}}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "invalid pattern",
+		},
+		{
+			name: "Creating a run with an unknown evaluator should return 400",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "development",
+				"evaluators": []map[string]interface{}{{"type": "bleu"}}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "unknown evaluator type",
+		},
+		{
+			name: "Creating a run with duplicate evaluator names should return 400",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "development",
+				"evaluators": []map[string]interface{}{{"type": "exact_match"}, {"type": "exact_match"}}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "used more than once",
+		},
+		{
+			name: "Creating a run with too high concurrency should return 400",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "development",
+				"concurrency": 1000, "evaluators": []map[string]interface{}{{"type": "exact_match"}}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "concurrency must be between 1 and",
+		},
+		{
+			name: "Creating a run for an external agent should return 400",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": externalAgentName, "environment": "development",
+				"evaluators": []map[string]interface{}{{"type": "exact_match"}}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "only supported for agents deployed by the platform",
+		},
+		{
+			name: "Creating a run for an unknown endpoint should return 400",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "development",
+				"endpointName": "admin", "evaluators": []map[string]interface{}{{"type": "exact_match"}}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMessage: "Agent endpoint not found",
+		},
+		{
+			name: "Creating a run for an unknown environment should return 404",
+			payload: map[string]interface{}{"datasetName": "regression", "agentName": runAgentName, "environment": "staging",
+				"evaluators": []map[string]interface{}{{"type": "exact_match"}}},
+			wantStatus:     http.StatusNotFound,
+			wantErrMessage: "Environment not found",
+		},
+		{
+			name: "Creating a run of an unknown dataset should return 404",
+			payload: map[string]interface{}{"datasetName": "missing", "agentName": runAgentName, "environment": "development",
+				"evaluators": []map[string]interface{}{{"type": "exact_match"}}},
+			wantStatus:     http.StatusNotFound,
+			wantErrMessage: "Dataset not found",
+		},
+	}
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := send(t, http.MethodPost, basePath, tt.payload)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMessage)
+		})
+	}
+
+	t.Run("Getting an unknown run should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodGet, basePath+"/"+uuid.New().String(), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
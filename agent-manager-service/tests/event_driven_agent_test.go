@@ -0,0 +1,291 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/queuesvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// startJetStreamServer runs an embedded NATS server with JetStream enabled for the duration of the test
+func startJetStreamServer(t *testing.T) string {
+	t.Helper()
+	ns, err := natsserver.NewServer(&natsserver.Options{
+		Host:      "127.0.0.1",
+		Port:      natsserver.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+	go ns.Start()
+	t.Cleanup(ns.Shutdown)
+	require.True(t, ns.ReadyForConnections(10*time.Second), "NATS server did not start")
+	return ns.ClientURL()
+}
+
+func TestEventDrivenAgent(t *testing.T) {
+	eventOrgId := uuid.New()
+	eventProjId := uuid.New()
+	eventUserIdpId := uuid.New()
+	eventOrgName := fmt.Sprintf("event-org-%s", uuid.New().String()[:5])
+	eventProjName := fmt.Sprintf("event-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, eventOrgId, eventUserIdpId, eventOrgName)
+	_ = apitestutils.CreateProject(t, eventProjId, eventOrgId, eventProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, eventOrgId, eventUserIdpId)
+
+	// Messages published to the stream before the agent's consumer processes any of them
+	const pendingMessages = 7
+	natsURL := startJetStreamServer(t)
+	nc, err := nats.Connect(natsURL)
+	require.NoError(t, err)
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = js.CreateStream(ctx, jetstream.StreamConfig{Name: "ORDERS", Subjects: []string{"orders.>"}})
+	require.NoError(t, err)
+	for i := 0; i < pendingMessages; i++ {
+		_, err := js.Publish(ctx, "orders.created", []byte(fmt.Sprintf(`{"orderId": %d}`, i)))
+		require.NoError(t, err)
+	}
+	_, err = js.CreateConsumer(ctx, "ORDERS", jetstream.ConsumerConfig{
+		Durable:       "order-processor",
+		FilterSubject: "orders.created",
+		AckPolicy:     jetstream.AckExplicitPolicy,
+	})
+	require.NoError(t, err)
+
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetAgentDeploymentsFunc = func(ctx context.Context, orgName string, pipelineName string, projName string, componentName string) ([]*models.DeploymentResponse, error) {
+		return []*models.DeploymentResponse{
+			{
+				AgentName:      componentName,
+				ProjectName:    projName,
+				ImageId:        "registry.local/order-processor:latest",
+				Status:         openchoreosvc.DeploymentStatusActive,
+				Environment:    "development",
+				LastDeployedAt: time.Now(),
+				Endpoints:      []models.Endpoint{},
+			},
+			{
+				AgentName:   componentName,
+				ProjectName: projName,
+				Status:      openchoreosvc.DeploymentStatusNotDeployed,
+				Environment: "production",
+				Endpoints:   []models.Endpoint{},
+			},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+		QueueClient:         queuesvc.NewQueueClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	eventAgentPayload := func(name string, queue map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"displayName": "Order Processor",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/order-processor",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": map[string]interface{}{"type": "event-driven"},
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+			"inputInterface": map[string]interface{}{
+				"type":  "QUEUE",
+				"queue": queue,
+			},
+		}
+	}
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", eventOrgName, eventProjName)
+
+	natsAgentName := fmt.Sprintf("event-agent-%s", uuid.New().String()[:5])
+	kafkaAgentName := fmt.Sprintf("event-agent-%s", uuid.New().String()[:5])
+
+	t.Run("Creating an event-driven agent should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, agentsPath, eventAgentPayload(natsAgentName, map[string]interface{}{
+			"broker":        "nats",
+			"url":           natsURL,
+			"topic":         "orders.created",
+			"consumerGroup": "order-processor",
+			"concurrency":   4,
+		}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, natsAgentName, createComponentCall.Req.Name)
+		require.Equal(t, "event-driven", createComponentCall.Req.AgentType.Type)
+		require.NotNil(t, createComponentCall.Req.InputInterface)
+		require.NotNil(t, createComponentCall.Req.InputInterface.Queue)
+		require.Equal(t, "orders.created", createComponentCall.Req.InputInterface.Queue.Topic)
+		require.Equal(t, int32(4), createComponentCall.Req.InputInterface.Queue.GetConcurrency())
+	})
+
+	t.Run("Getting deployments of an event-driven agent should report the consumer lag", func(t *testing.T) {
+		rr := send(t, http.MethodGet, fmt.Sprintf("%s/%s/deployments", agentsPath, natsAgentName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response map[string]spec.DeploymentDetailsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Contains(t, response, "development")
+		queue := response["development"].Queue
+		require.NotNil(t, queue)
+		require.Equal(t, "nats", queue.Broker)
+		require.Equal(t, "orders.created", queue.Topic)
+		require.Equal(t, "order-processor", queue.ConsumerGroup)
+		require.Equal(t, int64(pendingMessages), queue.GetConsumerLag())
+		require.False(t, queue.HasError())
+
+		require.Contains(t, response, "production")
+		require.Nil(t, response["production"].Queue)
+	})
+
+	t.Run("Creating an event-driven agent without a consumer group should default it to the agent name", func(t *testing.T) {
+		rr := send(t, http.MethodPost, agentsPath, eventAgentPayload(kafkaAgentName, map[string]interface{}{
+			"broker": "kafka",
+			"url":    "127.0.0.1:1",
+			"topic":  "orders",
+		}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Getting deployments should report an unreachable broker without failing", func(t *testing.T) {
+		rr := send(t, http.MethodGet, fmt.Sprintf("%s/%s/deployments", agentsPath, kafkaAgentName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response map[string]spec.DeploymentDetailsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		queue := response["development"].Queue
+		require.NotNil(t, queue)
+		require.Equal(t, "kafka", queue.Broker)
+		require.Equal(t, kafkaAgentName, queue.ConsumerGroup)
+		require.False(t, queue.HasConsumerLag())
+		require.True(t, queue.HasError())
+	})
+
+	validationTests := []struct {
+		name       string
+		queue      map[string]interface{}
+		extra      map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 on unsupported broker",
+			queue:      map[string]interface{}{"broker": "rabbitmq", "url": "amqp://localhost:5672", "topic": "orders"},
+			wantErrMsg: "unsupported inputInterface.queue.broker",
+		},
+		{
+			name:       "return 400 on NATS url with an unsupported scheme",
+			queue:      map[string]interface{}{"broker": "nats", "url": "http://localhost:4222", "topic": "orders"},
+			wantErrMsg: "inputInterface.queue.url",
+		},
+		{
+			name:       "return 400 on invalid NATS subject",
+			queue:      map[string]interface{}{"broker": "nats", "url": "nats://localhost:4222", "topic": "orders..created"},
+			wantErrMsg: "inputInterface.queue.topic must be a valid NATS subject",
+		},
+		{
+			name:       "return 400 on invalid Kafka topic",
+			queue:      map[string]interface{}{"broker": "kafka", "url": "localhost:9092", "topic": "orders/created"},
+			wantErrMsg: "inputInterface.queue.topic",
+		},
+		{
+			name:       "return 400 on concurrency out of range",
+			queue:      map[string]interface{}{"broker": "nats", "url": "nats://localhost:4222", "topic": "orders", "concurrency": 0},
+			wantErrMsg: "inputInterface.queue.concurrency must be between 1 and 100",
+		},
+		{
+			name:       "return 400 on missing queue settings",
+			extra:      map[string]interface{}{"inputInterface": map[string]interface{}{"type": "QUEUE"}},
+			wantErrMsg: "inputInterface.queue is required",
+		},
+		{
+			name:       "return 400 on HTTP input interface for an event-driven agent",
+			extra:      map[string]interface{}{"inputInterface": map[string]interface{}{"type": "HTTP", "port": 8000}},
+			wantErrMsg: "unsupported inputInterface type for event-driven agents",
+		},
+		{
+			name: "return 400 on queue settings for an api agent",
+			extra: map[string]interface{}{
+				"agentType": map[string]interface{}{"type": "api", "subType": "chat-api"},
+				"inputInterface": map[string]interface{}{
+					"type":  "HTTP",
+					"queue": map[string]interface{}{"broker": "nats", "url": "nats://localhost:4222", "topic": "orders"},
+				},
+			},
+			wantErrMsg: "inputInterface.queue is only supported for event-driven agents",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := eventAgentPayload(fmt.Sprintf("event-agent-%s", uuid.New().String()[:5]), tt.queue)
+			for key, value := range tt.extra {
+				payload[key] = value
+			}
+			rr := send(t, http.MethodPost, agentsPath, payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const exportTraceID = "5974d036b3d7709f2fc9f2b48461c176"
+
+func createMockTraceObserverClientForExport() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		ExportTraceFunc: func(ctx context.Context, params traceobserversvc.ExportTraceParams) (json.RawMessage, error) {
+			if params.TraceID != exportTraceID {
+				return nil, utils.ErrTraceNotFound
+			}
+			return json.RawMessage(`{"resourceSpans":[{"resource":{"attributes":[]},"scopeSpans":[{"scope":{},"spans":[{"traceId":"` + exportTraceID + `"}]}]}]}`), nil
+		},
+		ExportTracesFunc: func(ctx context.Context, params traceobserversvc.ExportTracesParams) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("{\"resourceSpans\":[]}\n{\"resourceSpans\":[]}\n")), nil
+		},
+	}
+}
+
+func TestExportTraces(t *testing.T) {
+	// Create unique test data for this test suite
+	exportOrgId := uuid.New()
+	exportUserIdpId := uuid.New()
+	exportProjId := uuid.New()
+	exportOrgName := fmt.Sprintf("export-org-%s", uuid.New().String()[:5])
+	exportProjName := fmt.Sprintf("export-project-%s", uuid.New().String()[:5])
+	exportAgentName := fmt.Sprintf("export-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, exportOrgId, exportUserIdpId, exportOrgName)
+	_ = apitestutils.CreateProject(t, exportProjId, exportOrgId, exportProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), exportOrgId, exportProjId, exportAgentName, "internal")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, exportOrgId, exportUserIdpId)
+
+	agentPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", exportOrgName, exportProjName, exportAgentName)
+
+	t.Run("Exporting a trace as OTLP JSON should return 200", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForExport()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s/trace/%s/export?environment=development", agentPath, exportTraceID)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Header().Get("Content-Disposition"), exportTraceID)
+
+		var response map[string]interface{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Contains(t, response, "resourceSpans")
+
+		// The format defaults to OTLP JSON
+		call := traceObserverClient.ExportTraceCalls()[0]
+		require.Equal(t, utils.TraceExportFormatOTLPJSON, call.Params.Format)
+		require.Equal(t, sessionComponentUID, call.Params.ComponentUID)
+		require.Equal(t, sessionEnvironmentUID, call.Params.EnvironmentUID)
+	})
+
+	t.Run("Exporting an unknown trace should return 404", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForExport(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s/trace/unknown-trace/export?environment=development&format=jaeger", agentPath)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Exporting a trace with an unsupported format should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForExport(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s/trace/%s/export?environment=development&format=zipkin", agentPath, exportTraceID)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Bulk exporting traces should stream NDJSON", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForExport()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s/traces/export?environment=development&format=jaeger&startTime=2025-12-01T00:00:00Z&endTime=2025-12-02T00:00:00Z", agentPath)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		require.Len(t, lines, 2)
+
+		call := traceObserverClient.ExportTracesCalls()[0]
+		require.Equal(t, utils.TraceExportFormatJaeger, call.Params.Format)
+		require.Equal(t, "2025-12-01T00:00:00Z", call.Params.StartTime)
+	})
+
+	t.Run("Bulk exporting traces without a time range should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForExport(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("%s/traces/export?environment=development", agentPath), nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
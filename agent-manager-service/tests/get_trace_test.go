@@ -32,6 +32,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
 )
@@ -87,6 +88,62 @@ func createMockTraceObserverClientWithDetails() *clientmocks.TraceObserverClient
 	}
 }
 
+// createMockTraceObserverClientWithSpanEvents returns a trace whose child span failed with an exception event and
+// links to a span of another trace
+func createMockTraceObserverClientWithSpanEvents() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			return &traceobserversvc.TraceResponse{
+				Spans: []traceobserversvc.Span{
+					{
+						TraceID:         params.TraceID,
+						SpanID:          "span-1",
+						Name:            "GET /api/endpoint",
+						Service:         params.ServiceName,
+						StartTime:       time.Date(2025, 12, 16, 10, 0, 0, 0, time.UTC),
+						EndTime:         time.Date(2025, 12, 16, 10, 0, 2, 0, time.UTC),
+						DurationInNanos: 2000000000,
+						Kind:            "server",
+						Status:          "1",
+					},
+					{
+						TraceID:         params.TraceID,
+						SpanID:          "span-2",
+						ParentSpanID:    "span-1",
+						Name:            "database query",
+						Service:         params.ServiceName,
+						StartTime:       time.Date(2025, 12, 16, 10, 0, 0, 500000000, time.UTC),
+						EndTime:         time.Date(2025, 12, 16, 10, 0, 1, 0, time.UTC),
+						DurationInNanos: 500000000,
+						Kind:            "client",
+						Status:          "2",
+						StatusMessage:   "connection refused",
+						Events: []traceobserversvc.SpanEvent{
+							{
+								Name: "exception",
+								Time: time.Date(2025, 12, 16, 10, 0, 0, 900000000, time.UTC),
+								Attributes: map[string]interface{}{
+									"exception.type":    "psycopg2.OperationalError",
+									"exception.message": "connection refused",
+								},
+								Exception: &traceobserversvc.SpanException{
+									Type:       "psycopg2.OperationalError",
+									Message:    "connection refused",
+									Stacktrace: "Traceback (most recent call last): ...",
+								},
+							},
+						},
+						Links: []traceobserversvc.SpanLink{
+							{TraceID: "linked-trace-id", SpanID: "linked-span-id"},
+						},
+					},
+				},
+				TotalCount: 2,
+			}, nil
+		},
+	}
+}
+
 func TestGetTrace(t *testing.T) {
 	// Create unique test data for this test suite
 	traceDetailsOrgId := uuid.New()
@@ -162,4 +219,136 @@ func TestGetTrace(t *testing.T) {
 		require.Equal(t, traceDetailsAgentName, traceDetailsCall.Params.ServiceName)
 		// Note: limit and sortOrder are hardcoded internally and not exposed as API parameters
 	})
+	t.Run("Getting trace details should include span status, events and links", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: createMockTraceObserverClientWithSpanEvents(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-456")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Spans, 2)
+
+		// Status codes are normalized and the status message is kept
+		require.Nil(t, response.Spans[0].Events)
+		require.Equal(t, "OK", response.Spans[0].StatusDetails.Code)
+		span := response.Spans[1]
+		require.Equal(t, "ERROR", span.StatusDetails.Code)
+		require.Equal(t, "connection refused", span.StatusDetails.Message)
+
+		// Exception details are passed through
+		require.Len(t, span.Events, 1)
+		require.Equal(t, "exception", span.Events[0].Name)
+		require.NotNil(t, span.Events[0].Exception)
+		require.Equal(t, "psycopg2.OperationalError", span.Events[0].Exception.Type)
+		require.Contains(t, span.Events[0].Exception.Stacktrace, "Traceback")
+
+		require.Len(t, span.Links, 1)
+		require.Equal(t, "linked-trace-id", span.Links[0].TraceID)
+	})
+}
+
+func TestGetTraceTree(t *testing.T) {
+	// Create unique test data for this test suite
+	traceTreeOrgId := uuid.New()
+	traceTreeUserIdpId := uuid.New()
+	traceTreeProjId := uuid.New()
+	traceTreeOrgName := fmt.Sprintf("trace-tree-org-%s", uuid.New().String()[:5])
+	traceTreeProjName := fmt.Sprintf("trace-tree-project-%s", uuid.New().String()[:5])
+	traceTreeAgentName := fmt.Sprintf("trace-tree-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, traceTreeOrgId, traceTreeUserIdpId, traceTreeOrgName)
+	_ = apitestutils.CreateProject(t, traceTreeProjId, traceTreeOrgId, traceTreeProjName)
+	// The tree view resolves the agent component and environment, so the agent must exist
+	_ = apitestutils.CreateAgent(t, uuid.New(), traceTreeOrgId, traceTreeProjId, traceTreeAgentName, "internal")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, traceTreeOrgId, traceTreeUserIdpId)
+
+	t.Run("Getting trace details as a tree should return 200", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetTraceTreeFunc: func(ctx context.Context, params traceobserversvc.TraceTreeParams) (*traceobserversvc.TraceTreeResponse, error) {
+				root := &traceobserversvc.SpanNode{
+					Span:            traceobserversvc.Span{TraceID: params.TraceID, SpanID: "span-1", Name: "GET /api/endpoint", DurationInNanos: 2000000000},
+					SelfTimeInNanos: 1500000000,
+					CriticalPath:    true,
+					Children: []*traceobserversvc.SpanNode{
+						{
+							Span:            traceobserversvc.Span{TraceID: params.TraceID, SpanID: "span-2", ParentSpanID: "span-1", Name: "database query", DurationInNanos: 500000000},
+							Depth:           1,
+							OffsetInNanos:   500000000,
+							SelfTimeInNanos: 500000000,
+							CriticalPath:    true,
+						},
+					},
+				}
+				return &traceobserversvc.TraceTreeResponse{
+					TraceID:         params.TraceID,
+					DurationInNanos: 2000000000,
+					Roots:           []*traceobserversvc.SpanNode{root},
+					TotalCount:      2,
+				}, nil
+			},
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		traceID := "trace-id-789"
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?view=tree&environment=development",
+			traceTreeOrgName, traceTreeProjName, traceTreeAgentName, traceID)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceTreeResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, traceID, response.TraceID)
+		require.Len(t, response.Roots, 1)
+		require.True(t, response.Roots[0].CriticalPath)
+		require.Len(t, response.Roots[0].Children, 1)
+		child := response.Roots[0].Children[0]
+		require.Equal(t, "span-2", child.SpanID)
+		require.Equal(t, 1, child.Depth)
+		require.Equal(t, int64(500000000), child.OffsetInNanos)
+
+		call := traceObserverClient.GetTraceTreeCalls()[0]
+		require.Equal(t, sessionComponentUID, call.Params.ComponentUID)
+		require.Equal(t, sessionEnvironmentUID, call.Params.EnvironmentUID)
+	})
+
+	t.Run("Getting trace details as a tree without an environment should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientWithDetails(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?view=tree",
+			traceTreeOrgName, traceTreeProjName, traceTreeAgentName, "trace-id-789")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
@@ -0,0 +1,330 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestJobAgent(t *testing.T) {
+	jobOrgId := uuid.New()
+	jobProjId := uuid.New()
+	jobUserIdpId := uuid.New()
+	jobOrgName := fmt.Sprintf("job-org-%s", uuid.New().String()[:5])
+	jobProjName := fmt.Sprintf("job-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, jobOrgId, jobUserIdpId, jobOrgName)
+	_ = apitestutils.CreateProject(t, jobProjId, jobOrgId, jobProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, jobOrgId, jobUserIdpId)
+
+	componentUUID := uuid.New().String()
+	environmentUUID := uuid.New().String()
+	startedAt := time.Date(2025, 6, 1, 2, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(5 * time.Minute)
+	runs := &models.JobRunsResponse{
+		Schedule: models.JobScheduleResponse{Schedule: "0 2 * * *"},
+		Runs: []models.JobRunResponse{
+			{
+				Name:        "report-job-29145720",
+				Environment: "development",
+				Trigger:     string(utils.JobRunTriggerScheduled),
+				Status:      string(utils.JobRunStatusSucceeded),
+				StartedAt:   &startedAt,
+				CompletedAt: &completedAt,
+				Namespace:   "dp-job-org-development",
+			},
+		},
+		Total: 1,
+	}
+
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		if environmentName != "development" {
+			return nil, utils.ErrEnvironmentNotFound
+		}
+		return &models.EnvironmentResponse{Name: environmentName, UUID: environmentUUID}, nil
+	}
+	openChoreoClient.GetAgentComponentFunc = func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
+		return &openchoreosvc.AgentComponent{Name: agentName, UUID: componentUUID}, nil
+	}
+	openChoreoClient.TriggerJobRunFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error) {
+		return &models.JobRunResponse{
+			Name:        fmt.Sprintf("%s-manual-abc123", agentName),
+			Environment: environment,
+			Trigger:     string(utils.JobRunTriggerManual),
+			Status:      string(utils.JobRunStatusPending),
+		}, nil
+	}
+	openChoreoClient.ListJobRunsFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunsResponse, error) {
+		return runs, nil
+	}
+	observabilityClient := &clientmocks.ObservabilitySvcClientMock{
+		GetComponentLogsFunc: func(ctx context.Context, componentId string, params observabilitysvc.ComponentLogsParams) (*models.ComponentLogsResponse, error) {
+			return &models.ComponentLogsResponse{
+				Logs: []models.LogEntry{
+					{Log: "generating report", Labels: map[string]string{"job-name": "report-job-29145720"}},
+					{Log: "other run", Labels: map[string]string{"job-name": "report-job-29144280"}},
+				},
+				TotalCount: 2,
+			}, nil
+		},
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient:    openChoreoClient,
+		ObservabilitySvcClient: observabilityClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	jobAgentPayload := func(name string, agentType string, jobConfig map[string]interface{}) map[string]interface{} {
+		payload := map[string]interface{}{
+			"name":        name,
+			"displayName": "Nightly Report",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/nightly-report",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": map[string]interface{}{"type": agentType},
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+		}
+		if jobConfig != nil {
+			payload["jobConfig"] = jobConfig
+		}
+		return payload
+	}
+
+	jobAgentName := fmt.Sprintf("job-agent-%s", uuid.New().String()[:5])
+	jobRunsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/job-runs", jobOrgName, jobProjName, jobAgentName)
+
+	t.Run("Creating a job agent should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", jobOrgName, jobProjName),
+			jobAgentPayload(jobAgentName, "job", map[string]interface{}{
+				"schedule":          "0 2 * * MON-FRI",
+				"timeoutSeconds":    600,
+				"retries":           2,
+				"concurrencyPolicy": "Replace",
+			}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, jobAgentName, createComponentCall.Req.Name)
+		require.Equal(t, "job", createComponentCall.Req.AgentType.Type)
+		require.NotNil(t, createComponentCall.Req.JobConfig)
+		require.Equal(t, "0 2 * * MON-FRI", createComponentCall.Req.JobConfig.GetSchedule())
+		require.Equal(t, int32(600), createComponentCall.Req.JobConfig.GetTimeoutSeconds())
+		require.Equal(t, int32(2), createComponentCall.Req.JobConfig.GetRetries())
+		require.Equal(t, "Replace", createComponentCall.Req.JobConfig.GetConcurrencyPolicy())
+	})
+
+	t.Run("Creating a job agent without a schedule should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", jobOrgName, jobProjName),
+			jobAgentPayload(fmt.Sprintf("job-agent-%s", uuid.New().String()[:5]), "job", nil))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Triggering a job run should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, jobRunsPath, map[string]interface{}{"environment": "development"})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var run models.JobRunResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&run))
+		require.Equal(t, fmt.Sprintf("%s-manual-abc123", jobAgentName), run.Name)
+		require.Equal(t, "manual", run.Trigger)
+		require.Equal(t, "Pending", run.Status)
+
+		calls := openChoreoClient.TriggerJobRunCalls()
+		require.NotEmpty(t, calls)
+		require.Equal(t, jobAgentName, calls[len(calls)-1].AgentName)
+		require.Equal(t, "development", calls[len(calls)-1].Environment)
+	})
+
+	t.Run("Triggering a job run without an environment should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodPost, jobRunsPath, map[string]interface{}{})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Triggering a job run in an unknown environment should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodPost, jobRunsPath, map[string]interface{}{"environment": "staging"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Triggering a job run of an unknown agent should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/unknown-agent/job-runs", jobOrgName, jobProjName),
+			map[string]interface{}{"environment": "development"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Triggering a job run of an agent that is not a job should return 400", func(t *testing.T) {
+		openChoreoClient.TriggerJobRunFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (*models.JobRunResponse, error) {
+			return nil, utils.ErrAgentNotJob
+		}
+		rr := send(t, http.MethodPost, jobRunsPath, map[string]interface{}{"environment": "development"})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "Job runs are only supported for job agents")
+	})
+
+	t.Run("Listing job runs should return the schedule and runs", func(t *testing.T) {
+		rr := send(t, http.MethodGet, jobRunsPath+"?environment=development", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.JobRunsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "0 2 * * *", response.Schedule.Schedule)
+		require.Equal(t, 1, response.Total)
+		require.Equal(t, "report-job-29145720", response.Runs[0].Name)
+		require.Equal(t, "Succeeded", response.Runs[0].Status)
+		require.NotContains(t, rr.Body.String(), "dp-job-org-development")
+	})
+
+	t.Run("Listing job runs without an environment should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodGet, jobRunsPath, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Getting job run logs should return the logs of the run only", func(t *testing.T) {
+		rr := send(t, http.MethodGet, jobRunsPath+"/report-job-29145720/logs?environment=development", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.JobRunLogsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "report-job-29145720", response.Run.Name)
+		require.Equal(t, 1, response.TotalCount)
+		require.Equal(t, "generating report", response.Logs[0].Log)
+
+		calls := observabilityClient.GetComponentLogsCalls()
+		require.NotEmpty(t, calls)
+		logsCall := calls[len(calls)-1]
+		require.Equal(t, componentUUID, logsCall.ComponentId)
+		require.Equal(t, environmentUUID, logsCall.Params.EnvironmentId)
+		require.Equal(t, "dp-job-org-development", logsCall.Params.Namespace)
+		require.Equal(t, startedAt.Add(-time.Minute), logsCall.Params.StartTime)
+		require.Equal(t, completedAt.Add(time.Minute), logsCall.Params.EndTime)
+	})
+
+	t.Run("Getting logs of an unknown job run should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodGet, jobRunsPath+"/unknown-run/logs?environment=development", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "Job run not found")
+	})
+
+	validationTests := []struct {
+		name       string
+		agentType  string
+		jobConfig  map[string]interface{}
+		extra      map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 on invalid cron schedule",
+			agentType:  "job",
+			jobConfig:  map[string]interface{}{"schedule": "0 25 * * *"},
+			wantErrMsg: "invalid schedule",
+		},
+		{
+			name:       "return 400 on cron schedule with too few fields",
+			agentType:  "job",
+			jobConfig:  map[string]interface{}{"schedule": "0 2 * *"},
+			wantErrMsg: "invalid schedule",
+		},
+		{
+			name:       "return 400 on non positive timeout",
+			agentType:  "job",
+			jobConfig:  map[string]interface{}{"timeoutSeconds": 0},
+			wantErrMsg: "timeoutSeconds must be greater than 0",
+		},
+		{
+			name:       "return 400 on retries out of range",
+			agentType:  "job",
+			jobConfig:  map[string]interface{}{"retries": 11},
+			wantErrMsg: "retries must be between 0 and 10",
+		},
+		{
+			name:       "return 400 on unsupported concurrency policy",
+			agentType:  "job",
+			jobConfig:  map[string]interface{}{"concurrencyPolicy": "Queue"},
+			wantErrMsg: "unsupported concurrencyPolicy: Queue",
+		},
+		{
+			name:       "return 400 on input interface for a job agent",
+			agentType:  "job",
+			extra:      map[string]interface{}{"inputInterface": map[string]interface{}{"type": "HTTP", "port": 8000}},
+			wantErrMsg: "inputInterface is not supported for job agents",
+		},
+		{
+			name:      "return 400 on job config for an api agent",
+			agentType: "api",
+			jobConfig: map[string]interface{}{"schedule": "@daily"},
+			extra: map[string]interface{}{
+				"agentType":      map[string]interface{}{"type": "api", "subType": "chat-api"},
+				"inputInterface": map[string]interface{}{"type": "HTTP"},
+			},
+			wantErrMsg: "jobConfig is only supported for job agents",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := jobAgentPayload(fmt.Sprintf("job-agent-%s", uuid.New().String()[:5]), tt.agentType, tt.jobConfig)
+			for key, value := range tt.extra {
+				payload[key] = value
+			}
+			rr := send(t, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", jobOrgName, jobProjName), payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// newStubMCPServer serves a streamable HTTP MCP server at /mcp with one tool, one resource and one prompt
+func newStubMCPServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mcp" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Notifications are accepted without a reply
+		if request.ID == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		if request.Method != "initialize" && r.Header.Get("Mcp-Session-Id") != "stub-session" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var result interface{}
+		switch request.Method {
+		case "initialize":
+			result = map[string]interface{}{
+				"protocolVersion": "2025-03-26",
+				"serverInfo":      map[string]string{"name": "weather", "version": "1.2.0"},
+				"capabilities": map[string]interface{}{
+					"tools":     map[string]interface{}{},
+					"resources": map[string]interface{}{},
+					"prompts":   map[string]interface{}{},
+				},
+			}
+		case "tools/list":
+			result = map[string]interface{}{
+				"tools": []interface{}{
+					map[string]interface{}{
+						"name":        "get_forecast",
+						"description": "Get the weather forecast for a city",
+						"inputSchema": map[string]interface{}{
+							"type":       "object",
+							"properties": map[string]interface{}{"city": map[string]string{"type": "string"}},
+						},
+					},
+				},
+			}
+		case "resources/list":
+			result = map[string]interface{}{
+				"resources": []interface{}{
+					map[string]interface{}{"uri": "weather://cities", "name": "cities", "mimeType": "application/json"},
+				},
+			}
+		case "prompts/list":
+			result = map[string]interface{}{
+				"prompts": []interface{}{
+					map[string]interface{}{
+						"name":      "plan_trip",
+						"arguments": []interface{}{map[string]interface{}{"name": "city", "required": true}},
+					},
+				},
+			}
+		}
+		response, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": result})
+		require.NoError(t, err)
+
+		w.Header().Set("Mcp-Session-Id", "stub-session")
+		// The tool list is streamed to cover servers that answer on an event stream
+		if request.Method == "tools/list" {
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", response)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMCPServerAgent(t *testing.T) {
+	mcpOrgId := uuid.New()
+	mcpProjId := uuid.New()
+	mcpUserIdpId := uuid.New()
+	mcpOrgName := fmt.Sprintf("mcp-org-%s", uuid.New().String()[:5])
+	mcpProjName := fmt.Sprintf("mcp-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, mcpOrgId, mcpUserIdpId, mcpOrgName)
+	_ = apitestutils.CreateProject(t, mcpProjId, mcpOrgId, mcpProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, mcpOrgId, mcpUserIdpId)
+
+	mcpServer := newStubMCPServer(t)
+	unreachableServer := httptest.NewServer(http.NotFoundHandler())
+	unreachableServer.Close()
+
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		return &models.EnvironmentResponse{Name: environmentName, UUID: uuid.New().String()}, nil
+	}
+	endpointURLs := map[string]string{}
+	openChoreoClient.GetAgentEndpointsFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.EndpointsResponse, error) {
+		name := fmt.Sprintf("%s-endpoint", agentName)
+		return map[string]models.EndpointsResponse{
+			name: {Endpoint: models.Endpoint{Name: name, URL: endpointURLs[agentName], Visibility: "Public"}},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", mcpOrgName, mcpProjName)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	mcpAgentPayload := func(name string, agentType map[string]interface{}, inputInterface map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"displayName": "Weather MCP Server",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/weather-mcp",
+					"branch":  "main",
+					"appPath": "server",
+				},
+			},
+			"agentType": agentType,
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python server.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+			"inputInterface": inputInterface,
+		}
+	}
+
+	getEndpoint := func(t *testing.T, agentName string) models.EndpointsResponse {
+		rr := send(t, http.MethodGet, fmt.Sprintf("%s/%s/endpoints?environment=development", agentsPath, agentName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var endpoints map[string]models.EndpointsResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&endpoints))
+		endpoint, ok := endpoints[fmt.Sprintf("%s-endpoint", agentName)]
+		require.True(t, ok, "endpoint of %s not found", agentName)
+		return endpoint
+	}
+
+	streamableAgentName := fmt.Sprintf("mcp-agent-%s", uuid.New().String()[:5])
+	sseAgentName := fmt.Sprintf("mcp-agent-%s", uuid.New().String()[:5])
+
+	t.Run("Creating an MCP server agent should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, agentsPath, mcpAgentPayload(streamableAgentName,
+			map[string]interface{}{"type": "mcp-server"},
+			map[string]interface{}{
+				"type":      "HTTP",
+				"port":      8080,
+				"transport": map[string]interface{}{"type": "streamable-http"},
+			},
+		))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, streamableAgentName, createComponentCall.Req.Name)
+		require.Equal(t, "mcp-server", createComponentCall.Req.AgentType.Type)
+		require.NotNil(t, createComponentCall.Req.InputInterface.Transport)
+		require.Equal(t, "streamable-http", createComponentCall.Req.InputInterface.Transport.Type)
+	})
+
+	t.Run("Creating an MCP server agent with the SSE transport should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, agentsPath, mcpAgentPayload(sseAgentName,
+			map[string]interface{}{"type": "mcp-server"},
+			map[string]interface{}{
+				"type":      "HTTP",
+				"transport": map[string]interface{}{"type": "sse", "path": "/events"},
+			},
+		))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Getting endpoints of an MCP server agent should list its tools, resources and prompts", func(t *testing.T) {
+		endpointURLs[streamableAgentName] = mcpServer.URL + "/"
+
+		endpoint := getEndpoint(t, streamableAgentName)
+		require.Empty(t, endpoint.Schema.Content)
+		require.NotNil(t, endpoint.MCP)
+		require.Empty(t, endpoint.MCP.IntrospectionError)
+		require.Equal(t, mcpServer.URL+"/mcp", endpoint.MCP.URL)
+		require.Equal(t, "streamable-http", endpoint.MCP.Transport)
+		require.Equal(t, "2025-03-26", endpoint.MCP.ProtocolVersion)
+		require.Equal(t, "weather", endpoint.MCP.ServerInfo.Name)
+
+		require.Len(t, endpoint.MCP.Tools, 1)
+		require.Equal(t, "get_forecast", endpoint.MCP.Tools[0].Name)
+		require.Equal(t, "Get the weather forecast for a city", endpoint.MCP.Tools[0].Description)
+		require.JSONEq(t, `{"type":"object","properties":{"city":{"type":"string"}}}`, string(endpoint.MCP.Tools[0].InputSchema))
+
+		require.Len(t, endpoint.MCP.Resources, 1)
+		require.Equal(t, "weather://cities", endpoint.MCP.Resources[0].URI)
+		require.Equal(t, "application/json", endpoint.MCP.Resources[0].MimeType)
+
+		require.Len(t, endpoint.MCP.Prompts, 1)
+		require.Equal(t, "plan_trip", endpoint.MCP.Prompts[0].Name)
+		require.Len(t, endpoint.MCP.Prompts[0].Arguments, 1)
+		require.True(t, endpoint.MCP.Prompts[0].Arguments[0].Required)
+	})
+
+	t.Run("Getting endpoints of an unreachable MCP server should report the introspection error", func(t *testing.T) {
+		endpointURLs[sseAgentName] = unreachableServer.URL
+
+		endpoint := getEndpoint(t, sseAgentName)
+		require.NotNil(t, endpoint.MCP)
+		require.Equal(t, unreachableServer.URL+"/events", endpoint.MCP.URL)
+		require.Equal(t, "sse", endpoint.MCP.Transport)
+		require.NotEmpty(t, endpoint.MCP.IntrospectionError)
+		require.Empty(t, endpoint.MCP.Tools)
+	})
+
+	validationTests := []struct {
+		name           string
+		agentType      map[string]interface{}
+		inputInterface map[string]interface{}
+		wantErrMsg     string
+	}{
+		{
+			name:           "return 400 on MCP server agent with a subtype",
+			agentType:      map[string]interface{}{"type": "mcp-server", "subType": "chat-api"},
+			inputInterface: map[string]interface{}{"type": "HTTP"},
+			wantErrMsg:     "agent type mcp-server does not support subtypes",
+		},
+		{
+			name:           "return 400 on unsupported MCP transport",
+			agentType:      map[string]interface{}{"type": "mcp-server"},
+			inputInterface: map[string]interface{}{"type": "HTTP", "transport": map[string]interface{}{"type": "stdio"}},
+			wantErrMsg:     "unsupported inputInterface.transport.type: stdio",
+		},
+		{
+			name:           "return 400 on relative MCP transport path",
+			agentType:      map[string]interface{}{"type": "mcp-server"},
+			inputInterface: map[string]interface{}{"type": "HTTP", "transport": map[string]interface{}{"type": "sse", "path": "sse"}},
+			wantErrMsg:     "inputInterface.transport.path must start with '/'",
+		},
+		{
+			name:       "return 400 on MCP server agent without an input interface",
+			agentType:  map[string]interface{}{"type": "mcp-server"},
+			wantErrMsg: "inputInterface is required for internal agents",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := mcpAgentPayload(fmt.Sprintf("mcp-agent-%s", uuid.New().String()[:5]), tt.agentType, tt.inputInterface)
+			if tt.inputInterface == nil {
+				delete(payload, "inputInterface")
+			}
+			rr := send(t, http.MethodPost, agentsPath, payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
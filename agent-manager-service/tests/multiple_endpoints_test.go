@@ -0,0 +1,260 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestMultipleAgentEndpoints(t *testing.T) {
+	endpointsOrgId := uuid.New()
+	endpointsProjId := uuid.New()
+	endpointsUserIdpId := uuid.New()
+	endpointsOrgName := fmt.Sprintf("endpoints-org-%s", uuid.New().String()[:5])
+	endpointsProjName := fmt.Sprintf("endpoints-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, endpointsOrgId, endpointsUserIdpId, endpointsOrgName)
+	_ = apitestutils.CreateProject(t, endpointsProjId, endpointsOrgId, endpointsProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, endpointsOrgId, endpointsUserIdpId)
+
+	var updateEndpointsErr error
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+		return &models.EnvironmentResponse{Name: environmentName, UUID: uuid.New().String()}, nil
+	}
+	openChoreoClient.UpdateAgentEndpointsFunc = func(ctx context.Context, orgName string, projName string, agentName string, endpoints []spec.AgentEndpoint) error {
+		return updateEndpointsErr
+	}
+	openChoreoClient.GetAgentEndpointsFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.EndpointsResponse, error) {
+		primaryName := fmt.Sprintf("%s-endpoint", agentName)
+		return map[string]models.EndpointsResponse{
+			primaryName: {Endpoint: models.Endpoint{Name: primaryName, URL: "https://dev.example.com/" + agentName, Visibility: "Public"}},
+			"admin":     {Endpoint: models.Endpoint{Name: "admin", URL: "https://dev.example.com/" + agentName + "/admin", Visibility: "Public"}},
+			"metrics":   {Endpoint: models.Endpoint{Name: "metrics", URL: "http://" + agentName + ".dp-dev.svc.cluster.local:9090", Visibility: "Private"}},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	apiAgentPayload := func(name string, endpoints []map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"displayName": "Reading List Agent",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/reading-list",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": map[string]interface{}{"type": "api", "subType": "custom-api"},
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+			"inputInterface": map[string]interface{}{
+				"type":     "HTTP",
+				"port":     8000,
+				"basePath": "/reading-list",
+				"schema":   map[string]interface{}{"path": "openapi.yaml"},
+			},
+			"endpoints": endpoints,
+		}
+	}
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", endpointsOrgName, endpointsProjName)
+	agentName := fmt.Sprintf("endpoints-agent-%s", uuid.New().String()[:5])
+	endpointsPath := fmt.Sprintf("%s/%s/endpoints", agentsPath, agentName)
+
+	t.Run("Creating an api agent with additional endpoints should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPost, agentsPath, apiAgentPayload(agentName, []map[string]interface{}{
+			{"name": "admin", "port": 8001, "type": "HTTP", "basePath": "/admin", "schema": map[string]interface{}{"path": "admin.yaml"}},
+			{"name": "metrics", "port": 9090, "type": "HTTP", "visibility": "Private"},
+		}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, agentName, createComponentCall.Req.Name)
+		require.Len(t, createComponentCall.Req.Endpoints, 2)
+		require.Equal(t, "admin", createComponentCall.Req.Endpoints[0].Name)
+		require.Equal(t, int32(8001), createComponentCall.Req.Endpoints[0].Port)
+		require.Equal(t, "/admin", createComponentCall.Req.Endpoints[0].GetBasePath())
+		require.Equal(t, "metrics", createComponentCall.Req.Endpoints[1].Name)
+		require.Equal(t, string(utils.EndpointVisibilityPrivate), createComponentCall.Req.Endpoints[1].GetVisibility())
+	})
+
+	t.Run("Updating the endpoints of an api agent should return 202", func(t *testing.T) {
+		rr := send(t, http.MethodPut, endpointsPath, map[string]interface{}{
+			"endpoints": []map[string]interface{}{
+				{"name": "metrics", "port": 9091, "type": "HTTP", "visibility": "Private"},
+			},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.UpdateAgentEndpointsCalls()
+		require.NotEmpty(t, calls)
+		updateCall := calls[len(calls)-1]
+		require.Equal(t, agentName, updateCall.AgentName)
+		require.Len(t, updateCall.Endpoints, 1)
+		require.Equal(t, "metrics", updateCall.Endpoints[0].Name)
+		require.Equal(t, int32(9091), updateCall.Endpoints[0].Port)
+	})
+
+	t.Run("Getting the endpoints of an agent should return all of its endpoints", func(t *testing.T) {
+		rr := send(t, http.MethodGet, endpointsPath+"?environment=development", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response map[string]spec.EndpointConfiguration
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response, 3)
+		require.Contains(t, response, fmt.Sprintf("%s-endpoint", agentName))
+		require.Equal(t, "Public", response["admin"].Visibility)
+		require.Equal(t, "Private", response["metrics"].Visibility)
+	})
+
+	t.Run("Updating the endpoints with a port used by the primary endpoint should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodPut, endpointsPath, map[string]interface{}{
+			"endpoints": []map[string]interface{}{
+				{"name": "admin", "port": 8000, "type": "HTTP"},
+			},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "port 8000 is used by another endpoint")
+	})
+
+	t.Run("Updating the endpoints of an agent that does not support them should return 400", func(t *testing.T) {
+		updateEndpointsErr = utils.ErrAgentEndpointsUnsupported
+		defer func() { updateEndpointsErr = nil }()
+
+		rr := send(t, http.MethodPut, endpointsPath, map[string]interface{}{
+			"endpoints": []map[string]interface{}{},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "only supported for api agents")
+	})
+
+	t.Run("Updating the endpoints of a missing agent should return 404", func(t *testing.T) {
+		rr := send(t, http.MethodPut, fmt.Sprintf("%s/missing-agent/endpoints", agentsPath), map[string]interface{}{
+			"endpoints": []map[string]interface{}{},
+		})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	validationTests := []struct {
+		name       string
+		endpoints  []map[string]interface{}
+		extra      map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name: "return 400 on duplicate endpoint names",
+			endpoints: []map[string]interface{}{
+				{"name": "admin", "port": 8001, "type": "HTTP"},
+				{"name": "admin", "port": 8002, "type": "HTTP"},
+			},
+			wantErrMsg: "name admin is used by another endpoint",
+		},
+		{
+			name: "return 400 on a port used by the primary endpoint",
+			endpoints: []map[string]interface{}{
+				{"name": "admin", "port": 8000, "type": "HTTP"},
+			},
+			wantErrMsg: "port 8000 is used by another endpoint",
+		},
+		{
+			name: "return 400 on the service port of the primary endpoint",
+			endpoints: []map[string]interface{}{
+				{"name": "admin", "port": 80, "type": "HTTP"},
+			},
+			wantErrMsg: "reserved for the service port of the primary endpoint",
+		},
+		{
+			name: "return 400 on the reserved endpoint name",
+			endpoints: []map[string]interface{}{
+				{"name": "http", "port": 8001, "type": "HTTP"},
+			},
+			wantErrMsg: "endpoints[0]",
+		},
+		{
+			name: "return 400 on invalid visibility",
+			endpoints: []map[string]interface{}{
+				{"name": "admin", "port": 8001, "type": "HTTP", "visibility": "Internal"},
+			},
+			wantErrMsg: "visibility",
+		},
+		{
+			name: "return 400 on endpoints for an mcp-server agent",
+			endpoints: []map[string]interface{}{
+				{"name": "admin", "port": 8001, "type": "HTTP"},
+			},
+			extra: map[string]interface{}{
+				"agentType": map[string]interface{}{"type": "mcp-server"},
+				"inputInterface": map[string]interface{}{
+					"type":     "HTTP",
+					"port":     8000,
+					"basePath": "/",
+				},
+			},
+			wantErrMsg: "endpoints are only supported for api agents",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := apiAgentPayload(fmt.Sprintf("endpoints-agent-%s", uuid.New().String()[:5]), tt.endpoints)
+			for key, value := range tt.extra {
+				payload[key] = value
+			}
+			rr := send(t, http.MethodPost, agentsPath, payload)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
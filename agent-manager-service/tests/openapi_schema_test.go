@@ -0,0 +1,386 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const testOpenAPISchemaV1 = `openapi: 3.0.3
+info:
+  title: Support Agent
+  version: "1.0"
+paths:
+  /chat:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                message:
+                  type: string
+      responses:
+        "200":
+          description: Agent reply
+  /health:
+    get:
+      responses:
+        "200":
+          description: Healthy
+`
+
+// Drops /health and requires a session id, both of which break existing clients
+const testOpenAPISchemaV2 = `openapi: 3.0.3
+info:
+  title: Support Agent
+  version: "2.0"
+paths:
+  /chat:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [message, session]
+              properties:
+                message:
+                  type: string
+                session:
+                  type: string
+      responses:
+        "200":
+          description: Agent reply
+`
+
+func TestOpenAPISchemas(t *testing.T) {
+	schemaOrgId := uuid.New()
+	schemaProjId := uuid.New()
+	schemaUserIdpId := uuid.New()
+	schemaOrgName := fmt.Sprintf("schema-org-%s", uuid.New().String()[:5])
+	schemaProjName := fmt.Sprintf("schema-project-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, schemaOrgId, schemaUserIdpId, schemaOrgName)
+	_ = apitestutils.CreateProject(t, schemaProjId, schemaOrgId, schemaProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, schemaOrgId, schemaUserIdpId)
+
+	// Schemas deployed to each environment, keyed by environment and endpoint name
+	deployedSchemas := map[string]map[string]models.DeployedEndpointSchema{}
+	openChoreoClient := createMockOpenChoreoClient()
+	openChoreoClient.GetDeploymentPipelineFunc = func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
+		return &models.DeploymentPipelineResponse{
+			Name: deploymentPipelineName,
+			PromotionPaths: []models.PromotionPath{
+				{SourceEnvironmentRef: "development", TargetEnvironmentRefs: []models.TargetEnvironmentRef{{Name: "production"}}},
+			},
+		}, nil
+	}
+	openChoreoClient.GetDeployedEndpointSchemasFunc = func(ctx context.Context, orgName string, projName string, agentName string, environment string) (map[string]models.DeployedEndpointSchema, error) {
+		schemas, ok := deployedSchemas[environment]
+		if !ok {
+			return nil, utils.ErrAgentNotDeployed
+		}
+		return schemas, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: openChoreoClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	send := func(t *testing.T, method string, path string, payload interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	customAPIPayload := func(name string, inputInterface map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"displayName": "Support Agent",
+			"provisioning": map[string]interface{}{
+				"type": "internal",
+				"repository": map[string]interface{}{
+					"url":     "https://github.com/test/support-agent",
+					"branch":  "main",
+					"appPath": "agent",
+				},
+			},
+			"agentType": map[string]interface{}{"type": "api", "subType": "custom-api"},
+			"runtimeConfigs": map[string]interface{}{
+				"runCommand":      "python main.py",
+				"language":        "python",
+				"languageVersion": "3.11",
+			},
+			"inputInterface": inputInterface,
+		}
+	}
+	agentsPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", schemaOrgName, schemaProjName)
+
+	t.Run("Creating an agent with an inline OpenAPI schema should return 202", func(t *testing.T) {
+		agentName := fmt.Sprintf("schema-agent-%s", uuid.New().String()[:5])
+		rr := send(t, http.MethodPost, agentsPath, customAPIPayload(agentName, map[string]interface{}{
+			"type":     "HTTP",
+			"port":     8080,
+			"basePath": "/",
+			"schema":   map[string]interface{}{"content": testOpenAPISchemaV1},
+		}))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		calls := openChoreoClient.CreateAgentComponentCalls()
+		require.NotEmpty(t, calls)
+		createComponentCall := calls[len(calls)-1]
+		require.Equal(t, agentName, createComponentCall.Req.Name)
+		require.Equal(t, testOpenAPISchemaV1, createComponentCall.Req.InputInterface.Schema.GetContent())
+	})
+
+	t.Run("Creating agents with invalid schemas should return 400", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			interfaceType string
+			schema        map[string]interface{}
+			wantError     string
+		}{
+			{
+				name:          "content that is not OpenAPI",
+				interfaceType: "HTTP",
+				schema:        map[string]interface{}{"content": "swagger: '2.0'\ninfo: {title: t, version: '1'}\npaths: {}\n"},
+				wantError:     "unsupported specification version",
+			},
+			{
+				name:          "content without info",
+				interfaceType: "HTTP",
+				schema:        map[string]interface{}{"content": "openapi: 3.1.0\npaths: {}\n"},
+				wantError:     "info is required",
+			},
+			{
+				name:          "content with an unresolved reference",
+				interfaceType: "HTTP",
+				schema: map[string]interface{}{"content": "openapi: 3.0.3\ninfo: {title: t, version: '1'}\npaths:\n  /chat:\n    get:\n" +
+					"      responses:\n        '200':\n          description: ok\n          content:\n            application/json:\n" +
+					"              schema: {$ref: '#/components/schemas/Reply'}\n"},
+				wantError: "#/components/schemas/Reply",
+			},
+			{
+				name:          "both a path and content",
+				interfaceType: "HTTP",
+				schema:        map[string]interface{}{"path": "openapi.yaml", "content": testOpenAPISchemaV1},
+				wantError:     "must not set both a path and content",
+			},
+			{
+				name:          "content for a gRPC endpoint",
+				interfaceType: "GRPC",
+				schema:        map[string]interface{}{"path": "", "content": testOpenAPISchemaV1},
+				wantError:     "content is only supported for HTTP endpoints",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				agentName := fmt.Sprintf("schema-agent-%s", uuid.New().String()[:5])
+				rr := send(t, http.MethodPost, agentsPath, customAPIPayload(agentName, map[string]interface{}{
+					"type":     tc.interfaceType,
+					"port":     8080,
+					"basePath": "/",
+					"schema":   tc.schema,
+				}))
+				require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+				require.Contains(t, rr.Body.String(), tc.wantError)
+			})
+		}
+	})
+
+	buildAgentName := fmt.Sprintf("schema-agent-%s", uuid.New().String()[:5])
+
+	validateBuildSchema := func(t *testing.T, agentName string, schemaPath string, content string) *httptest.ResponseRecorder {
+		path := fmt.Sprintf("/internal/builds/schema-validation?orgName=%s&projectName=%s&agentName=%s&schemaPath=%s",
+			schemaOrgName, schemaProjName, agentName, schemaPath)
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(content))
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set(config.GetConfig().APIKeyHeader, config.GetConfig().APIKeyValue)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Validating the schema files of a build", func(t *testing.T) {
+		payload := customAPIPayload(buildAgentName, map[string]interface{}{
+			"type":     "HTTP",
+			"port":     8080,
+			"basePath": "/",
+			"schema":   map[string]interface{}{"path": "openapi.yaml"},
+		})
+		payload["endpoints"] = []map[string]interface{}{
+			{"name": "admin", "port": 9090, "type": "HTTP", "basePath": "/admin", "schema": map[string]interface{}{"path": "admin/openapi.yaml"}},
+			{"name": "rpc", "port": 50051, "type": "GRPC", "basePath": "/", "schema": map[string]interface{}{"path": "proto/agent.proto"}},
+		}
+		rr := send(t, http.MethodPost, agentsPath, payload)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		t.Run("A valid OpenAPI schema should return 200", func(t *testing.T) {
+			rr := validateBuildSchema(t, buildAgentName, "openapi.yaml", testOpenAPISchemaV1)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		})
+
+		t.Run("A broken schema of an additional endpoint should return 422", func(t *testing.T) {
+			rr := validateBuildSchema(t, buildAgentName, "admin/openapi.yaml", "openapi: 3.0.3\ninfo: {title: admin}\npaths: {}\n")
+			require.Equal(t, http.StatusUnprocessableEntity, rr.Code, rr.Body.String())
+
+			var response struct {
+				Problems []string `json:"problems"`
+			}
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+			require.Equal(t, []string{"info.version is required"}, response.Problems)
+		})
+
+		t.Run("A proto file should not be validated as OpenAPI", func(t *testing.T) {
+			rr := validateBuildSchema(t, buildAgentName, "proto/agent.proto", "syntax = \"proto3\";\n")
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		})
+
+		t.Run("A file no endpoint uses should return 404", func(t *testing.T) {
+			rr := validateBuildSchema(t, buildAgentName, "other.yaml", testOpenAPISchemaV1)
+			require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		})
+	})
+
+	schemaDiffPath := fmt.Sprintf("%s/%s/schema-diff", agentsPath, buildAgentName)
+	primaryEndpointName := fmt.Sprintf("%s-endpoint", buildAgentName)
+
+	getSchemaDiff := func(t *testing.T, environment string) spec.SchemaDiffResponse {
+		rr := send(t, http.MethodGet, schemaDiffPath+"?environment="+environment, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response spec.SchemaDiffResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		return response
+	}
+
+	t.Run("Promoting to an environment the agent is not deployed to should not break anything", func(t *testing.T) {
+		deployedSchemas["development"] = map[string]models.DeployedEndpointSchema{
+			primaryEndpointName: {Type: "HTTP", Content: testOpenAPISchemaV1},
+			"rpc":               {Type: "GRPC", Content: "syntax = \"proto3\";\n"},
+		}
+
+		response := getSchemaDiff(t, "development")
+		require.Equal(t, "production", response.TargetEnvironment)
+		require.False(t, response.HasBreakingChanges)
+		require.Len(t, response.Endpoints, 1)
+		require.Equal(t, primaryEndpointName, response.Endpoints[0].Name)
+		require.Equal(t, string(utils.SchemaDiffStatusAdded), response.Endpoints[0].Status)
+	})
+
+	t.Run("Promoting a schema with breaking changes should report them", func(t *testing.T) {
+		deployedSchemas["production"] = map[string]models.DeployedEndpointSchema{
+			primaryEndpointName: {Type: "HTTP", Content: testOpenAPISchemaV1},
+		}
+		deployedSchemas["development"] = map[string]models.DeployedEndpointSchema{
+			primaryEndpointName: {Type: "HTTP", Content: testOpenAPISchemaV2},
+		}
+
+		response := getSchemaDiff(t, "development")
+		require.True(t, response.HasBreakingChanges)
+		require.Len(t, response.Endpoints, 1)
+		endpointDiff := response.Endpoints[0]
+		require.Equal(t, string(utils.SchemaDiffStatusModified), endpointDiff.Status)
+		require.Positive(t, endpointDiff.BreakingChanges)
+
+		var removedHealth, requiredSession bool
+		for _, change := range endpointDiff.Changes {
+			if change.GetPath() == "/health" && change.ChangeType == "REMOVED" {
+				require.True(t, change.Breaking)
+				removedHealth = true
+			}
+			if change.GetPath() == "/chat" && change.Property == "required" && change.GetNew() == "session" {
+				require.True(t, change.Breaking)
+				requiredSession = true
+			}
+		}
+		require.True(t, removedHealth, "removing /health should be reported")
+		require.True(t, requiredSession, "requiring session should be reported")
+	})
+
+	t.Run("Promoting an unchanged schema should not report changes", func(t *testing.T) {
+		deployedSchemas["development"] = deployedSchemas["production"]
+
+		response := getSchemaDiff(t, "development")
+		require.False(t, response.HasBreakingChanges)
+		require.Equal(t, string(utils.SchemaDiffStatusUnchanged), response.Endpoints[0].Status)
+		require.Empty(t, response.Endpoints[0].Changes)
+	})
+
+	t.Run("Removing an endpoint should be reported as breaking", func(t *testing.T) {
+		deployedSchemas["production"] = map[string]models.DeployedEndpointSchema{
+			primaryEndpointName: {Type: "HTTP", Content: testOpenAPISchemaV1},
+			"admin":             {Type: "HTTP", Content: testOpenAPISchemaV1},
+		}
+
+		response := getSchemaDiff(t, "development")
+		require.True(t, response.HasBreakingChanges)
+		require.Len(t, response.Endpoints, 2)
+		require.Equal(t, "admin", response.Endpoints[0].Name)
+		require.Equal(t, string(utils.SchemaDiffStatusRemoved), response.Endpoints[0].Status)
+	})
+
+	t.Run("Comparing schemas should fail for unsupported requests", func(t *testing.T) {
+		testCases := []struct {
+			name       string
+			query      string
+			wantStatus int
+		}{
+			{name: "missing environment", query: "", wantStatus: http.StatusBadRequest},
+			{name: "last environment of the pipeline", query: "?environment=production", wantStatus: http.StatusBadRequest},
+			{name: "unknown environment", query: "?environment=staging", wantStatus: http.StatusNotFound},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := send(t, http.MethodGet, schemaDiffPath+tc.query, nil)
+				require.Equal(t, tc.wantStatus, rr.Code, rr.Body.String())
+			})
+		}
+
+		delete(deployedSchemas, "development")
+		rr := send(t, http.MethodGet, schemaDiffPath+"?environment=development", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+
+		rr = send(t, http.MethodGet, fmt.Sprintf("%s/missing-agent/schema-diff?environment=development", agentsPath), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
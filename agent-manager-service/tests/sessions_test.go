@@ -0,0 +1,226 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const (
+	sessionComponentUID   = "component-uid-1"
+	sessionEnvironmentUID = "environment-uid-1"
+	sessionID             = "c5b2f1e0-6d3a-4b8e-9f21-7a0d4e3c2b19"
+)
+
+func createMockOpenChoreoClientForSessions() *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
+			return &openchoreosvc.AgentComponent{UUID: sessionComponentUID, Name: agentName, ProjectName: projName}, nil
+		},
+		GetEnvironmentFunc: func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+			if environmentName != "development" {
+				return nil, utils.ErrEnvironmentNotFound
+			}
+			return &models.EnvironmentResponse{UUID: sessionEnvironmentUID, Name: environmentName}, nil
+		},
+	}
+}
+
+func createMockTraceObserverClientForSessions() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		ListSessionsFunc: func(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionOverviewResponse, error) {
+			return &traceobserversvc.SessionOverviewResponse{
+				Sessions: []traceobserversvc.SessionOverview{
+					{
+						SessionID:       sessionID,
+						TurnCount:       2,
+						SpanCount:       16,
+						StartTime:       "2025-12-01T10:00:00Z",
+						EndTime:         "2025-12-01T10:02:00Z",
+						DurationInNanos: 120000000000,
+						InputTokens:     1200,
+						OutputTokens:    300,
+						TotalTokens:     1500,
+					},
+				},
+				TotalCount: 1,
+			}, nil
+		},
+		GetSessionTracesFunc: func(ctx context.Context, params traceobserversvc.SessionTracesParams) (*traceobserversvc.SessionTracesResponse, error) {
+			return &traceobserversvc.SessionTracesResponse{
+				SessionID: params.SessionID,
+				Traces: []traceobserversvc.TraceOverview{
+					{TraceID: "trace-1", RootSpanID: "span-1", RootSpanName: "turn-1", StartTime: "2025-12-01T10:00:00Z", EndTime: "2025-12-01T10:00:05Z", SpanCount: 8},
+					{TraceID: "trace-2", RootSpanID: "span-2", RootSpanName: "turn-2", StartTime: "2025-12-01T10:01:55Z", EndTime: "2025-12-01T10:02:00Z", SpanCount: 8},
+				},
+				TotalCount: 2,
+			}, nil
+		},
+	}
+}
+
+func TestSessions(t *testing.T) {
+	// Create unique test data for this test suite
+	sessionOrgId := uuid.New()
+	sessionUserIdpId := uuid.New()
+	sessionProjId := uuid.New()
+	sessionOrgName := fmt.Sprintf("session-org-%s", uuid.New().String()[:5])
+	sessionProjName := fmt.Sprintf("session-project-%s", uuid.New().String()[:5])
+	sessionAgentName := fmt.Sprintf("session-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, sessionOrgId, sessionUserIdpId, sessionOrgName)
+	_ = apitestutils.CreateProject(t, sessionProjId, sessionOrgId, sessionProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), sessionOrgId, sessionProjId, sessionAgentName, "internal")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, sessionOrgId, sessionUserIdpId)
+
+	basePath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/sessions", sessionOrgName, sessionProjName, sessionAgentName)
+
+	t.Run("Listing sessions should return 200", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForSessions()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s?environment=development&startTime=2025-12-01T00:00:00Z&endTime=2025-12-02T00:00:00Z&limit=5", basePath)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		b, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+		t.Logf("response body: %s", string(b))
+
+		var response models.SessionOverviewResponse
+		require.NoError(t, json.Unmarshal(b, &response))
+		require.Equal(t, 1, response.TotalCount)
+		require.Len(t, response.Sessions, 1)
+		require.Equal(t, sessionID, response.Sessions[0].SessionID)
+		require.Equal(t, 2, response.Sessions[0].TurnCount)
+		require.Equal(t, int64(1500), response.Sessions[0].TotalTokens)
+
+		// Sessions are looked up by the agent's component and environment UIDs
+		require.Len(t, traceObserverClient.ListSessionsCalls(), 1)
+		call := traceObserverClient.ListSessionsCalls()[0]
+		require.Equal(t, sessionComponentUID, call.Params.ComponentUID)
+		require.Equal(t, sessionEnvironmentUID, call.Params.EnvironmentUID)
+		require.Equal(t, 5, call.Params.Limit)
+		require.Equal(t, 0, call.Params.Offset)
+	})
+
+	t.Run("Getting session traces should return 200", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForSessions()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s/%s?environment=development", basePath, sessionID)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.SessionTracesResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, sessionID, response.SessionID)
+		require.Len(t, response.Traces, 2)
+		require.Equal(t, "trace-1", response.Traces[0].TraceID)
+
+		call := traceObserverClient.GetSessionTracesCalls()[0]
+		require.Equal(t, sessionID, call.Params.SessionID)
+		require.Equal(t, sessionComponentUID, call.Params.ComponentUID)
+	})
+
+	t.Run("Listing sessions without an environment should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForSessions(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, basePath, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Listing sessions for an unknown environment should return 404", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForSessions(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("%s?environment=staging", basePath), nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Listing sessions for an unknown agent should return 404", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForSessions(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/unknown-agent/sessions?environment=development", sessionOrgName, sessionProjName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const tailTraceEvent = "event: trace\nid: trace-1\ndata: {\"traceId\":\"trace-1\",\"rootSpanName\":\"invoke_agent\",\"spanCount\":2}\n\n"
+
+func createMockTraceObserverClientForTail() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		TailTracesFunc: func(ctx context.Context, params traceobserversvc.TailTracesParams) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(tailTraceEvent + ": keep-alive\n\n")), nil
+		},
+	}
+}
+
+func TestTailTraces(t *testing.T) {
+	// Create unique test data for this test suite
+	tailOrgId := uuid.New()
+	tailUserIdpId := uuid.New()
+	tailProjId := uuid.New()
+	tailOrgName := fmt.Sprintf("tail-org-%s", uuid.New().String()[:5])
+	tailProjName := fmt.Sprintf("tail-project-%s", uuid.New().String()[:5])
+	tailAgentName := fmt.Sprintf("tail-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, tailOrgId, tailUserIdpId, tailOrgName)
+	_ = apitestutils.CreateProject(t, tailProjId, tailOrgId, tailProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), tailOrgId, tailProjId, tailAgentName, "internal")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, tailOrgId, tailUserIdpId)
+
+	tailPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces/tail", tailOrgName, tailProjName, tailAgentName)
+
+	t.Run("Tailing traces should stream server-sent events", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForTail()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("%s?environment=development&attribute=gen_ai.system%%3Dopenai", tailPath)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+		require.Contains(t, rr.Body.String(), tailTraceEvent)
+
+		// The tail is scoped to the agent's component and environment UIDs
+		require.Len(t, traceObserverClient.TailTracesCalls(), 1)
+		call := traceObserverClient.TailTracesCalls()[0]
+		require.Equal(t, sessionComponentUID, call.Params.ComponentUID)
+		require.Equal(t, sessionEnvironmentUID, call.Params.EnvironmentUID)
+		require.Equal(t, []string{"gen_ai.system=openai"}, call.Params.Attributes)
+	})
+
+	t.Run("Tailing traces without an environment should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForTail(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tailPath, nil))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Tailing traces with an invalid attribute filter should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForTail(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tailPath+"?environment=development&attribute=invalid", nil))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Tailing traces for an unknown environment should return 404", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForSessions(),
+			TraceObserverClient: createMockTraceObserverClientForTail(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tailPath+"?environment=production", nil))
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
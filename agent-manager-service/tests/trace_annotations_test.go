@@ -0,0 +1,233 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceAnnotations(t *testing.T) {
+	// Create unique test data for this test suite
+	annotationOrgId := uuid.New()
+	annotationUserIdpId := uuid.New()
+	annotationProjId := uuid.New()
+	annotationOrgName := fmt.Sprintf("annotation-org-%s", uuid.New().String()[:5])
+	annotationProjName := fmt.Sprintf("annotation-project-%s", uuid.New().String()[:5])
+	annotationAgentName := fmt.Sprintf("annotation-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, annotationOrgId, annotationUserIdpId, annotationOrgName)
+	_ = apitestutils.CreateProject(t, annotationProjId, annotationOrgId, annotationProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), annotationOrgId, annotationProjId, annotationAgentName, "external")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, annotationOrgId, annotationUserIdpId)
+
+	// The list mock returns trace-id-1 and trace-id-2, the details mock returns spans of any requested trace
+	traceObserverClient := createMockTraceObserverClient()
+	traceObserverClient.TraceDetailsByIdFunc = createMockTraceObserverClientWithDetails().TraceDetailsByIdFunc
+	// Like the trace observer, only the requested traces are listed when trace IDs are given
+	listTraces := traceObserverClient.ListTracesFunc
+	traceObserverClient.ListTracesFunc = func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+		response, err := listTraces(ctx, params)
+		if err != nil || len(params.TraceIDs) == 0 {
+			return response, err
+		}
+		traces := []traceobserversvc.TraceOverview{}
+		for _, trace := range response.Traces {
+			if slices.Contains(params.TraceIDs, trace.TraceID) {
+				traces = append(traces, trace)
+			}
+		}
+		return &traceobserversvc.TraceOverviewResponse{Traces: traces, TotalCount: len(traces)}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoSvcClient: createMockOpenChoreoClient(),
+		TraceObserverClient: traceObserverClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	agentPath := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", annotationOrgName, annotationProjName, annotationAgentName)
+	annotationsPath := agentPath + "/trace/trace-id-1/annotations"
+
+	send := func(t *testing.T, method string, path string, payload map[string]interface{}) *httptest.ResponseRecorder {
+		reqBody := new(bytes.Buffer)
+		if payload != nil {
+			require.NoError(t, json.NewEncoder(reqBody).Encode(payload))
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	var traceAnnotation models.TraceAnnotationResponse
+
+	t.Run("Annotating a trace should return 201 with the author", func(t *testing.T) {
+		rr := send(t, http.MethodPost, annotationsPath, map[string]interface{}{"score": 1, "label": " good ", "comment": "Correct answer"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&traceAnnotation))
+		require.Equal(t, "trace-id-1", traceAnnotation.TraceID)
+		require.Empty(t, traceAnnotation.SpanID)
+		require.NotNil(t, traceAnnotation.Score)
+		require.Equal(t, 1.0, *traceAnnotation.Score)
+		require.Equal(t, "good", traceAnnotation.Label)
+		require.Equal(t, "Correct answer", traceAnnotation.Comment)
+		require.Equal(t, annotationUserIdpId.String(), traceAnnotation.Author)
+	})
+
+	t.Run("Annotating a span should return 201", func(t *testing.T) {
+		rr := send(t, http.MethodPost, annotationsPath, map[string]interface{}{"spanId": "span-2", "comment": "Slow tool call"})
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		var response models.TraceAnnotationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "span-2", response.SpanID)
+		require.Nil(t, response.Score)
+	})
+
+	t.Run("Annotating without a score, label or comment should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodPost, annotationsPath, map[string]interface{}{"spanId": "span-2"})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Annotating with a too long label should return 400", func(t *testing.T) {
+		rr := send(t, http.MethodPost, annotationsPath, map[string]interface{}{"label": strings.Repeat("a", utils.TraceAnnotationMaxLabelLength+1)})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Annotating a trace of an unknown agent should return 404", func(t *testing.T) {
+		path := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/unknown-agent/trace/trace-id-1/annotations", annotationOrgName, annotationProjName)
+		rr := send(t, http.MethodPost, path, map[string]interface{}{"label": "good"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Listing annotations should return the trace and span annotations", func(t *testing.T) {
+		rr := send(t, http.MethodGet, annotationsPath, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceAnnotationListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 2, response.Total)
+		require.Equal(t, traceAnnotation.ID, response.Annotations[0].ID)
+
+		rr = send(t, http.MethodGet, annotationsPath+"?spanId=span-2", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 1, response.Total)
+		require.Equal(t, "span-2", response.Annotations[0].SpanID)
+	})
+
+	t.Run("Listing traces should include their annotations", func(t *testing.T) {
+		rr := send(t, http.MethodGet, agentPath+"/traces", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Traces, 2)
+		require.Equal(t, "trace-id-1", response.Traces[0].TraceID)
+		require.Len(t, response.Traces[0].Annotations, 2)
+		require.Empty(t, response.Traces[1].Annotations)
+	})
+
+	t.Run("Listing traces by label should only return labelled traces", func(t *testing.T) {
+		callsBefore := len(traceObserverClient.ListTracesCalls())
+		rr := send(t, http.MethodGet, agentPath+"/traces?label=good", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 1, response.TotalCount)
+		require.Len(t, response.Traces, 1)
+		require.Equal(t, "trace-id-1", response.Traces[0].TraceID)
+
+		calls := traceObserverClient.ListTracesCalls()
+		require.Len(t, calls, callsBefore+1)
+		require.Equal(t, []string{"trace-id-1"}, calls[len(calls)-1].Params.TraceIDs)
+		require.Equal(t, 0, calls[len(calls)-1].Params.Offset)
+	})
+
+	t.Run("Listing traces by an unused label should return no traces", func(t *testing.T) {
+		callsBefore := len(traceObserverClient.ListTracesCalls())
+		rr := send(t, http.MethodGet, agentPath+"/traces?label=unused", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, 0, response.TotalCount)
+		require.Empty(t, response.Traces)
+		require.Len(t, traceObserverClient.ListTracesCalls(), callsBefore)
+	})
+
+	t.Run("Getting a trace should include its annotations", func(t *testing.T) {
+		rr := send(t, http.MethodGet, agentPath+"/trace/trace-id-1", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Annotations, 2)
+	})
+
+	t.Run("Updating an annotation should replace its score, label and comment", func(t *testing.T) {
+		rr := send(t, http.MethodPut, annotationsPath+"/"+traceAnnotation.ID, map[string]interface{}{"label": "bad"})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceAnnotationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, traceAnnotation.ID, response.ID)
+		require.Equal(t, "bad", response.Label)
+		require.Nil(t, response.Score)
+		require.Empty(t, response.Comment)
+
+		rr = send(t, http.MethodGet, agentPath+"/traces?label=good", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var traces models.TraceOverviewResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&traces))
+		require.Equal(t, 0, traces.TotalCount)
+	})
+
+	t.Run("Updating an annotation of another trace should return 404", func(t *testing.T) {
+		path := agentPath + "/trace/trace-id-2/annotations/" + traceAnnotation.ID
+		rr := send(t, http.MethodPut, path, map[string]interface{}{"label": "bad"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deleting an annotation should return 204", func(t *testing.T) {
+		rr := send(t, http.MethodDelete, annotationsPath+"/"+traceAnnotation.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = send(t, http.MethodDelete, annotationsPath+"/"+traceAnnotation.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
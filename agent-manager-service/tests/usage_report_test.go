@@ -0,0 +1,225 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const (
+	usageProjectUID = "project-uid-1"
+	usageAgentOneID = "component-uid-1"
+	usageAgentTwoID = "component-uid-2"
+)
+
+func createMockOpenChoreoClientForUsage(agentOneName string, agentTwoName string) *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetProjectFunc: func(ctx context.Context, projectName string, orgName string) (*models.ProjectResponse, error) {
+			return &models.ProjectResponse{
+				UUID:      usageProjectUID,
+				Name:      projectName,
+				OrgName:   orgName,
+				CreatedAt: time.Now(),
+			}, nil
+		},
+		ListAgentComponentsFunc: func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			return []*openchoreosvc.AgentComponent{
+				{UUID: usageAgentOneID, Name: agentOneName, ProjectName: projName},
+				{UUID: usageAgentTwoID, Name: agentTwoName, ProjectName: projName},
+			}, nil
+		},
+		GetAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
+			return &openchoreosvc.AgentComponent{UUID: usageAgentOneID, Name: agentName, ProjectName: projName}, nil
+		},
+	}
+}
+
+func createMockTraceObserverClientForUsage() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		GetTokenUsageFunc: func(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error) {
+			return &traceobserversvc.TokenUsageResponse{
+				Usage: []traceobserversvc.TokenUsage{
+					{ProjectUID: usageProjectUID, ComponentUID: usageAgentOneID, Model: "gpt-4o", InputTokens: 1000000, OutputTokens: 100000, TotalTokens: 1100000, SpanCount: 10},
+					{ProjectUID: usageProjectUID, ComponentUID: usageAgentTwoID, Model: "gpt-4o", InputTokens: 2000000, OutputTokens: 0, TotalTokens: 2000000, SpanCount: 5},
+					{ProjectUID: usageProjectUID, ComponentUID: usageAgentTwoID, Model: "custom-model", InputTokens: 500, OutputTokens: 500, TotalTokens: 1000, SpanCount: 1},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestGetUsageReport(t *testing.T) {
+	// Create unique test data for this test suite
+	usageOrgId := uuid.New()
+	usageUserIdpId := uuid.New()
+	usageProjId := uuid.New()
+	usageOrgName := fmt.Sprintf("usage-org-%s", uuid.New().String()[:5])
+	usageProjName := fmt.Sprintf("usage-project-%s", uuid.New().String()[:5])
+	usageAgentOneName := fmt.Sprintf("usage-agent-%s", uuid.New().String()[:5])
+	usageAgentTwoName := fmt.Sprintf("usage-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, usageOrgId, usageUserIdpId, usageOrgName)
+	_ = apitestutils.CreateProject(t, usageProjId, usageOrgId, usageProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), usageOrgId, usageProjId, usageAgentOneName, "internal")
+	authMiddleware := jwtassertion.NewMockMiddleware(t, usageOrgId, usageUserIdpId)
+
+	// Price gpt-4o only so that custom-model is reported as unpriced
+	config.GetConfig().TokenPricing = config.TokenPricingConfig{
+		Currency: "USD",
+		Models: map[string]config.ModelPricing{
+			"gpt-4o": {InputPerMillion: 2.5, OutputPerMillion: 10},
+		},
+	}
+
+	timeRange := "startTime=2025-12-01T00:00:00Z&endTime=2025-12-31T23:59:59Z"
+
+	t.Run("Getting project usage grouped by agent should return 200", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForUsage()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForUsage(usageAgentOneName, usageAgentTwoName),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/usage?groupBy=agent&%s", usageOrgName, usageProjName, timeRange)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		b, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+		t.Logf("response body: %s", string(b))
+
+		var response models.UsageReportResponse
+		require.NoError(t, json.Unmarshal(b, &response))
+
+		require.Equal(t, []string{"agent"}, response.GroupBy)
+		require.Equal(t, "USD", response.Currency)
+		require.Len(t, response.Items, 2)
+
+		// Agent two has the highest spend: 2M input tokens at 2.5 per million
+		require.Equal(t, usageAgentTwoName, response.Items[0].AgentName)
+		require.Equal(t, int64(2001000), response.Items[0].TotalTokens)
+		require.InDelta(t, 5.0, response.Items[0].EstimatedCost, 1e-9)
+
+		// Agent one: 1M input at 2.5 plus 0.1M output at 10
+		require.Equal(t, usageAgentOneName, response.Items[1].AgentName)
+		require.InDelta(t, 3.5, response.Items[1].EstimatedCost, 1e-9)
+
+		require.Equal(t, int64(3101000), response.Total.TotalTokens)
+		require.InDelta(t, 8.5, response.Total.EstimatedCost, 1e-9)
+		require.Equal(t, []string{"custom-model"}, response.UnpricedModels)
+
+		// Project usage is filtered on the project UID
+		require.Len(t, traceObserverClient.GetTokenUsageCalls(), 1)
+		call := traceObserverClient.GetTokenUsageCalls()[0]
+		require.Equal(t, []string{usageProjectUID}, call.Params.ProjectUIDs)
+		require.Empty(t, call.Params.ComponentUIDs)
+		require.Equal(t, "2025-12-01T00:00:00Z", call.Params.StartTime)
+	})
+
+	t.Run("Getting agent usage as CSV should return 200", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForUsage()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForUsage(usageAgentOneName, usageAgentTwoName),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/usage?groupBy=model&format=csv&%s",
+			usageOrgName, usageProjName, usageAgentOneName, timeRange)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, []string{"model", "inputTokens", "outputTokens", "totalTokens", "estimatedCost", "currency"}, records[0])
+		require.Len(t, records, 3)
+		require.Equal(t, "gpt-4o", records[1][0])
+
+		// Agent usage is filtered on the component UID
+		call := traceObserverClient.GetTokenUsageCalls()[0]
+		require.Equal(t, []string{usageAgentOneID}, call.Params.ComponentUIDs)
+		require.Empty(t, call.Params.ProjectUIDs)
+	})
+
+	t.Run("Getting usage with invalid groupBy should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForUsage(usageAgentOneName, usageAgentTwoName),
+			TraceObserverClient: createMockTraceObserverClientForUsage(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/usage?groupBy=team&%s", usageOrgName, timeRange)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Getting usage without a time range should return 400", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForUsage(usageAgentOneName, usageAgentTwoName),
+			TraceObserverClient: createMockTraceObserverClientForUsage(),
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/usage", usageOrgName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -28,7 +28,11 @@ const (
 type AgentType string
 
 const (
-	AgentTypeAPI AgentType = "api"
+	AgentTypeAPI       AgentType = "api"
+	AgentTypeMCPServer AgentType = "mcp-server"
+	AgentTypeJob       AgentType = "job"
+	// Event-driven agents consume messages from a queue instead of serving requests
+	AgentTypeEventDriven AgentType = "event-driven"
 )
 
 type AgentSubType string
@@ -36,10 +40,78 @@ type AgentSubType string
 const (
 	AgentSubTypeChatAPI   AgentSubType = "chat-api"
 	AgentSubTypeCustomAPI AgentSubType = "custom-api"
+	AgentSubTypeA2A       AgentSubType = "a2a"
+)
+
+// A2AAgentCardPath is where a2a agents publish their agent card, relative to the endpoint URL
+const A2AAgentCardPath = "/.well-known/agent.json"
+
+// A2AAgentLookupConcurrency is the number of agent endpoint lookups run at once when listing a2a agents
+const A2AAgentLookupConcurrency = 8
+
+type A2ASecuritySchemeType string
+
+const (
+	A2ASecuritySchemeAPIKey        A2ASecuritySchemeType = "apiKey"
+	A2ASecuritySchemeHTTP          A2ASecuritySchemeType = "http"
+	A2ASecuritySchemeOAuth2        A2ASecuritySchemeType = "oauth2"
+	A2ASecuritySchemeOpenIDConnect A2ASecuritySchemeType = "openIdConnect"
+	A2ASecuritySchemeMutualTLS     A2ASecuritySchemeType = "mutualTLS"
 )
 
 type InputInterfaceType string
 
 const (
-	InputInterfaceTypeHTTP InputInterfaceType = "HTTP"
+	InputInterfaceTypeHTTP      InputInterfaceType = "HTTP"
+	InputInterfaceTypeGRPC      InputInterfaceType = "GRPC"
+	InputInterfaceTypeWebSocket InputInterfaceType = "WEBSOCKET"
+	InputInterfaceTypeQueue     InputInterfaceType = "QUEUE"
+)
+
+// Visibility of the endpoints an api agent exposes besides its primary endpoint
+type EndpointVisibility string
+
+const (
+	// Public endpoints are routed through the gateway
+	EndpointVisibilityPublic EndpointVisibility = "Public"
+	// Private endpoints are only reachable from inside the cluster
+	EndpointVisibilityPrivate EndpointVisibility = "Private"
+)
+
+type QueueBroker string
+
+const (
+	QueueBrokerNATS  QueueBroker = "nats"
+	QueueBrokerKafka QueueBroker = "kafka"
+)
+
+type MCPTransport string
+
+const (
+	MCPTransportStreamableHTTP MCPTransport = "streamable-http"
+	MCPTransportSSE            MCPTransport = "sse"
+)
+
+type JobConcurrencyPolicy string
+
+const (
+	JobConcurrencyPolicyAllow   JobConcurrencyPolicy = "Allow"
+	JobConcurrencyPolicyForbid  JobConcurrencyPolicy = "Forbid"
+	JobConcurrencyPolicyReplace JobConcurrencyPolicy = "Replace"
+)
+
+type JobRunTrigger string
+
+const (
+	JobRunTriggerManual    JobRunTrigger = "manual"
+	JobRunTriggerScheduled JobRunTrigger = "scheduled"
+)
+
+type JobRunStatus string
+
+const (
+	JobRunStatusPending   JobRunStatus = "Pending"
+	JobRunStatusRunning   JobRunStatus = "Running"
+	JobRunStatusSucceeded JobRunStatus = "Succeeded"
+	JobRunStatusFailed    JobRunStatus = "Failed"
 )
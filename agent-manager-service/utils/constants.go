@@ -16,6 +16,8 @@
 
 package utils
 
+import "time"
+
 type EndpointType string
 
 const (
@@ -41,11 +43,29 @@ const (
 
 // Path parameter names used in HTTP routes
 const (
-	PathParamOrgName   = "orgName"
-	PathParamProjName  = "projName"
-	PathParamAgentName = "agentName"
-	PathParamBuildName = "buildName"
-	PathParamTraceId   = "traceId"
+	PathParamOrgName      = "orgName"
+	PathParamProjName     = "projName"
+	PathParamAgentName    = "agentName"
+	PathParamBuildName    = "buildName"
+	PathParamTraceId      = "traceId"
+	PathParamSessionId    = "sessionId"
+	PathParamAPIKeyId     = "keyId"
+	PathParamAnnotationId = "annotationId"
+	PathParamDatasetName  = "datasetName"
+	PathParamEvalRunId    = "runId"
+	PathParamJobRunName   = "runName"
+	PathParamConfigKey    = "configKey"
+)
+
+// Job agent limits
+const (
+	MaxJobRetries = 10
+)
+
+// Event-driven agent limits
+const (
+	DefaultQueueConcurrency = 1
+	MaxQueueConcurrency     = 100
 )
 
 // Pagination constants
@@ -56,3 +76,125 @@ const (
 	DefaultOffset = 0
 	MinOffset     = 0
 )
+
+type UsageGroupBy string
+
+// Dimensions a usage report can be grouped by
+const (
+	UsageGroupByOrg     UsageGroupBy = "org"
+	UsageGroupByProject UsageGroupBy = "project"
+	UsageGroupByAgent   UsageGroupBy = "agent"
+	UsageGroupByModel   UsageGroupBy = "model"
+)
+
+// Usage report output formats
+const (
+	UsageReportFormatJSON = "json"
+	UsageReportFormatCSV  = "csv"
+)
+
+// Trace export formats
+const (
+	TraceExportFormatOTLPJSON = "otlp-json"
+	TraceExportFormatJaeger   = "jaeger"
+)
+
+// API key constants
+const (
+	APIKeyPrefix               = "amp_"
+	APIKeyRandomBytes          = 32
+	APIKeyDisplayPrefixLength  = 12 // APIKeyPrefix followed by the first characters of the key
+	APIKeyMaxNameLength        = 100
+	APIKeyLastUsedUpdatePeriod = time.Minute
+)
+
+// Trace annotation constants
+const (
+	TraceAnnotationMaxLabelLength   = 100
+	TraceAnnotationMaxCommentLength = 4000
+	TraceAnnotationMaxTraceIDLength = 64
+	TraceAnnotationMaxSpanIDLength  = 32
+)
+
+// Evaluation dataset constants
+const (
+	EvalDatasetMaxDescriptionLength = 1000
+	EvalDatasetMaxItems             = 1000
+	// Maximum number of traces a single selection may add to a dataset
+	EvalDatasetMaxTraceSelection = 100
+)
+
+// Configuration override constants
+const (
+	ConfigOverrideMaxKeyLength   = 255
+	ConfigOverrideMaxValueLength = 32768
+)
+
+// Where a value of the effective configuration of an agent comes from
+const (
+	ConfigSourceBase     = "base"
+	ConfigSourceOverride = "override"
+)
+
+type EvaluatorType string
+
+// Evaluators an evaluation run can score agent outputs with
+const (
+	EvaluatorTypeExactMatch          EvaluatorType = "exact_match"
+	EvaluatorTypeRegex               EvaluatorType = "regex"
+	EvaluatorTypeJSONSchema          EvaluatorType = "json_schema"
+	EvaluatorTypeEmbeddingSimilarity EvaluatorType = "embedding_similarity"
+	EvaluatorTypeLLMJudge            EvaluatorType = "llm_judge"
+)
+
+// Evaluation run statuses
+const (
+	EvalRunStatusRunning   = "running"
+	EvalRunStatusCompleted = "completed"
+	EvalRunStatusFailed    = "failed"
+)
+
+// Evaluation run constants
+const (
+	EvalRunDefaultPath        = "/chat"
+	EvalRunDefaultConcurrency = 4
+	EvalRunMaxEvaluators      = 10
+	// Threshold used by evaluators that produce a graded score when none is configured
+	EvalRunDefaultThreshold = 0.8
+)
+
+// Outcome of an item when comparing two evaluation runs
+const (
+	EvalRunChangeImproved  = "improved"
+	EvalRunChangeRegressed = "regressed"
+	EvalRunChangeUnchanged = "unchanged"
+)
+
+// Agent endpoint limits
+const (
+	MaxAgentEndpoints = 10
+	// Endpoint names double as Kubernetes port names, which are limited to 15 characters
+	MaxAgentEndpointNameLength = 15
+	// Port of the Kubernetes service in front of the primary endpoint of an agent
+	PrimaryEndpointServicePort = 80
+	// Port name of the primary endpoint of an agent
+	PrimaryEndpointPortName = "http"
+)
+
+type SchemaDiffStatus string
+
+// How the schema of an endpoint changes when an agent is promoted
+const (
+	SchemaDiffStatusAdded     SchemaDiffStatus = "ADDED"
+	SchemaDiffStatusRemoved   SchemaDiffStatus = "REMOVED"
+	SchemaDiffStatusModified  SchemaDiffStatus = "MODIFIED"
+	SchemaDiffStatusUnchanged SchemaDiffStatus = "UNCHANGED"
+)
+
+type ResourceName string
+
+// Resources an agent container requests and is limited to
+const (
+	ResourceCPU    ResourceName = "cpu"
+	ResourceMemory ResourceName = "memory"
+)
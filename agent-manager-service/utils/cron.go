@@ -0,0 +1,122 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronMacros are the predefined schedules accepted by Kubernetes CronJobs
+var cronMacros = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// 7 is accepted as Sunday, like in the standard cron format
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// ValidateCronSchedule validates a schedule in the five field cron format used by Kubernetes CronJobs,
+// e.g. "0 2 * * *", or one of the predefined schedules such as "@daily".
+func ValidateCronSchedule(schedule string) error {
+	schedule = strings.TrimSpace(schedule)
+	if schedule == "" {
+		return fmt.Errorf("schedule cannot be empty")
+	}
+	if strings.HasPrefix(schedule, "@") {
+		if !cronMacros[schedule] {
+			return fmt.Errorf("unsupported schedule %s", schedule)
+		}
+		return nil
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("schedule must have %d fields (minute, hour, day of month, month, day of week), got %d", len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		rangePart, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid step '%s' in %s field", step, f.name)
+			}
+		}
+		if rangePart == "*" {
+			continue
+		}
+		from, to, isRange := strings.Cut(rangePart, "-")
+		start, err := f.parseValue(from)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		end, err := f.parseValue(to)
+		if err != nil {
+			return err
+		}
+		if start > end {
+			return fmt.Errorf("invalid range '%s' in %s field", rangePart, f.name)
+		}
+	}
+	return nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid value '%s' in %s field (must be %d-%d)", value, f.name, f.min, f.max)
+	}
+	return n, nil
+}
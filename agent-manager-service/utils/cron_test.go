@@ -0,0 +1,65 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCronSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		wantErr  string
+	}{
+		{name: "every minute", schedule: "* * * * *"},
+		{name: "fixed time", schedule: "0 2 * * *"},
+		{name: "surrounding whitespace", schedule: "  0 2 * * *  "},
+		{name: "ranges", schedule: "0 9-17 * * 1-5"},
+		{name: "lists", schedule: "0,15,30,45 * * * *"},
+		{name: "steps", schedule: "*/15 0-12/2 * * *"},
+		{name: "month and day names", schedule: "0 0 1 jan-jun MON,wed"},
+		{name: "sunday as 7", schedule: "0 0 * * 7"},
+		{name: "daily macro", schedule: "@daily"},
+		{name: "hourly macro", schedule: "@hourly"},
+		{name: "empty schedule", schedule: " ", wantErr: "schedule cannot be empty"},
+		{name: "unsupported macro", schedule: "@every 5m", wantErr: "unsupported schedule @every 5m"},
+		{name: "too few fields", schedule: "0 2 * *", wantErr: "schedule must have 5 fields (minute, hour, day of month, month, day of week), got 4"},
+		{name: "seconds field", schedule: "0 0 2 * * *", wantErr: "schedule must have 5 fields (minute, hour, day of month, month, day of week), got 6"},
+		{name: "minute out of range", schedule: "60 * * * *", wantErr: "invalid value '60' in minute field (must be 0-59)"},
+		{name: "day of month out of range", schedule: "0 0 0 * *", wantErr: "invalid value '0' in day of month field (must be 1-31)"},
+		{name: "unknown month name", schedule: "0 0 1 FOO *", wantErr: "invalid value 'FOO' in month field (must be 1-12)"},
+		{name: "reversed range", schedule: "0 17-9 * * *", wantErr: "invalid range '17-9' in hour field"},
+		{name: "range out of bounds", schedule: "0 0 * * 1-8", wantErr: "invalid value '8' in day of week field (must be 0-7)"},
+		{name: "zero step", schedule: "*/0 * * * *", wantErr: "invalid step '0' in minute field"},
+		{name: "non numeric step", schedule: "* */x * * *", wantErr: "invalid step 'x' in hour field"},
+		{name: "empty list item", schedule: "1,,2 * * * *", wantErr: "invalid value '' in minute field (must be 0-59)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCronSchedule(tt.schedule)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -25,8 +25,32 @@ var (
 	ErrOrganizationNotFound       = errors.New("organization not found")
 	ErrBuildNotFound              = errors.New("build not found")
 	ErrEnvironmentNotFound        = errors.New("environment not found")
+	ErrTraceNotFound              = errors.New("trace not found")
 	ErrOrganizationAlreadyExists  = errors.New("organization already exists")
 	ErrProjectAlreadyExists       = errors.New("project already exists")
 	ErrDeploymentPipelineNotFound = errors.New("deployment pipeline not found")
 	ErrProjectHasAssociatedAgents = errors.New("project has associated agents")
+	ErrAgentNotExternal           = errors.New("agent is not an external agent")
+	ErrAgentNotInternal           = errors.New("agent is not an internal agent")
+	ErrAPIKeyNotFound             = errors.New("api key not found")
+	ErrInvalidAPIKey              = errors.New("invalid api key")
+	ErrTraceAnnotationNotFound    = errors.New("trace annotation not found")
+	ErrEvalDatasetNotFound        = errors.New("dataset not found")
+	ErrEvalDatasetAlreadyExists   = errors.New("dataset already exists")
+	ErrEvalDatasetEmpty           = errors.New("dataset has no items")
+	ErrEvalDatasetTooLarge        = errors.New("dataset has too many items")
+	ErrEvalRunNotFound            = errors.New("evaluation run not found")
+	ErrEvalRunNotFinished         = errors.New("evaluation run has not finished")
+	ErrInvalidEvaluator           = errors.New("invalid evaluator")
+	ErrAgentEndpointNotFound      = errors.New("agent endpoint not found")
+	ErrAgentNotJob                = errors.New("agent is not a job agent")
+	ErrAgentNotDeployed           = errors.New("agent is not deployed to the environment")
+	ErrJobRunNotFound             = errors.New("job run not found")
+	ErrInvalidAgentEndpoints      = errors.New("invalid agent endpoints")
+	ErrAgentEndpointsUnsupported  = errors.New("agent does not support additional endpoints")
+	ErrNoPromotionTarget          = errors.New("environment has no promotion target")
+	ErrInvalidAgentScaling        = errors.New("invalid agent scaling")
+	ErrConfigOverrideNotFound     = errors.New("configuration override not found")
+	ErrConfigOverrideExists       = errors.New("configuration override already exists")
+	ErrInvalidConfigOverride      = errors.New("invalid configuration override")
 )
@@ -17,6 +17,8 @@
 package utils
 
 import (
+	"encoding/json"
+
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 )
@@ -180,6 +182,33 @@ func ConvertToDeploymentDetailsResponse(deploymentDetails []*models.DeploymentRe
 			Endpoints:              endpoints,
 			EnvironmentDisplayName: envDisplayName,
 		}
+		if deployment.Queue != nil {
+			queueStatus := spec.DeploymentQueueStatus{
+				Broker:        deployment.Queue.Broker,
+				Topic:         deployment.Queue.Topic,
+				ConsumerGroup: deployment.Queue.ConsumerGroup,
+				ConsumerLag:   deployment.Queue.ConsumerLag,
+			}
+			if deployment.Queue.Error != "" {
+				queueStatus.Error = &deployment.Queue.Error
+			}
+			deploymentResponse.Queue = &queueStatus
+		}
+		if deployment.Resources != nil {
+			deploymentResponse.Resources = &spec.ResourceRequirements{
+				Requests: convertToResourceQuantity(deployment.Resources.Requests),
+				Limits:   convertToResourceQuantity(deployment.Resources.Limits),
+			}
+		}
+		deploymentResponse.Replicas = deployment.Replicas
+		if deployment.Autoscaling != nil {
+			deploymentResponse.Autoscaling = &spec.AutoscalingConfig{
+				MinReplicas:                    deployment.Autoscaling.MinReplicas,
+				MaxReplicas:                    deployment.Autoscaling.MaxReplicas,
+				TargetCPUUtilizationPercentage: deployment.Autoscaling.TargetCPUUtilizationPercentage,
+				TargetConcurrency:              deployment.Autoscaling.TargetConcurrency,
+			}
+		}
 
 		// Add to result map with environment name as key
 		result[deployment.Environment] = deploymentResponse
@@ -188,6 +217,17 @@ func ConvertToDeploymentDetailsResponse(deploymentDetails []*models.DeploymentRe
 	return result
 }
 
+func convertToResourceQuantity(quantity models.ResourceQuantity) *spec.ResourceQuantity {
+	result := &spec.ResourceQuantity{}
+	if quantity.CPU != "" {
+		result.Cpu = &quantity.CPU
+	}
+	if quantity.Memory != "" {
+		result.Memory = &quantity.Memory
+	}
+	return result
+}
+
 func ConvertToAgentEndpointResponse(endpointDetails map[string]models.EndpointsResponse) map[string]spec.EndpointConfiguration {
 	result := make(map[string]spec.EndpointConfiguration)
 
@@ -195,19 +235,118 @@ func ConvertToAgentEndpointResponse(endpointDetails map[string]models.EndpointsR
 		return result
 	}
 	for endpointName, details := range endpointDetails {
-		result[endpointName] = spec.EndpointConfiguration{
+		endpoint := spec.EndpointConfiguration{
 			Url:          details.URL,
 			EndpointName: endpointName,
 			Visibility:   details.Visibility,
+			Type:         NonEmptyStrPointer(details.Type),
 			Schema: spec.EndpointSchema{
 				Content: details.Schema.Content,
 			},
+			Mcp: convertToMCPServerDetailsResponse(details.MCP),
+		}
+		if details.Schema.Reflection {
+			endpoint.Schema.SetReflection(true)
 		}
+		result[endpointName] = endpoint
 	}
 
 	return result
 }
 
+func ConvertToSchemaDiffResponse(diff *models.SchemaDiffResponse) spec.SchemaDiffResponse {
+	response := spec.SchemaDiffResponse{
+		Environment:        diff.Environment,
+		TargetEnvironment:  diff.TargetEnvironment,
+		HasBreakingChanges: diff.HasBreakingChanges,
+		Endpoints:          make([]spec.EndpointSchemaDiff, 0, len(diff.Endpoints)),
+	}
+	for _, endpoint := range diff.Endpoints {
+		endpointDiff := spec.EndpointSchemaDiff{
+			Name:            endpoint.Name,
+			Status:          endpoint.Status,
+			BreakingChanges: int32(endpoint.BreakingChanges),
+			Changes:         make([]spec.SchemaChange, 0, len(endpoint.Changes)),
+			Error:           NonEmptyStrPointer(endpoint.Error),
+		}
+		for _, change := range endpoint.Changes {
+			schemaChange := spec.SchemaChange{
+				Path:       NonEmptyStrPointer(change.Path),
+				Property:   change.Property,
+				ChangeType: change.ChangeType,
+				Original:   NonEmptyStrPointer(change.Original),
+				New:        NonEmptyStrPointer(change.New),
+				Breaking:   change.Breaking,
+			}
+			if change.Line > 0 {
+				schemaChange.SetLine(int32(change.Line))
+			}
+			endpointDiff.Changes = append(endpointDiff.Changes, schemaChange)
+		}
+		response.Endpoints = append(response.Endpoints, endpointDiff)
+	}
+	return response
+}
+
+func convertToMCPServerDetailsResponse(details *models.MCPServerDetails) *spec.MCPServerDetails {
+	if details == nil {
+		return nil
+	}
+	response := &spec.MCPServerDetails{
+		Url:       details.URL,
+		Transport: details.Transport,
+		ServerInfo: spec.MCPServerDetailsServerInfo{
+			Name:    NonEmptyStrPointer(details.ServerInfo.Name),
+			Version: NonEmptyStrPointer(details.ServerInfo.Version),
+		},
+		Tools:     make([]spec.MCPTool, 0, len(details.Tools)),
+		Resources: make([]spec.MCPResource, 0, len(details.Resources)),
+		Prompts:   make([]spec.MCPPrompt, 0, len(details.Prompts)),
+
+		ProtocolVersion:    NonEmptyStrPointer(details.ProtocolVersion),
+		IntrospectionError: NonEmptyStrPointer(details.IntrospectionError),
+	}
+	for _, tool := range details.Tools {
+		mcpTool := spec.MCPTool{
+			Name:        tool.Name,
+			Title:       NonEmptyStrPointer(tool.Title),
+			Description: NonEmptyStrPointer(tool.Description),
+		}
+		// Tools whose input schema is not a JSON object are listed without it
+		var inputSchema map[string]interface{}
+		if len(tool.InputSchema) > 0 && json.Unmarshal(tool.InputSchema, &inputSchema) == nil {
+			mcpTool.InputSchema = inputSchema
+		}
+		response.Tools = append(response.Tools, mcpTool)
+	}
+	for _, resource := range details.Resources {
+		response.Resources = append(response.Resources, spec.MCPResource{
+			Uri:         resource.URI,
+			Name:        resource.Name,
+			Title:       NonEmptyStrPointer(resource.Title),
+			Description: NonEmptyStrPointer(resource.Description),
+			MimeType:    NonEmptyStrPointer(resource.MimeType),
+		})
+	}
+	for _, prompt := range details.Prompts {
+		mcpPrompt := spec.MCPPrompt{
+			Name:        prompt.Name,
+			Title:       NonEmptyStrPointer(prompt.Title),
+			Description: NonEmptyStrPointer(prompt.Description),
+		}
+		for _, argument := range prompt.Arguments {
+			required := argument.Required
+			mcpPrompt.Arguments = append(mcpPrompt.Arguments, spec.MCPPromptArgumentsInner{
+				Name:        argument.Name,
+				Description: NonEmptyStrPointer(argument.Description),
+				Required:    &required,
+			})
+		}
+		response.Prompts = append(response.Prompts, mcpPrompt)
+	}
+	return response
+}
+
 func ConvertToEnvironmentListResponse(environments []*models.EnvironmentResponse) []spec.Environment {
 	if len(environments) == 0 {
 		return []spec.Environment{}
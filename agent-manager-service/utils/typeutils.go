@@ -30,6 +30,14 @@ func StrPointerAsStr(v *string, defaultValue string) string {
 	return *v
 }
 
+// NonEmptyStrPointer returns a pointer to v, or nil when v is empty so that optional fields are left out
+func NonEmptyStrPointer(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
 func ParseUUID(s string) (uuid.UUID, error) {
 	id, err := uuid.Parse(s)
 	if err != nil {
@@ -17,13 +17,21 @@
 package utils
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
+	"net"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/openapi"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 )
 
@@ -65,6 +73,53 @@ func validateInternalAgent(payload spec.CreateAgentRequest) error {
 			return fmt.Errorf("invalid inputInterface: %w", err)
 		}
 	}
+	// Validate the endpoints api agents expose besides their primary endpoint
+	if len(payload.Endpoints) > 0 {
+		if payload.AgentType.Type != string(AgentTypeAPI) {
+			return fmt.Errorf("endpoints are only supported for %s agents", AgentTypeAPI)
+		}
+		if err := ValidateAgentEndpoints(payload.Endpoints, primaryEndpointPort(payload)); err != nil {
+			return fmt.Errorf("invalid endpoints: %w", err)
+		}
+	}
+	// Validate MCP transport settings for MCP server agents
+	if payload.AgentType.Type == string(AgentTypeMCPServer) {
+		if err := validateMCPInputInterface(payload.InputInterface); err != nil {
+			return fmt.Errorf("invalid inputInterface: %w", err)
+		}
+	}
+	// Validate the queue settings of event-driven agents
+	if payload.AgentType.Type == string(AgentTypeEventDriven) {
+		if err := validateQueueInputInterface(payload.InputInterface); err != nil {
+			return fmt.Errorf("invalid inputInterface: %w", err)
+		}
+	} else if payload.InputInterface != nil && payload.InputInterface.Queue != nil {
+		return fmt.Errorf("inputInterface.queue is only supported for %s agents", AgentTypeEventDriven)
+	}
+	// Validate the agent card of A2A agents
+	if StrPointerAsStr(payload.AgentType.SubType, "") == string(AgentSubTypeA2A) {
+		if payload.AgentCard == nil {
+			return fmt.Errorf("agentCard is required for %s agents", AgentSubTypeA2A)
+		}
+		if err := validateAgentCard(payload.AgentCard); err != nil {
+			return fmt.Errorf("invalid agentCard: %w", err)
+		}
+	} else if payload.AgentCard != nil {
+		return fmt.Errorf("agentCard is only supported for %s agents", AgentSubTypeA2A)
+	}
+	// Job agents run to completion and do not serve requests
+	if payload.AgentType.Type == string(AgentTypeJob) {
+		if payload.InputInterface != nil {
+			return fmt.Errorf("inputInterface is not supported for %s agents", AgentTypeJob)
+		}
+		if payload.JobConfig != nil {
+			if err := validateJobConfig(payload.JobConfig); err != nil {
+				return fmt.Errorf("invalid jobConfig: %w", err)
+			}
+		}
+	} else if payload.JobConfig != nil {
+		return fmt.Errorf("jobConfig is only supported for %s agents", AgentTypeJob)
+	}
 
 	// Validate runtime configurations
 	if payload.RuntimeConfigs == nil {
@@ -74,18 +129,41 @@ func validateInternalAgent(payload spec.CreateAgentRequest) error {
 	if err := validateLanguage(payload.RuntimeConfigs.Language, payload.RuntimeConfigs.LanguageVersion); err != nil {
 		return fmt.Errorf("invalid language: %w", err)
 	}
+	if err := ValidateAgentScaling(payload.RuntimeConfigs.Resources, payload.RuntimeConfigs.Replicas, payload.RuntimeConfigs.Autoscaling); err != nil {
+		return fmt.Errorf("invalid runtimeConfigs: %w", err)
+	}
+	// A job runs a single pod per run
+	if payload.AgentType.Type == string(AgentTypeJob) && (payload.RuntimeConfigs.Replicas != nil || payload.RuntimeConfigs.Autoscaling != nil) {
+		return fmt.Errorf("replicas and autoscaling are not supported for %s agents", AgentTypeJob)
+	}
+	if payload.RuntimeConfigs.Probes != nil {
+		if payload.AgentType.Type == string(AgentTypeJob) {
+			return fmt.Errorf("probes are not supported for %s agents", AgentTypeJob)
+		}
+		if err := ValidateAgentProbes(payload.RuntimeConfigs.Probes); err != nil {
+			return fmt.Errorf("invalid runtimeConfigs: %w", err)
+		}
+	}
 
 	return nil
 }
 
 func validateAgentType(agentType spec.AgentType) error {
-	if agentType.Type != string(AgentTypeAPI) {
+	if agentType.Type != string(AgentTypeAPI) && agentType.Type != string(AgentTypeMCPServer) && agentType.Type != string(AgentTypeJob) &&
+		agentType.Type != string(AgentTypeEventDriven) {
 		return fmt.Errorf("unsupported agent type: %s", agentType.Type)
 	}
 	return nil
 }
 
 func validateAgentSubType(agentType spec.AgentType) error {
+	// MCP servers, jobs and event-driven agents have no subtypes
+	if agentType.Type == string(AgentTypeMCPServer) || agentType.Type == string(AgentTypeJob) || agentType.Type == string(AgentTypeEventDriven) {
+		if StrPointerAsStr(agentType.SubType, "") != "" {
+			return fmt.Errorf("agent type %s does not support subtypes", agentType.Type)
+		}
+		return nil
+	}
 	if agentType.SubType == nil {
 		return fmt.Errorf("agent subtype is required")
 	}
@@ -94,7 +172,7 @@ func validateAgentSubType(agentType spec.AgentType) error {
 	}
 	// Validate subtype for API agent type
 	subType := StrPointerAsStr(agentType.SubType, "")
-	if subType != string(AgentSubTypeChatAPI) && subType != string(AgentSubTypeCustomAPI) {
+	if subType != string(AgentSubTypeChatAPI) && subType != string(AgentSubTypeCustomAPI) && subType != string(AgentSubTypeA2A) {
 		return fmt.Errorf("unsupported agent subtype for type %s: %s", agentType.Type, subType)
 	}
 
@@ -176,12 +254,16 @@ func validateInputInterface(agentType spec.AgentType, inputInterface *spec.Input
 	if inputInterface == nil {
 		return fmt.Errorf("inputInterface is required for internal agents")
 	}
-	if inputInterface.Type != string(InputInterfaceTypeHTTP) {
+	if !isEndpointType(inputInterface.Type) {
 		return fmt.Errorf("unsupported inputInterface type: %s", inputInterface.Type)
 	}
+	// Chat and A2A agents speak a fixed HTTP protocol, only custom APIs choose how they are served
+	if inputInterface.Type != string(InputInterfaceTypeHTTP) && StrPointerAsStr(agentType.SubType, "") != string(AgentSubTypeCustomAPI) {
+		return fmt.Errorf("inputInterface type %s is only supported for %s agents", inputInterface.Type, AgentSubTypeCustomAPI)
+	}
 	if StrPointerAsStr(agentType.SubType, "") == string(AgentSubTypeCustomAPI) {
-		if inputInterface.Schema.Path == "" {
-			return fmt.Errorf("inputInterface.schema.path is required")
+		if err := validateEndpointSchema("inputInterface.schema", inputInterface.Type, &inputInterface.Schema); err != nil {
+			return err
 		}
 		if inputInterface.Port <= 0 || inputInterface.Port > 65535 {
 			return fmt.Errorf("inputInterface.port must be a valid port number (1-65535)")
@@ -190,7 +272,535 @@ func validateInputInterface(agentType spec.AgentType, inputInterface *spec.Input
 			return fmt.Errorf("inputInterface.basePath is required")
 		}
 	}
+	// A2A agents serve their own protocol and agent card, so only the port is needed
+	if StrPointerAsStr(agentType.SubType, "") == string(AgentSubTypeA2A) {
+		if inputInterface.Port <= 0 || inputInterface.Port > 65535 {
+			return fmt.Errorf("inputInterface.port must be a valid port number (1-65535)")
+		}
+		if inputInterface.BasePath != "" && !strings.HasPrefix(inputInterface.BasePath, "/") {
+			return fmt.Errorf("inputInterface.basePath must start with '/'")
+		}
+	}
+
+	return nil
+}
+
+// validateAgentCard validates the A2A agent card of an a2a agent. The card URL is not part of the request,
+// since it is only known once the agent is deployed.
+func validateAgentCard(card *spec.AgentCard) error {
+	if strings.TrimSpace(card.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if strings.TrimSpace(card.Description) == "" {
+		return fmt.Errorf("description is required")
+	}
+	if strings.TrimSpace(card.Version) == "" {
+		return fmt.Errorf("version is required")
+	}
+	if card.Provider != nil && (card.Provider.Organization == "" || card.Provider.Url == "") {
+		return fmt.Errorf("provider.organization and provider.url are required when provider is set")
+	}
+	if err := validateMediaTypes("defaultInputModes", card.DefaultInputModes, true); err != nil {
+		return err
+	}
+	if err := validateMediaTypes("defaultOutputModes", card.DefaultOutputModes, true); err != nil {
+		return err
+	}
+
+	if len(card.Skills) == 0 {
+		return fmt.Errorf("at least one skill is required")
+	}
+	skillIDs := make(map[string]bool, len(card.Skills))
+	for i, skill := range card.Skills {
+		if strings.TrimSpace(skill.Id) == "" || strings.TrimSpace(skill.Name) == "" || strings.TrimSpace(skill.Description) == "" {
+			return fmt.Errorf("skills[%d]: id, name and description are required", i)
+		}
+		if skillIDs[skill.Id] {
+			return fmt.Errorf("skills[%d]: duplicate skill id '%s'", i, skill.Id)
+		}
+		skillIDs[skill.Id] = true
+		if err := validateMediaTypes(fmt.Sprintf("skills[%d].inputModes", i), skill.InputModes, false); err != nil {
+			return err
+		}
+		if err := validateMediaTypes(fmt.Sprintf("skills[%d].outputModes", i), skill.OutputModes, false); err != nil {
+			return err
+		}
+	}
+
+	for name, scheme := range card.SecuritySchemes {
+		if err := validateSecurityScheme(scheme); err != nil {
+			return fmt.Errorf("securitySchemes.%s: %w", name, err)
+		}
+	}
+	for i, requirement := range card.Security {
+		for name := range requirement {
+			if _, ok := card.SecuritySchemes[name]; !ok {
+				return fmt.Errorf("security[%d]: unknown security scheme '%s'", i, name)
+			}
+		}
+	}
+	return nil
+}
+
+func validateMediaTypes(field string, mediaTypes []string, required bool) error {
+	if required && len(mediaTypes) == 0 {
+		return fmt.Errorf("%s must list at least one media type", field)
+	}
+	for _, mediaType := range mediaTypes {
+		if strings.TrimSpace(mediaType) == "" {
+			return fmt.Errorf("%s must not contain empty media types", field)
+		}
+	}
+	return nil
+}
+
+func validateSecurityScheme(scheme spec.AgentCardSecurityScheme) error {
+	switch A2ASecuritySchemeType(scheme.Type) {
+	case A2ASecuritySchemeAPIKey:
+		in := StrPointerAsStr(scheme.In, "")
+		if StrPointerAsStr(scheme.Name, "") == "" || (in != "header" && in != "query" && in != "cookie") {
+			return fmt.Errorf("apiKey schemes require a name and 'in' set to header, query or cookie")
+		}
+	case A2ASecuritySchemeHTTP:
+		if StrPointerAsStr(scheme.Scheme, "") == "" {
+			return fmt.Errorf("http schemes require a scheme")
+		}
+	case A2ASecuritySchemeOAuth2:
+		if len(scheme.Flows) == 0 {
+			return fmt.Errorf("oauth2 schemes require flows")
+		}
+	case A2ASecuritySchemeOpenIDConnect:
+		if StrPointerAsStr(scheme.OpenIdConnectUrl, "") == "" {
+			return fmt.Errorf("openIdConnect schemes require an openIdConnectUrl")
+		}
+	case A2ASecuritySchemeMutualTLS:
+	default:
+		return fmt.Errorf("unsupported type '%s'", scheme.Type)
+	}
+	return nil
+}
+
+// validateMCPInputInterface validates the inputInterface of an MCP server agent. The port, base path and
+// transport path are optional and fall back to the MCP server defaults.
+func validateMCPInputInterface(inputInterface *spec.InputInterface) error {
+	if inputInterface == nil {
+		return fmt.Errorf("inputInterface is required for internal agents")
+	}
+	if inputInterface.Type != string(InputInterfaceTypeHTTP) {
+		return fmt.Errorf("unsupported inputInterface type: %s", inputInterface.Type)
+	}
+	if inputInterface.Port < 0 || inputInterface.Port > 65535 {
+		return fmt.Errorf("inputInterface.port must be a valid port number (1-65535)")
+	}
+	if inputInterface.BasePath != "" && !strings.HasPrefix(inputInterface.BasePath, "/") {
+		return fmt.Errorf("inputInterface.basePath must start with '/'")
+	}
+	if inputInterface.Transport == nil {
+		return nil
+	}
+	transport := inputInterface.Transport.Type
+	if transport != string(MCPTransportStreamableHTTP) && transport != string(MCPTransportSSE) {
+		return fmt.Errorf("unsupported inputInterface.transport.type: %s (must be '%s' or '%s')", transport, MCPTransportStreamableHTTP, MCPTransportSSE)
+	}
+	if path := StrPointerAsStr(inputInterface.Transport.Path, ""); path != "" && !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("inputInterface.transport.path must start with '/'")
+	}
+	return nil
+}
+
+// validateQueueInputInterface validates the queue an event-driven agent consumes from. The consumer group and
+// concurrency are optional.
+func validateQueueInputInterface(inputInterface *spec.InputInterface) error {
+	if inputInterface == nil {
+		return fmt.Errorf("inputInterface is required for internal agents")
+	}
+	if inputInterface.Type != string(InputInterfaceTypeQueue) {
+		return fmt.Errorf("unsupported inputInterface type for %s agents: %s (must be '%s')", AgentTypeEventDriven, inputInterface.Type, InputInterfaceTypeQueue)
+	}
+	queue := inputInterface.Queue
+	if queue == nil {
+		return fmt.Errorf("inputInterface.queue is required")
+	}
+	switch QueueBroker(queue.Broker) {
+	case QueueBrokerNATS:
+		if err := validateNATSQueue(queue); err != nil {
+			return err
+		}
+	case QueueBrokerKafka:
+		if err := validateKafkaQueue(queue); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported inputInterface.queue.broker: %s (must be '%s' or '%s')", queue.Broker, QueueBrokerNATS, QueueBrokerKafka)
+	}
+	if queue.ConsumerGroup != nil && !regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`).MatchString(*queue.ConsumerGroup) {
+		return fmt.Errorf("inputInterface.queue.consumerGroup may only contain letters, digits, '-' and '_' (max 64 characters)")
+	}
+	if queue.Concurrency != nil && (*queue.Concurrency < 1 || *queue.Concurrency > MaxQueueConcurrency) {
+		return fmt.Errorf("inputInterface.queue.concurrency must be between 1 and %d", MaxQueueConcurrency)
+	}
+	return nil
+}
+
+func validateNATSQueue(queue *spec.InputInterfaceQueue) error {
+	for _, server := range strings.Split(queue.Url, ",") {
+		serverURL, err := url.Parse(strings.TrimSpace(server))
+		if err != nil || serverURL.Host == "" || (serverURL.Scheme != "nats" && serverURL.Scheme != "tls") {
+			return fmt.Errorf("inputInterface.queue.url must be a list of nats:// or tls:// server URLs")
+		}
+	}
+	// Consumers subscribe to a subject, wildcards included, but never publish to it
+	if queue.Topic == "" || strings.ContainsAny(queue.Topic, " \t\r\n") || strings.HasPrefix(queue.Topic, ".") ||
+		strings.HasSuffix(queue.Topic, ".") || strings.Contains(queue.Topic, "..") {
+		return fmt.Errorf("inputInterface.queue.topic must be a valid NATS subject")
+	}
+	return nil
+}
+
+func validateKafkaQueue(queue *spec.InputInterfaceQueue) error {
+	for _, server := range strings.Split(queue.Url, ",") {
+		if _, _, err := net.SplitHostPort(strings.TrimSpace(server)); err != nil {
+			return fmt.Errorf("inputInterface.queue.url must be a comma separated list of host:port bootstrap servers")
+		}
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`).MatchString(queue.Topic) || queue.Topic == "." || queue.Topic == ".." {
+		return fmt.Errorf("inputInterface.queue.topic must be a valid Kafka topic name")
+	}
+	return nil
+}
+
+// primaryEndpointPort returns the container port of the endpoint described by the input interface of an api agent
+func primaryEndpointPort(payload spec.CreateAgentRequest) int32 {
+	if StrPointerAsStr(payload.AgentType.SubType, "") == string(AgentSubTypeChatAPI) {
+		return config.GetConfig().DefaultChatAPI.DefaultHTTPPort
+	}
+	return payload.InputInterface.Port
+}
+
+// ValidateConfigOverride validates an environment override of the agent configuration. Overrides are set as
+// environment variables of the agent container, so keys must be valid environment variable names.
+func ValidateConfigOverride(key string, value string) error {
+	if key == "" {
+		return fmt.Errorf("key is required")
+	}
+	if len(key) > ConfigOverrideMaxKeyLength {
+		return fmt.Errorf("key must be at most %d characters", ConfigOverrideMaxKeyLength)
+	}
+	if !regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]*$`).MatchString(key) {
+		return fmt.Errorf("key must start with a letter or underscore and contain only letters, digits, '_', '.' and '-'")
+	}
+	if len(value) > ConfigOverrideMaxValueLength {
+		return fmt.Errorf("value must be at most %d characters", ConfigOverrideMaxValueLength)
+	}
+	return nil
+}
+
+// ValidateAgentEndpoints validates the endpoints an api agent exposes besides its primary endpoint, which listens
+// on primaryPort. Each endpoint gets its own port on the agent's service, so names and ports must be unique.
+func ValidateAgentEndpoints(endpoints []spec.AgentEndpoint, primaryPort int32) error {
+	if len(endpoints) > MaxAgentEndpoints {
+		return fmt.Errorf("at most %d endpoints are supported", MaxAgentEndpoints)
+	}
+	names := make(map[string]bool, len(endpoints))
+	ports := map[int32]bool{primaryPort: true}
+	for i, endpoint := range endpoints {
+		if err := validateAgentEndpoint(endpoint); err != nil {
+			return fmt.Errorf("endpoints[%d]: %w", i, err)
+		}
+		if names[endpoint.Name] {
+			return fmt.Errorf("endpoints[%d]: name %s is used by another endpoint", i, endpoint.Name)
+		}
+		if ports[endpoint.Port] {
+			return fmt.Errorf("endpoints[%d]: port %d is used by another endpoint", i, endpoint.Port)
+		}
+		names[endpoint.Name] = true
+		ports[endpoint.Port] = true
+	}
+	return nil
+}
+
+func validateAgentEndpoint(endpoint spec.AgentEndpoint) error {
+	if len(endpoint.Name) > MaxAgentEndpointNameLength || !regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`).MatchString(endpoint.Name) {
+		return fmt.Errorf("name must start with a letter, contain only lowercase letters, digits and '-', and be at most %d characters", MaxAgentEndpointNameLength)
+	}
+	if endpoint.Name == PrimaryEndpointPortName {
+		return fmt.Errorf("name %s is reserved for the primary endpoint", PrimaryEndpointPortName)
+	}
+	if !isEndpointType(endpoint.Type) {
+		return fmt.Errorf("unsupported type: %s (must be '%s', '%s' or '%s')", endpoint.Type, InputInterfaceTypeHTTP, InputInterfaceTypeGRPC, InputInterfaceTypeWebSocket)
+	}
+	if endpoint.Port <= 0 || endpoint.Port > 65535 {
+		return fmt.Errorf("port must be a valid port number (1-65535)")
+	}
+	if endpoint.Port == PrimaryEndpointServicePort {
+		return fmt.Errorf("port %d is reserved for the service port of the primary endpoint", PrimaryEndpointServicePort)
+	}
+	if endpoint.BasePath != nil && !strings.HasPrefix(*endpoint.BasePath, "/") {
+		return fmt.Errorf("basePath must start with '/'")
+	}
+	if endpoint.Schema != nil || endpoint.Type == string(InputInterfaceTypeGRPC) {
+		if err := validateEndpointSchema("schema", endpoint.Type, endpoint.Schema); err != nil {
+			return err
+		}
+	}
+	if endpoint.Visibility != nil {
+		visibility := EndpointVisibility(*endpoint.Visibility)
+		if visibility != EndpointVisibilityPublic && visibility != EndpointVisibilityPrivate {
+			return fmt.Errorf("unsupported visibility: %s (must be '%s' or '%s')", visibility, EndpointVisibilityPublic, EndpointVisibilityPrivate)
+		}
+	}
+	return nil
+}
+
+// isEndpointType reports whether endpointType is a protocol agents can serve endpoints over
+func isEndpointType(endpointType string) bool {
+	switch InputInterfaceType(endpointType) {
+	case InputInterfaceTypeHTTP, InputInterfaceTypeGRPC, InputInterfaceTypeWebSocket:
+		return true
+	}
+	return false
+}
+
+// validateEndpointSchema validates the schema of an endpoint against its type. HTTP endpoints are described by an
+// OpenAPI file or inline OpenAPI content, gRPC endpoints by a proto file or by server reflection, and WebSocket
+// endpoints optionally by a file describing their messages. field is the name the schema is reported under.
+func validateEndpointSchema(field string, endpointType string, schema *spec.InputInterfaceSchema) error {
+	var path string
+	var reflection bool
+	var content *string
+	if schema != nil {
+		path = strings.TrimSpace(schema.Path)
+		reflection = schema.GetReflection()
+		content = schema.Content
+	}
+	if strings.ContainsAny(path, " \t\r\n") {
+		return fmt.Errorf("%s.path must not contain whitespace", field)
+	}
+	if reflection && endpointType != string(InputInterfaceTypeGRPC) {
+		return fmt.Errorf("%s.reflection is only supported for %s endpoints", field, InputInterfaceTypeGRPC)
+	}
+	if content != nil && endpointType != string(InputInterfaceTypeHTTP) {
+		return fmt.Errorf("%s.content is only supported for %s endpoints", field, InputInterfaceTypeHTTP)
+	}
+	switch InputInterfaceType(endpointType) {
+	case InputInterfaceTypeHTTP:
+		if path == "" && content == nil {
+			return fmt.Errorf("%s must set either the path of an OpenAPI file or its content", field)
+		}
+		if path != "" && content != nil {
+			return fmt.Errorf("%s must not set both a path and content", field)
+		}
+		if content != nil {
+			if err := openapi.Validate([]byte(*content)); err != nil {
+				return fmt.Errorf("%s.content is not valid: %w", field, err)
+			}
+		}
+	case InputInterfaceTypeGRPC:
+		if path == "" && !reflection {
+			return fmt.Errorf("%s must set either the path of a proto file or reflection for %s endpoints", field, InputInterfaceTypeGRPC)
+		}
+		if path != "" && reflection {
+			return fmt.Errorf("%s must not set both a path and reflection", field)
+		}
+		if path != "" && !strings.HasSuffix(path, ".proto") {
+			return fmt.Errorf("%s.path must point to a .proto file for %s endpoints", field, InputInterfaceTypeGRPC)
+		}
+	}
+	return nil
+}
+
+// validateJobConfig validates the schedule and execution settings of a job agent. All settings are optional;
+// a job without a schedule only runs when triggered.
+func validateJobConfig(jobConfig *spec.JobConfig) error {
+	if jobConfig.Schedule != nil {
+		if err := ValidateCronSchedule(*jobConfig.Schedule); err != nil {
+			return fmt.Errorf("invalid schedule: %w", err)
+		}
+	}
+	if jobConfig.TimeoutSeconds != nil && *jobConfig.TimeoutSeconds <= 0 {
+		return fmt.Errorf("timeoutSeconds must be greater than 0")
+	}
+	if jobConfig.Retries != nil && (*jobConfig.Retries < 0 || *jobConfig.Retries > MaxJobRetries) {
+		return fmt.Errorf("retries must be between 0 and %d", MaxJobRetries)
+	}
+	if jobConfig.ConcurrencyPolicy != nil {
+		policy := JobConcurrencyPolicy(*jobConfig.ConcurrencyPolicy)
+		if policy != JobConcurrencyPolicyAllow && policy != JobConcurrencyPolicyForbid && policy != JobConcurrencyPolicyReplace {
+			return fmt.Errorf("unsupported concurrencyPolicy: %s (must be '%s', '%s' or '%s')", policy,
+				JobConcurrencyPolicyAllow, JobConcurrencyPolicyForbid, JobConcurrencyPolicyReplace)
+		}
+	}
+	return nil
+}
+
+// ValidateAgentScaling validates the resources and the fixed or autoscaled replica count of an agent
+func ValidateAgentScaling(resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+	if resources != nil {
+		if err := validateResourceRequirements(resources); err != nil {
+			return err
+		}
+	}
+	if replicas != nil && autoscaling != nil {
+		return fmt.Errorf("replicas and autoscaling must not both be set")
+	}
+	if replicas != nil && *replicas < 1 {
+		return fmt.Errorf("replicas must be at least 1")
+	}
+	if autoscaling != nil {
+		if err := validateAutoscaling(autoscaling); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateResourceRequirements(resources *spec.ResourceRequirements) error {
+	for _, name := range []ResourceName{ResourceCPU, ResourceMemory} {
+		request, err := parseResourceQuantity(fmt.Sprintf("resources.requests.%s", name), resourceQuantityValue(resources.Requests, name))
+		if err != nil {
+			return err
+		}
+		limit, err := parseResourceQuantity(fmt.Sprintf("resources.limits.%s", name), resourceQuantityValue(resources.Limits, name))
+		if err != nil {
+			return err
+		}
+		if request != nil && limit != nil && request.Cmp(*limit) > 0 {
+			return fmt.Errorf("resources.requests.%s must not exceed resources.limits.%s", name, name)
+		}
+	}
+	return nil
+}
 
+func validateAutoscaling(autoscaling *spec.AutoscalingConfig) error {
+	if autoscaling.MinReplicas < 1 {
+		return fmt.Errorf("autoscaling.minReplicas must be at least 1")
+	}
+	if autoscaling.MaxReplicas < autoscaling.MinReplicas {
+		return fmt.Errorf("autoscaling.maxReplicas must not be less than autoscaling.minReplicas")
+	}
+	if autoscaling.TargetCPUUtilizationPercentage == nil && autoscaling.TargetConcurrency == nil {
+		return fmt.Errorf("autoscaling must set targetCPUUtilizationPercentage or targetConcurrency")
+	}
+	if target := autoscaling.TargetCPUUtilizationPercentage; target != nil && (*target < 1 || *target > 100) {
+		return fmt.Errorf("autoscaling.targetCPUUtilizationPercentage must be between 1 and 100")
+	}
+	if target := autoscaling.TargetConcurrency; target != nil && *target < 1 {
+		return fmt.Errorf("autoscaling.targetConcurrency must be at least 1")
+	}
+	return nil
+}
+
+// ValidateAgentScalingCaps checks the resources and replicas of an agent against the caps of an environment. A limit
+// that is not set defaults to at least its request, so the request is checked in its place.
+func ValidateAgentScalingCaps(environment string, caps config.ResourceCaps, resources *spec.ResourceRequirements, replicas *int32, autoscaling *spec.AutoscalingConfig) error {
+	if resources != nil {
+		maxQuantities := map[ResourceName]resource.Quantity{ResourceCPU: caps.MaxCPU, ResourceMemory: caps.MaxMemory}
+		for _, name := range []ResourceName{ResourceCPU, ResourceMemory} {
+			field, value := fmt.Sprintf("resources.limits.%s", name), resourceQuantityValue(resources.Limits, name)
+			if value == nil {
+				field, value = fmt.Sprintf("resources.requests.%s", name), resourceQuantityValue(resources.Requests, name)
+			}
+			if value == nil {
+				continue
+			}
+			maxQuantity := maxQuantities[name]
+			if quantity, err := resource.ParseQuantity(*value); err == nil && quantity.Cmp(maxQuantity) > 0 {
+				return fmt.Errorf("%s %s exceeds the cap of %s in environment %s", field, *value, maxQuantity.String(), environment)
+			}
+		}
+	}
+	if replicas != nil && *replicas > caps.MaxReplicas {
+		return fmt.Errorf("replicas %d exceeds the cap of %d in environment %s", *replicas, caps.MaxReplicas, environment)
+	}
+	if autoscaling != nil && autoscaling.MaxReplicas > caps.MaxReplicas {
+		return fmt.Errorf("autoscaling.maxReplicas %d exceeds the cap of %d in environment %s", autoscaling.MaxReplicas, caps.MaxReplicas, environment)
+	}
+	return nil
+}
+
+// resourceQuantityValue returns the cpu or memory of a resource quantity, or nil when it is not set
+func resourceQuantityValue(quantity *spec.ResourceQuantity, name ResourceName) *string {
+	if quantity == nil {
+		return nil
+	}
+	if name == ResourceCPU {
+		return quantity.Cpu
+	}
+	return quantity.Memory
+}
+
+func parseResourceQuantity(field string, value *string) (*resource.Quantity, error) {
+	if value == nil {
+		return nil, nil
+	}
+	quantity, err := resource.ParseQuantity(*value)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid quantity: %s", field, *value)
+	}
+	if quantity.Sign() <= 0 {
+		return nil, fmt.Errorf("%s must be greater than 0", field)
+	}
+	return &quantity, nil
+}
+
+// ValidateAgentProbes validates the liveness, readiness and startup probes of an agent
+func ValidateAgentProbes(probes *spec.AgentProbes) error {
+	for _, probe := range []struct {
+		name  string
+		probe *spec.Probe
+	}{
+		{"probes.liveness", probes.Liveness},
+		{"probes.readiness", probes.Readiness},
+		{"probes.startup", probes.Startup},
+	} {
+		if probe.probe == nil {
+			continue
+		}
+		if err := validateProbe(probe.name, probe.probe); err != nil {
+			return err
+		}
+	}
+	// Kubernetes only allows readiness probes to require more than one success
+	if probes.Liveness != nil && probes.Liveness.SuccessThreshold != nil && *probes.Liveness.SuccessThreshold != 1 {
+		return fmt.Errorf("probes.liveness.successThreshold must be 1")
+	}
+	if probes.Startup != nil && probes.Startup.SuccessThreshold != nil && *probes.Startup.SuccessThreshold != 1 {
+		return fmt.Errorf("probes.startup.successThreshold must be 1")
+	}
+	return nil
+}
+
+func validateProbe(name string, probe *spec.Probe) error {
+	if (probe.HttpGet == nil) == (probe.Exec == nil) {
+		return fmt.Errorf("%s must set exactly one of httpGet and exec", name)
+	}
+	if probe.HttpGet != nil {
+		if !strings.HasPrefix(probe.HttpGet.Path, "/") {
+			return fmt.Errorf("%s.httpGet.path must start with /", name)
+		}
+		if probe.HttpGet.Port < 1 || probe.HttpGet.Port > 65535 {
+			return fmt.Errorf("%s.httpGet.port must be between 1 and 65535", name)
+		}
+	}
+	if probe.Exec != nil && len(probe.Exec.Command) == 0 {
+		return fmt.Errorf("%s.exec.command must not be empty", name)
+	}
+	if probe.InitialDelaySeconds != nil && *probe.InitialDelaySeconds < 0 {
+		return fmt.Errorf("%s.initialDelaySeconds must not be negative", name)
+	}
+	for _, field := range []struct {
+		name  string
+		value *int32
+	}{
+		{"periodSeconds", probe.PeriodSeconds},
+		{"timeoutSeconds", probe.TimeoutSeconds},
+		{"failureThreshold", probe.FailureThreshold},
+		{"successThreshold", probe.SuccessThreshold},
+	} {
+		if field.value != nil && *field.value < 1 {
+			return fmt.Errorf("%s.%s must be at least 1", name, field.name)
+		}
+	}
 	return nil
 }
 
@@ -280,6 +890,28 @@ func ValidateResourceNameRequest(payload spec.ResourceNameRequest) error {
 	return nil
 }
 
+// ParseUsageGroupBy parses a comma separated groupBy query value into usage report dimensions
+func ParseUsageGroupBy(groupBy string) ([]UsageGroupBy, error) {
+	if strings.TrimSpace(groupBy) == "" {
+		return []UsageGroupBy{UsageGroupByModel}, nil
+	}
+	seen := make(map[UsageGroupBy]bool)
+	var dimensions []UsageGroupBy
+	for _, value := range strings.Split(groupBy, ",") {
+		dimension := UsageGroupBy(strings.ToLower(strings.TrimSpace(value)))
+		switch dimension {
+		case UsageGroupByOrg, UsageGroupByProject, UsageGroupByAgent, UsageGroupByModel:
+		default:
+			return nil, fmt.Errorf("unsupported groupBy value '%s': must be one of org, project, agent, model", value)
+		}
+		if !seen[dimension] {
+			seen[dimension] = true
+			dimensions = append(dimensions, dimension)
+		}
+	}
+	return dimensions, nil
+}
+
 // WriteSuccessResponse writes a successful API response
 func WriteSuccessResponse[T any](w http.ResponseWriter, statusCode int, data T) {
 	w.Header().Set("Content-Type", "application/json")
@@ -300,6 +932,37 @@ func WriteErrorResponse(w http.ResponseWriter, statusCode int, message string) {
 	_ = json.NewEncoder(w).Encode(errPayload) // Ignore encoding errors for response
 }
 
+// WriteCSVResponse writes records as a CSV attachment
+func WriteCSVResponse(w http.ResponseWriter, statusCode int, fileName string, records [][]string) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.WriteHeader(statusCode)
+	_ = csv.NewWriter(w).WriteAll(records) // Ignore write errors for response
+}
+
+// CopyAndFlush copies a streamed response body to the client, flushing after every chunk so that
+// the client receives data as soon as it is produced
+func CopyAndFlush(rc *http.ResponseController, w io.Writer, src io.Reader) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, readErr := src.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return err
+			}
+			if err := rc.Flush(); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
 // generateRandomSuffix creates a random suffix of specified length using custom alphabet
 func generateRandomSuffix(length int) string {
 	result := make([]byte, length)
@@ -19,18 +19,28 @@ package wiring
 import (
 	observabilitysvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
 	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	queuesvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/queuesvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 )
 
 type AppParams struct {
-	AuthMiddleware          jwtassertion.Middleware
-	AgentController         controllers.AgentController
-	InfraResourceController controllers.InfraResourceController
-	BuildCIController       controllers.BuildCIController
-	ObservabilityController controllers.ObservabilityController
+	AuthMiddleware            jwtassertion.Middleware
+	AgentController           controllers.AgentController
+	InfraResourceController   controllers.InfraResourceController
+	BuildCIController         controllers.BuildCIController
+	ObservabilityController   controllers.ObservabilityController
+	APIKeyController          controllers.APIKeyController
+	TraceAnnotationController controllers.TraceAnnotationController
+	EvalDatasetController     controllers.EvalDatasetController
+	EvalRunController         controllers.EvalRunController
+	JobRunController          controllers.JobRunController
+	AgentConfigController     controllers.AgentConfigController
+	// EvalRunManagerService recovers the evaluation runs interrupted by a restart at startup
+	EvalRunManagerService services.EvalRunManagerService
 }
 
 // TestClients contains all mock clients needed for testing
@@ -38,6 +48,7 @@ type TestClients struct {
 	OpenChoreoSvcClient    clients.OpenChoreoSvcClient
 	ObservabilitySvcClient observabilitysvc.ObservabilitySvcClient
 	TraceObserverClient    traceobserversvc.TraceObserverClient
+	QueueClient            queuesvc.QueueClient
 }
 
 func ProvideConfigFromPtr(config *config.Config) config.Config {
@@ -24,8 +24,11 @@ import (
 
 	"github.com/google/wire"
 
+	evaluationsvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	mcpsvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/mcpsvc"
 	observabilitysvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
 	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	queuesvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/queuesvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
@@ -43,12 +46,20 @@ var repositoryProviderSet = wire.NewSet(
 	repositories.NewAgentRepository,
 	repositories.NewProjectRepository,
 	repositories.NewInternalAgentRepository,
+	repositories.NewAPIKeyRepository,
+	repositories.NewTraceAnnotationRepository,
+	repositories.NewEvalDatasetRepository,
+	repositories.NewEvalRunRepository,
+	repositories.NewAgentConfigOverrideRepository,
 )
 
 var clientProviderSet = wire.NewSet(
 	clients.NewOpenChoreoSvcClient,
 	observabilitysvc.NewObservabilitySvcClient,
 	traceobserversvc.NewTraceObserverClient,
+	evaluationsvc.NewEvaluationClient,
+	mcpsvc.NewMCPClient,
+	queuesvc.NewQueueClient,
 )
 
 var serviceProviderSet = wire.NewSet(
@@ -56,6 +67,12 @@ var serviceProviderSet = wire.NewSet(
 	services.NewBuildCIManager,
 	services.NewInfraResourceManager,
 	services.NewObservabilityManager,
+	services.NewAPIKeyManagerService,
+	services.NewTraceAnnotationManagerService,
+	services.NewEvalDatasetManagerService,
+	services.NewEvalRunManagerService,
+	services.NewJobRunManagerService,
+	services.NewAgentConfigManagerService,
 )
 
 var controllerProviderSet = wire.NewSet(
@@ -63,12 +80,23 @@ var controllerProviderSet = wire.NewSet(
 	controllers.NewBuildCIController,
 	controllers.NewInfraResourceController,
 	controllers.NewObservabilityController,
+	controllers.NewAPIKeyController,
+	controllers.NewTraceAnnotationController,
+	controllers.NewEvalDatasetController,
+	controllers.NewEvalRunController,
+	controllers.NewJobRunController,
+	controllers.NewAgentConfigController,
 )
 
 var testClientProviderSet = wire.NewSet(
 	ProvideTestOpenChoreoSvcClient,
 	ProvideTestObservabilitySvcClient,
 	ProvideTestTraceObserverClient,
+	ProvideTestQueueClient,
+	// Evaluation runs call agents and model endpoints over HTTP, which tests point at local stub servers
+	evaluationsvc.NewEvaluationClient,
+	// MCP servers are introspected over HTTP, which tests point at local stub servers
+	mcpsvc.NewMCPClient,
 )
 
 // ProvideLogger provides the configured slog.Logger instance
@@ -95,6 +123,11 @@ func ProvideTestTraceObserverClient(testClients TestClients) traceobserversvc.Tr
 	return testClients.TraceObserverClient
 }
 
+// ProvideTestQueueClient extracts the QueueClient from TestClients
+func ProvideTestQueueClient(testClients TestClients) queuesvc.QueueClient {
+	return testClients.QueueClient
+}
+
 func InitializeAppParams(cfg *config.Config) (*AppParams, error) {
 	wire.Build(
 		configProviderSet,
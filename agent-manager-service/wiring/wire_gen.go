@@ -11,8 +11,11 @@ import (
 
 	"github.com/google/wire"
 
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/evaluationsvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/mcpsvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/queuesvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
@@ -35,22 +38,49 @@ func InitializeAppParams(cfg *config.Config) (*AppParams, error) {
 		return nil, err
 	}
 	observabilitySvcClient := observabilitysvc.NewObservabilitySvcClient()
+	mcpClient := mcpsvc.NewMCPClient()
+	queueClient := queuesvc.NewQueueClient()
 	logger := ProvideLogger()
-	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, openChoreoSvcClient, observabilitySvcClient, logger)
+	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, openChoreoSvcClient, observabilitySvcClient, mcpClient, queueClient, logger)
 	agentController := controllers.NewAgentController(agentManagerService)
 	infraResourceManager := services.NewInfraResourceManager(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, logger)
 	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
 	buildCIManagerService := services.NewBuildCIManager(openChoreoSvcClient, logger, organizationRepository, projectRepository, agentRepository)
 	buildCIController := controllers.NewBuildCIController(buildCIManagerService)
+	traceAnnotationRepository := repositories.NewTraceAnnotationRepository()
 	traceObserverClient := traceobserversvc.NewTraceObserverClient()
-	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, logger)
+	observabilityManagerService := services.NewObservabilityManager(organizationRepository, projectRepository, agentRepository, traceAnnotationRepository, openChoreoSvcClient, traceObserverClient, logger)
 	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
+	apiKeyRepository := repositories.NewAPIKeyRepository()
+	apiKeyManagerService := services.NewAPIKeyManagerService(organizationRepository, projectRepository, agentRepository, apiKeyRepository, openChoreoSvcClient, logger)
+	apiKeyController := controllers.NewAPIKeyController(apiKeyManagerService)
+	traceAnnotationManagerService := services.NewTraceAnnotationManagerService(organizationRepository, projectRepository, agentRepository, traceAnnotationRepository, logger)
+	traceAnnotationController := controllers.NewTraceAnnotationController(traceAnnotationManagerService)
+	evalDatasetRepository := repositories.NewEvalDatasetRepository()
+	evalDatasetManagerService := services.NewEvalDatasetManagerService(organizationRepository, projectRepository, agentRepository, evalDatasetRepository, observabilityManagerService, logger)
+	evalDatasetController := controllers.NewEvalDatasetController(evalDatasetManagerService)
+	evalRunRepository := repositories.NewEvalRunRepository()
+	evaluationClient := evaluationsvc.NewEvaluationClient()
+	evalRunManagerService := services.NewEvalRunManagerService(organizationRepository, projectRepository, agentRepository, evalRunRepository, evalDatasetManagerService, agentManagerService, evaluationClient, logger)
+	evalRunController := controllers.NewEvalRunController(evalRunManagerService)
+	jobRunManagerService := services.NewJobRunManagerService(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, observabilitySvcClient, logger)
+	jobRunController := controllers.NewJobRunController(jobRunManagerService)
+	agentConfigOverrideRepository := repositories.NewAgentConfigOverrideRepository()
+	agentConfigManagerService := services.NewAgentConfigManagerService(organizationRepository, projectRepository, agentRepository, agentConfigOverrideRepository, openChoreoSvcClient, logger)
+	agentConfigController := controllers.NewAgentConfigController(agentConfigManagerService)
 	appParams := &AppParams{
-		AuthMiddleware:          middleware,
-		AgentController:         agentController,
-		InfraResourceController: infraResourceController,
-		BuildCIController:       buildCIController,
-		ObservabilityController: observabilityController,
+		AuthMiddleware:            middleware,
+		AgentController:           agentController,
+		InfraResourceController:   infraResourceController,
+		BuildCIController:         buildCIController,
+		ObservabilityController:   observabilityController,
+		APIKeyController:          apiKeyController,
+		TraceAnnotationController: traceAnnotationController,
+		EvalDatasetController:     evalDatasetController,
+		EvalRunController:         evalRunController,
+		JobRunController:          jobRunController,
+		AgentConfigController:     agentConfigController,
+		EvalRunManagerService:     evalRunManagerService,
 	}
 	return appParams, nil
 }
@@ -62,22 +92,49 @@ func InitializeTestAppParamsWithClientMocks(cfg *config.Config, authMiddleware j
 	internalAgentRepository := repositories.NewInternalAgentRepository()
 	openChoreoSvcClient := ProvideTestOpenChoreoSvcClient(testClients)
 	observabilitySvcClient := ProvideTestObservabilitySvcClient(testClients)
+	mcpClient := mcpsvc.NewMCPClient()
+	queueClient := ProvideTestQueueClient(testClients)
 	logger := ProvideLogger()
-	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, openChoreoSvcClient, observabilitySvcClient, logger)
+	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, openChoreoSvcClient, observabilitySvcClient, mcpClient, queueClient, logger)
 	agentController := controllers.NewAgentController(agentManagerService)
 	infraResourceManager := services.NewInfraResourceManager(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, logger)
 	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
 	buildCIManagerService := services.NewBuildCIManager(openChoreoSvcClient, logger, organizationRepository, projectRepository, agentRepository)
 	buildCIController := controllers.NewBuildCIController(buildCIManagerService)
+	traceAnnotationRepository := repositories.NewTraceAnnotationRepository()
 	traceObserverClient := ProvideTestTraceObserverClient(testClients)
-	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, logger)
+	observabilityManagerService := services.NewObservabilityManager(organizationRepository, projectRepository, agentRepository, traceAnnotationRepository, openChoreoSvcClient, traceObserverClient, logger)
 	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
+	apiKeyRepository := repositories.NewAPIKeyRepository()
+	apiKeyManagerService := services.NewAPIKeyManagerService(organizationRepository, projectRepository, agentRepository, apiKeyRepository, openChoreoSvcClient, logger)
+	apiKeyController := controllers.NewAPIKeyController(apiKeyManagerService)
+	traceAnnotationManagerService := services.NewTraceAnnotationManagerService(organizationRepository, projectRepository, agentRepository, traceAnnotationRepository, logger)
+	traceAnnotationController := controllers.NewTraceAnnotationController(traceAnnotationManagerService)
+	evalDatasetRepository := repositories.NewEvalDatasetRepository()
+	evalDatasetManagerService := services.NewEvalDatasetManagerService(organizationRepository, projectRepository, agentRepository, evalDatasetRepository, observabilityManagerService, logger)
+	evalDatasetController := controllers.NewEvalDatasetController(evalDatasetManagerService)
+	evalRunRepository := repositories.NewEvalRunRepository()
+	evaluationClient := evaluationsvc.NewEvaluationClient()
+	evalRunManagerService := services.NewEvalRunManagerService(organizationRepository, projectRepository, agentRepository, evalRunRepository, evalDatasetManagerService, agentManagerService, evaluationClient, logger)
+	evalRunController := controllers.NewEvalRunController(evalRunManagerService)
+	jobRunManagerService := services.NewJobRunManagerService(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, observabilitySvcClient, logger)
+	jobRunController := controllers.NewJobRunController(jobRunManagerService)
+	agentConfigOverrideRepository := repositories.NewAgentConfigOverrideRepository()
+	agentConfigManagerService := services.NewAgentConfigManagerService(organizationRepository, projectRepository, agentRepository, agentConfigOverrideRepository, openChoreoSvcClient, logger)
+	agentConfigController := controllers.NewAgentConfigController(agentConfigManagerService)
 	appParams := &AppParams{
-		AuthMiddleware:          authMiddleware,
-		AgentController:         agentController,
-		InfraResourceController: infraResourceController,
-		BuildCIController:       buildCIController,
-		ObservabilityController: observabilityController,
+		AuthMiddleware:            authMiddleware,
+		AgentController:           agentController,
+		InfraResourceController:   infraResourceController,
+		BuildCIController:         buildCIController,
+		ObservabilityController:   observabilityController,
+		APIKeyController:          apiKeyController,
+		TraceAnnotationController: traceAnnotationController,
+		EvalDatasetController:     evalDatasetController,
+		EvalRunController:         evalRunController,
+		JobRunController:          jobRunController,
+		AgentConfigController:     agentConfigController,
+		EvalRunManagerService:     evalRunManagerService,
 	}
 	return appParams, nil
 }
@@ -88,18 +145,19 @@ var configProviderSet = wire.NewSet(
 	ProvideConfigFromPtr,
 )
 
-var repositoryProviderSet = wire.NewSet(repositories.NewOrganizationRepository, repositories.NewAgentRepository, repositories.NewProjectRepository, repositories.NewInternalAgentRepository)
+var repositoryProviderSet = wire.NewSet(repositories.NewOrganizationRepository, repositories.NewAgentRepository, repositories.NewProjectRepository, repositories.NewInternalAgentRepository, repositories.NewAPIKeyRepository, repositories.NewTraceAnnotationRepository, repositories.NewEvalDatasetRepository, repositories.NewEvalRunRepository, repositories.NewAgentConfigOverrideRepository)
 
-var clientProviderSet = wire.NewSet(openchoreosvc.NewOpenChoreoSvcClient, observabilitysvc.NewObservabilitySvcClient, traceobserversvc.NewTraceObserverClient)
+var clientProviderSet = wire.NewSet(openchoreosvc.NewOpenChoreoSvcClient, observabilitysvc.NewObservabilitySvcClient, traceobserversvc.NewTraceObserverClient, evaluationsvc.NewEvaluationClient, mcpsvc.NewMCPClient, queuesvc.NewQueueClient)
 
-var serviceProviderSet = wire.NewSet(services.NewAgentManagerService, services.NewBuildCIManager, services.NewInfraResourceManager, services.NewObservabilityManager)
+var serviceProviderSet = wire.NewSet(services.NewAgentManagerService, services.NewBuildCIManager, services.NewInfraResourceManager, services.NewObservabilityManager, services.NewAPIKeyManagerService, services.NewTraceAnnotationManagerService, services.NewEvalDatasetManagerService, services.NewEvalRunManagerService, services.NewJobRunManagerService, services.NewAgentConfigManagerService)
 
-var controllerProviderSet = wire.NewSet(controllers.NewAgentController, controllers.NewBuildCIController, controllers.NewInfraResourceController, controllers.NewObservabilityController)
+var controllerProviderSet = wire.NewSet(controllers.NewAgentController, controllers.NewBuildCIController, controllers.NewInfraResourceController, controllers.NewObservabilityController, controllers.NewAPIKeyController, controllers.NewTraceAnnotationController, controllers.NewEvalDatasetController, controllers.NewEvalRunController, controllers.NewJobRunController, controllers.NewAgentConfigController)
 
 var testClientProviderSet = wire.NewSet(
 	ProvideTestOpenChoreoSvcClient,
 	ProvideTestObservabilitySvcClient,
 	ProvideTestTraceObserverClient,
+	ProvideTestQueueClient, evaluationsvc.NewEvaluationClient, mcpsvc.NewMCPClient,
 )
 
 // ProvideLogger provides the configured slog.Logger instance
@@ -125,3 +183,8 @@ func ProvideTestObservabilitySvcClient(testClients TestClients) observabilitysvc
 func ProvideTestTraceObserverClient(testClients TestClients) traceobserversvc.TraceObserverClient {
 	return testClients.TraceObserverClient
 }
+
+// ProvideTestQueueClient extracts the QueueClient from TestClients
+func ProvideTestQueueClient(testClients TestClients) queuesvc.QueueClient {
+	return testClients.QueueClient
+}
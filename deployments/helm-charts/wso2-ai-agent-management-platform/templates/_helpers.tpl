@@ -201,6 +201,39 @@ PostgreSQL password secret key
 {{- end }}
 {{- end }}
 
+{{/*
+==============================================
+Traces Observer Authentication
+==============================================
+*/}}
+
+{{/*
+Traces Observer shared secret or signing key secret name
+Fails rendering when authentication is enabled without a secret, so that the query API is never left open by default
+*/}}
+{{- define "agent-management-platform.traceObserver.secretName" -}}
+{{- with .Values.agentManagerService.config.traceObserver }}
+{{- if .existingSecret }}
+{{- .existingSecret }}
+{{- else if .secret }}
+{{- printf "%s-traces-observer" (include "agent-management-platform.agentManagerService.fullname" $) }}
+{{- else }}
+{{- fail "agentManagerService.config.traceObserver.secret or existingSecret is required when authMode is shared-secret or jwt, set authMode to none to disable Traces Observer authentication" }}
+{{- end }}
+{{- end }}
+{{- end }}
+
+{{/*
+Traces Observer shared secret or signing key secret key
+*/}}
+{{- define "agent-management-platform.traceObserver.secretKey" -}}
+{{- if .Values.agentManagerService.config.traceObserver.existingSecret }}
+{{- .Values.agentManagerService.config.traceObserver.existingSecretKey }}
+{{- else }}
+{{- print "traces-observer-secret" }}
+{{- end }}
+{{- end }}
+
 {{/*
 ==============================================
 Image Pull Secrets
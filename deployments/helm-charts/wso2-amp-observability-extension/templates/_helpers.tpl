@@ -60,3 +60,30 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+Traces Observer shared secret or signing key secret name
+Fails rendering when authentication is enabled without a secret, so that the query API is never left open by default
+*/}}
+{{- define "amp-observability-extension.tracesObserverAuthSecretName" -}}
+{{- with .Values.tracesObserver.auth }}
+{{- if .existingSecret }}
+{{- .existingSecret }}
+{{- else if .secret }}
+{{- printf "%s-auth" $.Values.tracesObserver.name }}
+{{- else }}
+{{- fail "tracesObserver.auth.secret or existingSecret is required when auth.mode is shared-secret or jwt, set auth.mode to none to disable query API authentication" }}
+{{- end }}
+{{- end }}
+{{- end }}
+
+{{/*
+Traces Observer shared secret or signing key secret key
+*/}}
+{{- define "amp-observability-extension.tracesObserverAuthSecretKey" -}}
+{{- if .Values.tracesObserver.auth.existingSecret }}
+{{- .Values.tracesObserver.auth.existingSecretKey }}
+{{- else }}
+{{- print "traces-observer-secret" }}
+{{- end }}
+{{- end }}
@@ -37,6 +37,16 @@ if [[ -z "${OBSERVABILITY_HELM_ARGS+x}" ]]; then
     OBSERVABILITY_HELM_ARGS=()
 fi
 
+# Shared secret the Agent Manager authenticates to the Traces Observer query API with.
+# A re-run reuses the secret of an existing platform installation so that both charts keep matching.
+if [[ -z "${TRACES_OBSERVER_SHARED_SECRET:-}" ]]; then
+    TRACES_OBSERVER_SHARED_SECRET="$(kubectl get secret amp-api-traces-observer -n "${AMP_NS}" \
+        -o jsonpath='{.data.traces-observer-secret}' 2>/dev/null | base64 -d 2>/dev/null || true)"
+fi
+if [[ -z "${TRACES_OBSERVER_SHARED_SECRET}" ]]; then
+    TRACES_OBSERVER_SHARED_SECRET="$(head -c 32 /dev/urandom | od -An -tx1 | tr -d ' \n')"
+fi
+
 # Timeouts (in seconds)
 TIMEOUT_AMP_INSTALL=1800
 TIMEOUT_DEPLOYMENT=600
@@ -118,6 +128,7 @@ install_agent_management_platform() {
     # Install Helm chart
     if ! install_amp_helm_chart "${release_name}" "${chart_ref}" "${AMP_NS}" "${TIMEOUT_AMP_INSTALL}" \
         --version "${chart_version}" \
+        --set-string agentManagerService.config.traceObserver.secret="${TRACES_OBSERVER_SHARED_SECRET}" \
         "${AMP_HELM_ARGS[@]}" >"${helm_log}" 2>&1; then
         echo "Helm installation log (last 50 lines):"
         tail -50 "${helm_log}" 2>/dev/null || cat "${helm_log}" 2>/dev/null || echo "Log file not available"
@@ -181,6 +192,7 @@ install_observability_extension() {
     # Install Helm chart
     if ! install_amp_helm_chart "${release_name}" "${chart_ref}" "${OBSERVABILITY_NS}" "${TIMEOUT_AMP_INSTALL}" \
         --version "${chart_version}" \
+        --set-string tracesObserver.auth.secret="${TRACES_OBSERVER_SHARED_SECRET}" \
         "${OBSERVABILITY_HELM_ARGS[@]}"; then
         return 1
     fi
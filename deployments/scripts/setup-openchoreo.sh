@@ -194,7 +194,8 @@ else
         --create-namespace \
         --namespace openchoreo-observability-plane \
         --timeout=10m \
-        --set tracesObserver.developmentMode=true
+        --set tracesObserver.developmentMode=true \
+        --set tracesObserver.auth.mode=none
 fi
 
 echo "⏳ Waiting for Observability Plane pods to be ready..."
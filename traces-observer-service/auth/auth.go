@@ -0,0 +1,76 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// ErrForbidden is returned when a query asks for components or projects its token does not cover
+var ErrForbidden = errors.New("forbidden")
+
+type claimsCtxKey struct{}
+
+// WithClaims returns a context carrying the claims of the query token the request was authenticated with
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsCtxKey{}, claims)
+}
+
+// ClaimsFromContext returns the query token claims of the request, or nil if the request was not authenticated with a token
+func ClaimsFromContext(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(claimsCtxKey{}).(*Claims)
+	return claims
+}
+
+// Authorize checks that the query token of the request covers every requested component and project UID.
+// Requests authenticated without a token, with the shared secret or with authentication disabled, are trusted
+// callers and may query any UID. A token never authorizes a query that is not scoped to a component or project.
+func Authorize(ctx context.Context, componentUids []string, projectUids []string) error {
+	claims := ClaimsFromContext(ctx)
+	if claims == nil {
+		return nil
+	}
+
+	if len(componentUids) == 0 && len(projectUids) == 0 {
+		return fmt.Errorf("%w: query must be scoped to a component or project", ErrForbidden)
+	}
+	for _, componentUid := range componentUids {
+		if !slices.Contains(claims.ComponentUids, componentUid) {
+			return fmt.Errorf("%w: component %s", ErrForbidden, componentUid)
+		}
+	}
+	for _, projectUid := range projectUids {
+		if !slices.Contains(claims.ProjectUids, projectUid) {
+			return fmt.Errorf("%w: project %s", ErrForbidden, projectUid)
+		}
+	}
+	return nil
+}
+
+// BearerToken returns the bearer token of the Authorization header
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) > len("Bearer ") && strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return strings.TrimSpace(header[len("Bearer "):])
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidToken is returned when a token is malformed, wrongly signed, expired or issued for another audience
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the claims of a query token. A token authorizes queries on the listed components and projects only,
+// which the issuer resolved from the organization the caller is allowed to see.
+type Claims struct {
+	Issuer        string   `json:"iss,omitempty"`
+	Subject       string   `json:"sub,omitempty"`
+	Audience      string   `json:"aud,omitempty"`
+	IssuedAt      int64    `json:"iat,omitempty"`
+	ExpiresAt     int64    `json:"exp"`
+	ComponentUids []string `json:"componentUids,omitempty"`
+	ProjectUids   []string `json:"projectUids,omitempty"`
+}
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ,omitempty"`
+}
+
+// ParseToken verifies an HS256 signed JWT and returns its claims. The token must not be expired at now and,
+// when audience is set, must have been issued for it.
+func ParseToken(token string, signingKey []byte, audience string, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+	var header tokenHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+	// Only accept the algorithm the key is meant for, so that "none" or asymmetric algorithms cannot be substituted
+	if header.Algorithm != "HS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+	if !hmac.Equal(signature, sign(parts[0]+"."+parts[1], signingKey)) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidToken)
+	}
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+	var claims Claims
+	if err := json.Unmarshal(claimsJSON, &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	if claims.ExpiresAt == 0 || !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+	if audience != "" && claims.Audience != audience {
+		return nil, fmt.Errorf("%w: token not issued for %s", ErrInvalidToken, audience)
+	}
+
+	return &claims, nil
+}
+
+// SignToken creates an HS256 signed JWT with the given claims
+func SignToken(claims Claims, signingKey []byte) (string, error) {
+	headerJSON, err := json.Marshal(tokenHeader{Algorithm: "HS256", Type: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sign(signingInput, signingKey)), nil
+}
+
+func sign(signingInput string, signingKey []byte) []byte {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
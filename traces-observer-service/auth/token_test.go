@@ -0,0 +1,95 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSigningKey = []byte("test-signing-key")
+
+func TestParseToken(t *testing.T) {
+	now := time.Date(2025, time.January, 10, 12, 0, 0, 0, time.UTC)
+	claims := Claims{
+		Subject:       "agent-manager",
+		Audience:      "traces-observer",
+		IssuedAt:      now.Unix(),
+		ExpiresAt:     now.Add(5 * time.Minute).Unix(),
+		ComponentUids: []string{"component-uid-1"},
+	}
+	token, err := SignToken(claims, testSigningKey)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	parsed, err := ParseToken(token, testSigningKey, "traces-observer", now)
+	if err != nil {
+		t.Fatalf("expected a valid token, got %v", err)
+	}
+	if parsed.Subject != "agent-manager" || len(parsed.ComponentUids) != 1 || parsed.ComponentUids[0] != "component-uid-1" {
+		t.Errorf("unexpected claims: %+v", parsed)
+	}
+
+	// Header of a token with the "none" algorithm and the original claims and signature
+	noneToken := "eyJhbGciOiJub25lIn0" + token[strings.Index(token, "."):]
+
+	invalid := map[string]struct {
+		token    string
+		key      []byte
+		audience string
+		now      time.Time
+	}{
+		"wrong key":      {token, []byte("other-key"), "traces-observer", now},
+		"other audience": {token, testSigningKey, "other-service", now},
+		"expired":        {token, testSigningKey, "traces-observer", now.Add(10 * time.Minute)},
+		"none algorithm": {noneToken, testSigningKey, "traces-observer", now},
+		"malformed":      {"not-a-token", testSigningKey, "traces-observer", now},
+	}
+	for name, tc := range invalid {
+		if _, err := ParseToken(tc.token, tc.key, tc.audience, tc.now); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", name, err)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	// Callers without a token are trusted
+	if err := Authorize(context.Background(), []string{"any-component"}, nil); err != nil {
+		t.Errorf("expected a request without claims to be authorized, got %v", err)
+	}
+
+	ctx := WithClaims(context.Background(), &Claims{
+		ComponentUids: []string{"component-uid-1"},
+		ProjectUids:   []string{"project-uid-1"},
+	})
+	if err := Authorize(ctx, []string{"component-uid-1"}, []string{"project-uid-1"}); err != nil {
+		t.Errorf("expected covered UIDs to be authorized, got %v", err)
+	}
+	if err := Authorize(ctx, []string{"component-uid-1", "component-uid-2"}, nil); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected an uncovered component to be forbidden, got %v", err)
+	}
+	if err := Authorize(ctx, nil, []string{"project-uid-2"}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected an uncovered project to be forbidden, got %v", err)
+	}
+	if err := Authorize(ctx, nil, nil); !errors.Is(err, ErrForbidden) {
+		t.Errorf("expected an unscoped query to be forbidden, got %v", err)
+	}
+}
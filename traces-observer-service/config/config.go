@@ -20,12 +20,19 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds all configuration for the tracing service
 type Config struct {
 	Server     ServerConfig
 	OpenSearch OpenSearchConfig
+	Tracing    TracingConfig
+	Store      StoreConfig
+	Ingest     IngestConfig
+	Retention  RetentionConfig
+	Auth       AuthConfig
+	Telemetry  TelemetryConfig
 }
 
 // ServerConfig holds HTTP server configuration
@@ -38,6 +45,96 @@ type OpenSearchConfig struct {
 	Address  string
 	Username string
 	Password string
+	// Prefix of the daily trace indices, followed by the UTC date formatted with IndexDateLayout
+	IndexPrefix string
+	// Go time layout of the date suffix of the daily trace indices
+	IndexDateLayout string
+	// Alias or index pattern that all traces are read from and written to instead of the daily indices
+	IndexAlias string
+	// Most daily indices a query names before it falls back to the index wildcard
+	MaxQueryIndices int
+}
+
+// RetentionConfig holds configuration of the job that removes old daily trace indices
+type RetentionConfig struct {
+	// Daily indices older than this many days are removed. Retention is disabled when 0.
+	Days int
+	// Snapshot repository indices are snapshotted to before they are deleted, indices are deleted without a snapshot when empty
+	SnapshotRepository string
+	// How often the retention job runs
+	Interval time.Duration
+}
+
+// TracingConfig holds trace query configuration
+type TracingConfig struct {
+	// Span attribute that identifies the conversation/session a trace belongs to
+	SessionKeyAttribute string
+	// How often a live tail polls for new traces
+	TailPollInterval time.Duration
+	// How far behind the newest trace a live tail keeps looking for traces whose root span arrived late
+	TailLookback time.Duration
+}
+
+// TelemetryConfig holds logging and self-tracing configuration of the service
+type TelemetryConfig struct {
+	// Minimum level of log records, one of DEBUG, INFO, WARN or ERROR
+	LogLevel string
+	// Whether spans of the service's own requests are exported, to the OTEL_EXPORTER_OTLP_* endpoint
+	TracingEnabled bool
+	// Service name the spans are reported under
+	ServiceName string
+	// Fraction of requests that are traced when the caller did not make the decision
+	TracingSampleRatio float64
+}
+
+// Query API authentication modes
+const (
+	AuthModeNone         = "none"
+	AuthModeSharedSecret = "shared-secret"
+	AuthModeJWT          = "jwt"
+)
+
+// AuthConfig holds authentication configuration of the trace query API
+type AuthConfig struct {
+	// How query API callers authenticate, one of "shared-secret" (default), "jwt" or "none"
+	Mode string
+	// Secret trusted callers send as a bearer token in shared-secret mode, they may query any component
+	SharedSecret string
+	// Key HS256 query tokens are signed with in jwt mode, tokens only authorize the components and projects they list
+	JWTSigningKey string
+	// Audience query tokens must be issued for
+	JWTAudience string
+}
+
+// Trace store backends
+const (
+	StoreBackendOpenSearch = "opensearch"
+	StoreBackendMemory     = "memory"
+)
+
+// StoreConfig holds trace store configuration
+type StoreConfig struct {
+	// Backend traces are read from, either "opensearch" or "memory"
+	Backend string
+	// OTLP/JSON file or directory of files the in-memory store is seeded from
+	SeedPath string
+}
+
+// IngestConfig holds OTLP/HTTP trace ingestion configuration
+type IngestConfig struct {
+	// JSON file of ingestion key hashes and the component/environment they were issued for.
+	// Ingestion is disabled when empty.
+	KeysFile string
+	// agent-manager internal endpoint ingestion keys are verified against, used when no keys file is set.
+	// Ingestion is disabled when both are empty.
+	KeyVerifyURL string
+	// Header and value the agent-manager internal API is authenticated with
+	KeyVerifyAPIKeyHeader string
+	KeyVerifyAPIKey       string
+	// How long verified keys are cached for
+	KeyCacheTTLSeconds int
+	// Largest accepted request body, after decompression
+	MaxBodyBytes int
 }
 
 // Load loads configuration from environment variables with defaults
@@ -50,6 +147,45 @@ func Load() (*Config, error) {
 			Address:  getEnv("OPENSEARCH_ADDRESS", "https://localhost:9200"),
 			Username: getEnv("OPENSEARCH_USERNAME", ""),
 			Password: getEnv("OPENSEARCH_PASSWORD", ""),
+
+			IndexPrefix:     getEnv("OPENSEARCH_INDEX_PREFIX", "otel-traces-"),
+			IndexDateLayout: getEnv("OPENSEARCH_INDEX_DATE_FORMAT", "2006-01-02"),
+			IndexAlias:      getEnv("OPENSEARCH_INDEX_ALIAS", ""),
+			MaxQueryIndices: getEnvAsInt("OPENSEARCH_MAX_QUERY_INDICES", 31),
+		},
+		Retention: RetentionConfig{
+			Days:               getEnvAsInt("TRACE_RETENTION_DAYS", 0),
+			SnapshotRepository: getEnv("TRACE_RETENTION_SNAPSHOT_REPOSITORY", ""),
+			Interval:           time.Duration(getEnvAsInt("TRACE_RETENTION_INTERVAL_HOURS", 24)) * time.Hour,
+		},
+		Tracing: TracingConfig{
+			SessionKeyAttribute: getEnv("TRACE_SESSION_KEY_ATTRIBUTE", "session.id"),
+			TailPollInterval:    time.Duration(getEnvAsInt("TRACE_TAIL_POLL_INTERVAL_SECONDS", 2)) * time.Second,
+			TailLookback:        time.Duration(getEnvAsInt("TRACE_TAIL_LOOKBACK_SECONDS", 60)) * time.Second,
+		},
+		Store: StoreConfig{
+			Backend:  getEnv("TRACE_STORE", StoreBackendOpenSearch),
+			SeedPath: getEnv("TRACE_STORE_SEED_PATH", ""),
+		},
+		Telemetry: TelemetryConfig{
+			LogLevel:           getEnv("LOG_LEVEL", "INFO"),
+			TracingEnabled:     getEnvAsBool("OTEL_TRACING_ENABLED", false),
+			ServiceName:        getEnv("OTEL_SERVICE_NAME", "traces-observer"),
+			TracingSampleRatio: getEnvAsFloat("OTEL_TRACING_SAMPLE_RATIO", 1),
+		},
+		Auth: AuthConfig{
+			Mode:          getEnv("TRACES_OBSERVER_AUTH_MODE", AuthModeSharedSecret),
+			SharedSecret:  getEnv("TRACES_OBSERVER_SHARED_SECRET", ""),
+			JWTSigningKey: getEnv("TRACES_OBSERVER_JWT_SIGNING_KEY", ""),
+			JWTAudience:   getEnv("TRACES_OBSERVER_JWT_AUDIENCE", "traces-observer"),
+		},
+		Ingest: IngestConfig{
+			KeysFile:              getEnv("TRACE_INGEST_KEYS_FILE", ""),
+			KeyVerifyURL:          getEnv("TRACE_INGEST_KEY_VERIFY_URL", ""),
+			KeyVerifyAPIKeyHeader: getEnv("TRACE_INGEST_KEY_VERIFY_API_KEY_HEADER", "X-API-KEY"),
+			KeyVerifyAPIKey:       getEnv("TRACE_INGEST_KEY_VERIFY_API_KEY", ""),
+			KeyCacheTTLSeconds:    getEnvAsInt("TRACE_INGEST_KEY_CACHE_TTL_SECONDS", 60),
+			MaxBodyBytes:          getEnvAsInt("TRACE_INGEST_MAX_BODY_BYTES", 10*1024*1024),
 		},
 	}
 
@@ -62,18 +198,76 @@ func Load() (*Config, error) {
 }
 
 func (c *Config) validate() error {
-	if c.OpenSearch.Username == "" || c.OpenSearch.Password == "" {
-		return fmt.Errorf("opensearch username and password are required")
-	}
 	if c.Server.Port <= 0 || c.Server.Port > 65535 {
 		return fmt.Errorf("invalid server port: %d", c.Server.Port)
 	}
-	if c.OpenSearch.Address == "" {
-		return fmt.Errorf("opensearch address is required")
+	switch c.Store.Backend {
+	case StoreBackendOpenSearch:
+		if c.OpenSearch.Username == "" || c.OpenSearch.Password == "" {
+			return fmt.Errorf("opensearch username and password are required")
+		}
+		if c.OpenSearch.Address == "" {
+			return fmt.Errorf("opensearch address is required")
+		}
+		if c.OpenSearch.IndexAlias == "" {
+			if c.OpenSearch.IndexPrefix == "" {
+				return fmt.Errorf("opensearch index prefix is required when no index alias is set")
+			}
+			if !isDailyLayout(c.OpenSearch.IndexDateLayout) {
+				return fmt.Errorf("invalid opensearch index date format: %s", c.OpenSearch.IndexDateLayout)
+			}
+		}
+		if c.OpenSearch.MaxQueryIndices <= 0 {
+			return fmt.Errorf("invalid opensearch max query indices: %d", c.OpenSearch.MaxQueryIndices)
+		}
+		if c.Retention.Days < 0 {
+			return fmt.Errorf("invalid trace retention days: %d", c.Retention.Days)
+		}
+		if c.Retention.Days > 0 && c.Retention.Interval <= 0 {
+			return fmt.Errorf("invalid trace retention interval: %s", c.Retention.Interval)
+		}
+	case StoreBackendMemory:
+	default:
+		return fmt.Errorf("invalid trace store: %s", c.Store.Backend)
+	}
+	switch c.Auth.Mode {
+	case AuthModeNone:
+	case AuthModeSharedSecret:
+		if c.Auth.SharedSecret == "" {
+			return fmt.Errorf("TRACES_OBSERVER_SHARED_SECRET is required when auth mode is %s, set TRACES_OBSERVER_AUTH_MODE=%s to disable authentication", AuthModeSharedSecret, AuthModeNone)
+		}
+	case AuthModeJWT:
+		if c.Auth.JWTSigningKey == "" {
+			return fmt.Errorf("TRACES_OBSERVER_JWT_SIGNING_KEY is required when auth mode is %s", AuthModeJWT)
+		}
+	default:
+		return fmt.Errorf("invalid auth mode: %s", c.Auth.Mode)
+	}
+	if c.Telemetry.TracingSampleRatio < 0 || c.Telemetry.TracingSampleRatio > 1 {
+		return fmt.Errorf("invalid tracing sample ratio: %g", c.Telemetry.TracingSampleRatio)
+	}
+	if c.Tracing.TailPollInterval <= 0 {
+		return fmt.Errorf("invalid tail poll interval: %s", c.Tracing.TailPollInterval)
+	}
+	if c.Tracing.TailLookback < 0 {
+		return fmt.Errorf("invalid tail lookback: %s", c.Tracing.TailLookback)
+	}
+	if c.Ingest.MaxBodyBytes <= 0 {
+		return fmt.Errorf("invalid ingestion max body size: %d", c.Ingest.MaxBodyBytes)
+	}
+	if c.Ingest.KeyCacheTTLSeconds < 0 {
+		return fmt.Errorf("invalid ingestion key cache ttl: %d", c.Ingest.KeyCacheTTLSeconds)
 	}
 	return nil
 }
 
+// isDailyLayout reports whether a time layout identifies a day, by checking that a date survives formatting and parsing
+func isDailyLayout(layout string) bool {
+	day := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+	parsed, err := time.Parse(layout, day.Format(layout))
+	return err == nil && parsed.Equal(day)
+}
+
 // Helper functions
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -90,3 +284,21 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadAuthConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          string
+		sharedSecret  string
+		jwtSigningKey string
+		wantMode      string
+		wantErr       string
+	}{
+		{
+			name:         "shared secret mode is the default",
+			sharedSecret: "secret",
+			wantMode:     AuthModeSharedSecret,
+		},
+		{
+			name:    "default mode without a shared secret fails",
+			wantErr: "TRACES_OBSERVER_SHARED_SECRET is required",
+		},
+		{
+			name:     "authentication is only disabled when none is set explicitly",
+			mode:     AuthModeNone,
+			wantMode: AuthModeNone,
+		},
+		{
+			name:    "jwt mode without a signing key fails",
+			mode:    AuthModeJWT,
+			wantErr: "TRACES_OBSERVER_JWT_SIGNING_KEY is required",
+		},
+		{
+			name:          "jwt mode with a signing key",
+			mode:          AuthModeJWT,
+			jwtSigningKey: "signing-key",
+			wantMode:      AuthModeJWT,
+		},
+		{
+			name:    "unknown mode fails",
+			mode:    "basic",
+			wantErr: "invalid auth mode: basic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRACE_STORE", StoreBackendMemory)
+			t.Setenv("TRACES_OBSERVER_AUTH_MODE", tt.mode)
+			t.Setenv("TRACES_OBSERVER_SHARED_SECRET", tt.sharedSecret)
+			t.Setenv("TRACES_OBSERVER_JWT_SIGNING_KEY", tt.jwtSigningKey)
+
+			cfg, err := Load()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.Auth.Mode != tt.wantMode {
+				t.Errorf("expected auth mode %s, got %s", tt.wantMode, cfg.Auth.Mode)
+			}
+		})
+	}
+}
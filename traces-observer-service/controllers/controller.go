@@ -18,29 +18,38 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"sort"
 	"time"
 
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store"
 )
 
+// ErrTraceNotFound is returned when no spans match a trace lookup
+var ErrTraceNotFound = errors.New("trace not found")
+
 // TracingController provides tracing functionality
 type TracingController struct {
-	osClient *opensearch.Client
+	traceStore    store.TraceStore
+	tracingConfig *config.TracingConfig
 }
 
 // NewTracingController creates a new tracing service
-func NewTracingController(osClient *opensearch.Client) *TracingController {
+func NewTracingController(traceStore store.TraceStore, tracingConfig *config.TracingConfig) *TracingController {
 	return &TracingController{
-		osClient: osClient,
+		traceStore:    traceStore,
+		tracingConfig: tracingConfig,
 	}
 }
 
 // GetTraceOverviews retrieves unique trace IDs with root span information
 func (s *TracingController) GetTraceOverviews(ctx context.Context, params opensearch.TraceQueryParams) (*opensearch.TraceOverviewResponse, error) {
-	log.Printf("Getting trace overviews for component: %s, environment: %s", params.ComponentUid, params.EnvironmentUid)
+	slog.InfoContext(ctx, "Getting trace overviews", "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid)
 
 	// Set defaults
 	if params.Limit == 0 {
@@ -50,40 +59,254 @@ func (s *TracingController) GetTraceOverviews(ctx context.Context, params opense
 		params.Offset = 0
 	}
 
-	// For trace overview, we need to fetch more spans to ensure we get complete traces
-	// Multiply limit by a factor to get enough spans (each trace typically has multiple spans)
 	originalLimit := params.Limit
 	originalOffset := params.Offset
-	params.Limit = params.Limit * 50 // Fetch more spans to capture complete traces
-	params.Offset = 0                // Start from beginning for grouping
 
-	// Use the existing BuildTraceQuery
-	query := opensearch.BuildTraceQuery(params)
+	var spans []opensearch.Span
+	truncated := false
+	var err error
+	if len(params.TraceIDs) > 0 {
+		// Only the requested traces are listed, so all of their spans are fetched
+		spans, truncated, err = s.traceStore.GetSpansOfTraces(ctx, opensearch.TraceSpansParams{
+			TraceIDs:       params.TraceIDs,
+			ComponentUid:   params.ComponentUid,
+			EnvironmentUid: params.EnvironmentUid,
+			StartTime:      params.StartTime,
+			EndTime:        params.EndTime,
+		})
+	} else {
+		// For trace overview, we need to fetch more spans to ensure we get complete traces
+		// Multiply limit by a factor to get enough spans (each trace typically has multiple spans)
+		params.Limit = params.Limit * 50 // Fetch more spans to capture complete traces
+		params.Offset = 0                // Start from beginning for grouping
+		spans, err = s.traceStore.SearchSpans(ctx, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Group spans by traceId and find root spans
+	allOverviews := filterByRootAttributes(buildTraceOverviews(spans), params.Attributes)
+
+	// Sort by StartTime (descending) for consistent pagination
+	sort.Slice(allOverviews, func(i, j int) bool {
+		return allOverviews[i].StartTime > allOverviews[j].StartTime
+	})
+
+	// Apply pagination to the trace overviews
+	totalCount := len(allOverviews)
+	start := originalOffset
+	end := originalOffset + originalLimit
+
+	if start >= len(allOverviews) {
+		start = len(allOverviews)
+	}
+	if end > len(allOverviews) {
+		end = len(allOverviews)
+	}
+
+	paginatedOverviews := allOverviews[start:end]
+
+	slog.InfoContext(ctx, "Retrieved trace overviews",
+		"traceCount", len(allOverviews), "spanCount", len(spans), "start", start, "end", end, "totalCount", totalCount)
+
+	return &opensearch.TraceOverviewResponse{
+		Traces:     paginatedOverviews,
+		TotalCount: totalCount,
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetTraceByIdAndService retrieves spans for a specific trace ID and component UID
+func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params opensearch.TraceByIdAndServiceParams) (*opensearch.TraceResponse, error) {
+	slog.InfoContext(ctx, "Getting trace", "traceId", params.TraceID, "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid)
+
+	spans, err := s.traceStore.GetTraceSpans(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(spans) == 0 {
+		return nil, fmt.Errorf("%w: no spans found for traceID: %s, component: %s, environment: %s", ErrTraceNotFound, params.TraceID, params.ComponentUid, params.EnvironmentUid)
+	}
+
+	slog.InfoContext(ctx, "Retrieved trace spans", "spanCount", len(spans), "traceId", params.TraceID, "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid)
+
+	return &opensearch.TraceResponse{
+		Spans:      spans,
+		TotalCount: len(spans),
+	}, nil
+}
 
-	// Generate indices based on time range
-	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+// GetTokenUsage sums GenAI token usage per project, component and model
+func (s *TracingController) GetTokenUsage(ctx context.Context, params opensearch.TokenUsageParams) (*opensearch.TokenUsageResponse, error) {
+	slog.InfoContext(ctx, "Getting token usage", "projectUids", params.ProjectUids, "componentUids", params.ComponentUids, "environmentUid", params.EnvironmentUid)
+
+	usage, err := s.traceStore.GetTokenUsage(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Retrieved token usage", "bucketCount", len(usage))
+
+	return &opensearch.TokenUsageResponse{
+		Usage: usage,
+	}, nil
+}
+
+// ListSessions retrieves sessions grouped by the configured session key attribute
+func (s *TracingController) ListSessions(ctx context.Context, params opensearch.SessionQueryParams) (*opensearch.SessionOverviewResponse, error) {
+	slog.InfoContext(ctx, "Listing sessions", "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid, "sessionKey", s.tracingConfig.SessionKeyAttribute)
+
+	// Set defaults
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
 	}
-	log.Printf("Searching indices: %v", indices)
 
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
+	sessions, totalCount, err := s.traceStore.ListSessions(ctx, params, s.tracingConfig.SessionKeyAttribute)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search trace overviews: %w", err)
+		return nil, err
 	}
 
-	// Parse all spans
-	spans := opensearch.ParseSpans(response)
+	// Sessions are already ordered by last activity, apply pagination
+	start := params.Offset
+	end := params.Offset + params.Limit
+	if start > len(sessions) {
+		start = len(sessions)
+	}
+	if end > len(sessions) {
+		end = len(sessions)
+	}
 
-	// Group spans by traceId and find root spans
+	slog.InfoContext(ctx, "Retrieved sessions", "sessionCount", len(sessions), "start", start, "end", end, "totalCount", totalCount)
+
+	return &opensearch.SessionOverviewResponse{
+		Sessions:   sessions[start:end],
+		TotalCount: totalCount,
+	}, nil
+}
+
+// GetSessionTraces retrieves the traces of a session in chronological order
+func (s *TracingController) GetSessionTraces(ctx context.Context, params opensearch.SessionTracesParams) (*opensearch.SessionTracesResponse, error) {
+	slog.InfoContext(ctx, "Getting session traces", "sessionId", params.SessionID, "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid)
+
+	// Sessions are usually short lived, search the last 7 days unless a time range is given
+	if params.StartTime == "" || params.EndTime == "" {
+		now := time.Now()
+		params.StartTime = now.AddDate(0, 0, -7).Format(time.RFC3339)
+		params.EndTime = now.Format(time.RFC3339)
+	}
+
+	spans, truncated, err := s.traceStore.GetSessionSpans(ctx, params, s.tracingConfig.SessionKeyAttribute)
+	if err != nil {
+		return nil, err
+	}
+	traces := buildTraceOverviews(spans)
+
+	// Turns are returned in the order they happened
+	sort.Slice(traces, func(i, j int) bool {
+		return traces[i].StartTime < traces[j].StartTime
+	})
+
+	slog.InfoContext(ctx, "Retrieved session traces", "traceCount", len(traces), "spanCount", len(spans), "truncated", truncated, "sessionId", params.SessionID)
+
+	return &opensearch.SessionTracesResponse{
+		SessionID:  params.SessionID,
+		Traces:     traces,
+		TotalCount: len(traces),
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetTraceTree retrieves all spans of a trace and nests them into a span tree
+func (s *TracingController) GetTraceTree(ctx context.Context, params opensearch.TraceByIdAndServiceParams) (*opensearch.TraceTreeResponse, error) {
+	// The tree needs every span of the trace regardless of the requested page
+	params.SortOrder = "asc"
+	params.Limit = 0
+
+	result, err := s.GetTraceByIdAndService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildSpanTree(params.TraceID, result.Spans), nil
+}
+
+// ExportTrace converts all spans of a trace into the given export format
+func (s *TracingController) ExportTrace(ctx context.Context, params opensearch.TraceByIdAndServiceParams, format export.Format) (interface{}, error) {
+	// Export the whole trace in chronological order
+	params.SortOrder = "asc"
+	params.Limit = 0
+
+	result, err := s.GetTraceByIdAndService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return export.Convert(format, result.Spans)
+}
+
+// ExportTraces pages through the spans of a component in a time range and calls emit once per trace
+// with the trace converted into the given export format. Export stops at the first error returned by emit.
+func (s *TracingController) ExportTraces(ctx context.Context, params opensearch.ExportTracesParams, format export.Format, emit func(record interface{}) error) error {
+	slog.InfoContext(ctx, "Exporting traces", "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid, "startTime", params.StartTime, "endTime", params.EndTime)
+
+	// Spans are sorted by traceId, so a trace is complete once a span of the next trace is read
+	var currentSpans []opensearch.Span
+	flush := func() error {
+		if len(currentSpans) == 0 {
+			return nil
+		}
+		sort.Slice(currentSpans, func(i, j int) bool {
+			return currentSpans[i].StartTime.Before(currentSpans[j].StartTime)
+		})
+		record, err := export.ConvertLine(format, currentSpans)
+		if err != nil {
+			return err
+		}
+		currentSpans = nil
+		return emit(record)
+	}
+
+	traceCount, spanCount := 0, 0
+	err := s.traceStore.ScanSpans(ctx, params, func(span opensearch.Span) error {
+		if len(currentSpans) > 0 && currentSpans[0].TraceID != span.TraceID {
+			if err := flush(); err != nil {
+				return err
+			}
+			traceCount++
+		}
+		currentSpans = append(currentSpans, span)
+		spanCount++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(currentSpans) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+		traceCount++
+	}
+
+	slog.InfoContext(ctx, "Exported traces", "traceCount", traceCount, "spanCount", spanCount, "componentUid", params.ComponentUid)
+	return nil
+}
+
+// buildTraceOverviews groups spans by traceId and summarizes each trace that has a root span
+func buildTraceOverviews(spans []opensearch.Span) []opensearch.TraceOverview {
 	traceMap := make(map[string][]opensearch.Span)
 	for _, span := range spans {
 		traceMap[span.TraceID] = append(traceMap[span.TraceID], span)
 	}
 
 	// Process each trace to find root span
-	allOverviews := []opensearch.TraceOverview{}
+	overviews := []opensearch.TraceOverview{}
 	for traceID, traceSpans := range traceMap {
 		// Find root span (span with no parentSpanId)
 		var rootSpanID, rootSpanName, startTime, endTime string
@@ -104,7 +327,7 @@ func (s *TracingController) GetTraceOverviews(ctx context.Context, params opense
 
 		// Add to overviews if we found a root span
 		if rootSpanID != "" {
-			allOverviews = append(allOverviews, opensearch.TraceOverview{
+			overviews = append(overviews, opensearch.TraceOverview{
 				TraceID:            traceID,
 				RootSpanID:         rootSpanID,
 				RootSpanName:       rootSpanName,
@@ -117,76 +340,34 @@ func (s *TracingController) GetTraceOverviews(ctx context.Context, params opense
 		}
 	}
 
-	// Sort by StartTime (descending) for consistent pagination
-	sort.Slice(allOverviews, func(i, j int) bool {
-		return allOverviews[i].StartTime > allOverviews[j].StartTime
-	})
-
-	// Apply pagination to the trace overviews
-	totalCount := len(allOverviews)
-	start := originalOffset
-	end := originalOffset + originalLimit
-
-	if start >= len(allOverviews) {
-		start = len(allOverviews)
-	}
-	if end > len(allOverviews) {
-		end = len(allOverviews)
-	}
-
-	paginatedOverviews := allOverviews[start:end]
-
-	log.Printf("Retrieved %d unique traces from %d spans (showing %d-%d of %d)",
-		len(allOverviews), len(spans), start, end, totalCount)
-
-	return &opensearch.TraceOverviewResponse{
-		Traces:     paginatedOverviews,
-		TotalCount: totalCount,
-	}, nil
+	return overviews
 }
 
-// GetTraceByIdAndService retrieves spans for a specific trace ID and component UID
-func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params opensearch.TraceByIdAndServiceParams) (*opensearch.TraceResponse, error) {
-	log.Printf("Getting trace for traceID: %s, component: %s, environment: %s", params.TraceID, params.ComponentUid, params.EnvironmentUid)
-
-	// Build query
-	query := opensearch.BuildTraceByIdAndServiceQuery(params)
-
-	// For trace by ID queries, we need to search across a broader time range
-	// Use current day and previous 7 days as default
-	endTime := time.Now()
-	startTime := endTime.AddDate(0, 0, -7)
-	indices, err := opensearch.GetIndicesForTimeRange(
-		startTime.Format(time.RFC3339),
-		endTime.Format(time.RFC3339),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
-	}
-	log.Printf("Searching indices for trace ID: %v", indices)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search traces: %w", err)
+// filterByRootAttributes keeps the traces whose root span has all of the given attribute values.
+// Attribute values are compared in their string form so that numeric and boolean attributes can be matched.
+func filterByRootAttributes(overviews []opensearch.TraceOverview, attributes map[string]string) []opensearch.TraceOverview {
+	if len(attributes) == 0 {
+		return overviews
 	}
 
-	// Parse spans
-	spans := opensearch.ParseSpans(response)
-
-	if len(spans) == 0 {
-		return nil, fmt.Errorf("no spans found for traceID: %s, component: %s, environment: %s", params.TraceID, params.ComponentUid, params.EnvironmentUid)
+	filtered := []opensearch.TraceOverview{}
+	for _, overview := range overviews {
+		matches := true
+		for key, value := range attributes {
+			actual, ok := overview.RootSpanAttributes[key]
+			if !ok || fmt.Sprint(actual) != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, overview)
+		}
 	}
-
-	log.Printf("Retrieved %d spans for traceID: %s, component: %s, environment: %s", len(spans), params.TraceID, params.ComponentUid, params.EnvironmentUid)
-
-	return &opensearch.TraceResponse{
-		Spans:      spans,
-		TotalCount: len(spans),
-	}, nil
+	return filtered
 }
 
 // HealthCheck checks if the service is healthy
 func (s *TracingController) HealthCheck(ctx context.Context) error {
-	return s.osClient.HealthCheck(ctx)
+	return s.traceStore.HealthCheck(ctx)
 }
@@ -0,0 +1,61 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/ingest"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store"
+)
+
+// IngestController writes OTLP trace data pushed by agents into the trace store
+type IngestController struct {
+	traceStore  store.TraceStore
+	keyResolver ingest.KeyResolver
+}
+
+// NewIngestController creates a new ingestion controller
+func NewIngestController(traceStore store.TraceStore, keyResolver ingest.KeyResolver) *IngestController {
+	return &IngestController{
+		traceStore:  traceStore,
+		keyResolver: keyResolver,
+	}
+}
+
+// Authenticate resolves the ingestion key of a request to the scope its spans are stamped with.
+// It returns ingest.ErrInvalidKey if the key is not known.
+func (c *IngestController) Authenticate(ctx context.Context, key string) (*ingest.Scope, error) {
+	return c.keyResolver.Resolve(ctx, key)
+}
+
+// IngestTraces stamps trace data with the given scope and writes its spans to the trace store.
+// It returns the number of spans written.
+func (c *IngestController) IngestTraces(ctx context.Context, scope *ingest.Scope, data export.OTLPTracesData) (int, error) {
+	ingest.StampScope(&data, *scope)
+
+	documents := export.DocumentsFromOTLP(data)
+	if err := c.traceStore.WriteSpans(ctx, documents); err != nil {
+		return 0, fmt.Errorf("failed to ingest spans: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Ingested spans", "spanCount", len(documents), "componentUid", scope.ComponentUid, "environmentUid", scope.EnvironmentUid)
+	return len(documents), nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// buildSpanTree nests the spans of a trace under their parents using ParentSpanID.
+// Spans whose parent is not part of the trace are returned as additional roots marked as orphans,
+// after the actual root span. Depth, offset from the trace start, self time and the critical path
+// are computed for every node.
+func buildSpanTree(traceID string, spans []opensearch.Span) *opensearch.TraceTreeResponse {
+	response := &opensearch.TraceTreeResponse{
+		TraceID: traceID,
+		Roots:   []*opensearch.SpanNode{},
+	}
+	if len(spans) == 0 {
+		return response
+	}
+
+	// Duplicate span documents (e.g. from re-ingestion) are only placed once
+	nodes := make(map[string]*opensearch.SpanNode, len(spans))
+	uniqueSpans := make([]opensearch.Span, 0, len(spans))
+	for _, span := range spans {
+		if _, exists := nodes[span.SpanID]; exists {
+			continue
+		}
+		nodes[span.SpanID] = &opensearch.SpanNode{Span: span}
+		uniqueSpans = append(uniqueSpans, span)
+	}
+	spans = uniqueSpans
+
+	// Link every node to its parent, treating spans with a missing parent as orphan roots
+	traceStart, traceEnd := spans[0].StartTime, spanEnd(spans[0])
+	for _, span := range spans {
+		node := nodes[span.SpanID]
+		if span.StartTime.Before(traceStart) {
+			traceStart = span.StartTime
+		}
+		if end := spanEnd(span); end.After(traceEnd) {
+			traceEnd = end
+		}
+
+		if span.ParentSpanID == "" {
+			response.Roots = append(response.Roots, node)
+			continue
+		}
+		parent, ok := nodes[span.ParentSpanID]
+		if !ok || parent == node {
+			node.Orphan = true
+			response.Roots = append(response.Roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	// Orphans come after the real roots, each group in start time order
+	sort.SliceStable(response.Roots, func(i, j int) bool {
+		if response.Roots[i].Orphan != response.Roots[j].Orphan {
+			return !response.Roots[i].Orphan
+		}
+		return response.Roots[i].StartTime.Before(response.Roots[j].StartTime)
+	})
+
+	visited := make(map[*opensearch.SpanNode]bool, len(nodes))
+	for _, root := range response.Roots {
+		annotateSpanNode(root, 0, traceStart, visited)
+	}
+
+	// Spans that are part of a parent cycle are never reached from a root; surface them as orphans
+	for _, span := range spans {
+		if node := nodes[span.SpanID]; !visited[node] {
+			detachFromParent(nodes[node.ParentSpanID], node)
+			node.Orphan = true
+			response.Roots = append(response.Roots, node)
+			annotateSpanNode(node, 0, traceStart, visited)
+		}
+	}
+
+	if len(response.Roots) > 0 {
+		markCriticalPath(response.Roots[0])
+	}
+
+	response.TotalCount = len(spans)
+	response.StartTime = traceStart
+	response.DurationInNanos = traceEnd.Sub(traceStart).Nanoseconds()
+	return response
+}
+
+// annotateSpanNode sets depth, offset and self time of a node and its descendants, sorting children by start time
+func annotateSpanNode(node *opensearch.SpanNode, depth int, traceStart time.Time, visited map[*opensearch.SpanNode]bool) {
+	if visited[node] {
+		return
+	}
+	visited[node] = true
+
+	node.Depth = depth
+	node.OffsetInNanos = node.StartTime.Sub(traceStart).Nanoseconds()
+
+	sort.SliceStable(node.Children, func(i, j int) bool {
+		return node.Children[i].StartTime.Before(node.Children[j].StartTime)
+	})
+	for _, child := range node.Children {
+		annotateSpanNode(child, depth+1, traceStart, visited)
+	}
+
+	node.SelfTimeInNanos = selfTime(node)
+}
+
+// selfTime returns the part of a span's duration not covered by any of its children.
+// Overlapping (concurrent) children are merged and clipped to the span's own interval.
+func selfTime(node *opensearch.SpanNode) int64 {
+	start, end := node.StartTime, spanEnd(node.Span)
+	covered := int64(0)
+	var coveredUntil time.Time
+
+	// Children are sorted by start time, so intervals can be merged in one pass
+	for _, child := range node.Children {
+		childStart, childEnd := child.StartTime, spanEnd(child.Span)
+		if childStart.Before(start) {
+			childStart = start
+		}
+		if childEnd.After(end) {
+			childEnd = end
+		}
+		if childStart.Before(coveredUntil) {
+			childStart = coveredUntil
+		}
+		if !childEnd.After(childStart) {
+			continue
+		}
+		covered += childEnd.Sub(childStart).Nanoseconds()
+		coveredUntil = childEnd
+	}
+
+	self := node.DurationInNanos - covered
+	if self < 0 {
+		return 0
+	}
+	return self
+}
+
+// markCriticalPath marks the chain of spans that determines the end-to-end latency of the trace.
+// Walking back from the end of a span, the child that finished last before that point is what the
+// span was waiting on, so it is marked and the walk continues from that child's start.
+func markCriticalPath(node *opensearch.SpanNode) {
+	node.CriticalPath = true
+
+	children := make([]*opensearch.SpanNode, len(node.Children))
+	copy(children, node.Children)
+	sort.SliceStable(children, func(i, j int) bool {
+		return spanEnd(children[i].Span).After(spanEnd(children[j].Span))
+	})
+
+	cursor := spanEnd(node.Span)
+	for _, child := range children {
+		if !child.StartTime.Before(cursor) {
+			continue
+		}
+		markCriticalPath(child)
+		cursor = child.StartTime
+	}
+}
+
+// detachFromParent removes a node from its parent's children
+func detachFromParent(parent *opensearch.SpanNode, node *opensearch.SpanNode) {
+	if parent == nil {
+		return
+	}
+	for i, child := range parent.Children {
+		if child == node {
+			parent.Children = append(parent.Children[:i], parent.Children[i+1:]...)
+			return
+		}
+	}
+}
+
+// spanEnd returns the end time of a span, deriving it from the duration when it is missing
+func spanEnd(span opensearch.Span) time.Time {
+	if span.EndTime.IsZero() {
+		return span.StartTime.Add(time.Duration(span.DurationInNanos))
+	}
+	return span.EndTime
+}
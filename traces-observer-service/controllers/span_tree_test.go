@@ -0,0 +1,183 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+var traceStart = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+// testSpan returns a span that runs between the given milliseconds after the start of the trace
+func testSpan(spanID string, parentSpanID string, startMs int64, endMs int64) opensearch.Span {
+	return opensearch.Span{
+		TraceID:         "trace-1",
+		SpanID:          spanID,
+		ParentSpanID:    parentSpanID,
+		StartTime:       traceStart.Add(time.Duration(startMs) * time.Millisecond),
+		EndTime:         traceStart.Add(time.Duration(endMs) * time.Millisecond),
+		DurationInNanos: (time.Duration(endMs-startMs) * time.Millisecond).Nanoseconds(),
+	}
+}
+
+// treeNode is where a span was placed in the tree and what was computed for it
+type treeNode struct {
+	parent   string
+	depth    int
+	offsetMs int64
+	selfMs   int64
+	critical bool
+	orphan   bool
+}
+
+func flattenSpanTree(nodes []*opensearch.SpanNode, parent string, flat map[string]treeNode) {
+	for _, node := range nodes {
+		flat[node.SpanID] = treeNode{
+			parent:   parent,
+			depth:    node.Depth,
+			offsetMs: time.Duration(node.OffsetInNanos).Milliseconds(),
+			selfMs:   time.Duration(node.SelfTimeInNanos).Milliseconds(),
+			critical: node.CriticalPath,
+			orphan:   node.Orphan,
+		}
+		flattenSpanTree(node.Children, node.SpanID, flat)
+	}
+}
+
+func TestBuildSpanTree(t *testing.T) {
+	tests := []struct {
+		name           string
+		spans          []opensearch.Span
+		wantRoots      []string
+		wantDurationMs int64
+		wantNodes      map[string]treeNode
+	}{
+		{
+			name:           "single span",
+			spans:          []opensearch.Span{testSpan("root", "", 0, 100)},
+			wantRoots:      []string{"root"},
+			wantDurationMs: 100,
+			wantNodes: map[string]treeNode{
+				"root": {selfMs: 100, critical: true},
+			},
+		},
+		{
+			name: "overlapping children",
+			spans: []opensearch.Span{
+				testSpan("root", "", 0, 100),
+				testSpan("retrieve", "root", 10, 60),
+				testSpan("embed", "root", 20, 30),
+				testSpan("generate", "root", 40, 90),
+			},
+			wantRoots:      []string{"root"},
+			wantDurationMs: 100,
+			wantNodes: map[string]treeNode{
+				// The children cover 10-90 together, so only 20ms of the root is its own
+				"root":     {selfMs: 20, critical: true},
+				"retrieve": {parent: "root", depth: 1, offsetMs: 10, selfMs: 50, critical: true},
+				// Runs while retrieve is still running, so the root is not waiting on it
+				"embed":    {parent: "root", depth: 1, offsetMs: 20, selfMs: 10},
+				"generate": {parent: "root", depth: 1, offsetMs: 40, selfMs: 50, critical: true},
+			},
+		},
+		{
+			name: "child that outlives its parent",
+			spans: []opensearch.Span{
+				testSpan("root", "", 0, 100),
+				testSpan("background", "root", 50, 150),
+			},
+			wantRoots:      []string{"root"},
+			wantDurationMs: 150,
+			wantNodes: map[string]treeNode{
+				// Only the part of the child within the root is subtracted
+				"root":       {selfMs: 50, critical: true},
+				"background": {parent: "root", depth: 1, offsetMs: 50, selfMs: 100, critical: true},
+			},
+		},
+		{
+			name: "missing parent",
+			spans: []opensearch.Span{
+				testSpan("late", "not-ingested", 20, 40),
+				testSpan("root", "", 0, 100),
+			},
+			wantRoots:      []string{"root", "late"},
+			wantDurationMs: 100,
+			wantNodes: map[string]treeNode{
+				"root": {selfMs: 100, critical: true},
+				"late": {offsetMs: 20, selfMs: 20, orphan: true},
+			},
+		},
+		{
+			name: "parent and child cycle",
+			spans: []opensearch.Span{
+				testSpan("root", "", 0, 100),
+				testSpan("a", "b", 10, 20),
+				testSpan("b", "a", 30, 40),
+				testSpan("self", "self", 50, 60),
+			},
+			wantRoots:      []string{"root", "self", "a"},
+			wantDurationMs: 100,
+			wantNodes: map[string]treeNode{
+				"root": {selfMs: 100, critical: true},
+				"self": {offsetMs: 50, selfMs: 10, orphan: true},
+				// The cycle is broken at the first of its spans, which keeps the other as its child
+				"a": {offsetMs: 10, selfMs: 10, orphan: true},
+				"b": {parent: "a", depth: 1, offsetMs: 30, selfMs: 10},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := buildSpanTree("trace-1", tt.spans)
+
+			var roots []string
+			for _, root := range tree.Roots {
+				roots = append(roots, root.SpanID)
+			}
+			if !reflect.DeepEqual(roots, tt.wantRoots) {
+				t.Errorf("roots = %v, want %v", roots, tt.wantRoots)
+			}
+			if tree.TotalCount != len(tt.spans) {
+				t.Errorf("total count = %d, want %d", tree.TotalCount, len(tt.spans))
+			}
+			if durationMs := time.Duration(tree.DurationInNanos).Milliseconds(); durationMs != tt.wantDurationMs {
+				t.Errorf("duration = %dms, want %dms", durationMs, tt.wantDurationMs)
+			}
+			if !tree.StartTime.Equal(traceStart) {
+				t.Errorf("start time = %v, want %v", tree.StartTime, traceStart)
+			}
+
+			nodes := map[string]treeNode{}
+			flattenSpanTree(tree.Roots, "", nodes)
+			if !reflect.DeepEqual(nodes, tt.wantNodes) {
+				t.Errorf("nodes = %+v, want %+v", nodes, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func TestBuildSpanTreeWithoutSpans(t *testing.T) {
+	tree := buildSpanTree("trace-1", nil)
+	if len(tree.Roots) != 0 || tree.TotalCount != 0 {
+		t.Errorf("tree = %+v, want no roots", tree)
+	}
+}
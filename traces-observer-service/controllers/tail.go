@@ -0,0 +1,147 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+const (
+	// defaultTailPollInterval is used when the tracing config does not set a poll interval
+	defaultTailPollInterval = 2 * time.Second
+	// tailMaxSpansPerPoll bounds the spans fetched by each search of a live tail poll
+	tailMaxSpansPerPoll = 1000
+)
+
+// TailTraces streams the traces of a component and environment that start after the tail is opened.
+// The store is polled with a moving high-water mark: each poll searches from the start time of the newest
+// trace seen so far, less the configured lookback so that root spans exported after their children are
+// still picked up, and traces that were already sent are skipped. New traces are passed to send oldest
+// first, with an empty slice when a poll found nothing new. TailTraces returns when ctx is done or send fails.
+func (s *TracingController) TailTraces(ctx context.Context, params opensearch.TraceQueryParams, send func(traces []opensearch.TraceOverview) error) error {
+	slog.InfoContext(ctx, "Tailing traces", "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid)
+
+	interval := s.tracingConfig.TailPollInterval
+	if interval <= 0 {
+		interval = defaultTailPollInterval
+	}
+	lookback := s.tracingConfig.TailLookback
+
+	since := time.Now().UTC()
+	highWater := since
+	sent := make(map[string]time.Time) // Start times of the traces sent within the lookback, keyed by trace ID
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Stopped tailing traces", "componentUid", params.ComponentUid, "environmentUid", params.EnvironmentUid)
+			return nil
+		case <-ticker.C:
+		}
+
+		windowStart := highWater.Add(-lookback)
+		if windowStart.Before(since) {
+			windowStart = since
+		}
+
+		spans, err := s.pollTailSpans(ctx, params, windowStart, time.Now().UTC())
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// Keep tailing through transient store failures, the next poll covers the same window
+			slog.WarnContext(ctx, "Failed to poll traces for tail", "error", err)
+			continue
+		}
+
+		traces := []opensearch.TraceOverview{}
+		for _, overview := range filterByRootAttributes(buildTraceOverviews(spans), params.Attributes) {
+			if _, ok := sent[overview.TraceID]; ok {
+				continue
+			}
+			startTime, err := time.Parse(time.RFC3339Nano, overview.StartTime)
+			if err != nil || startTime.Before(since) {
+				continue
+			}
+			sent[overview.TraceID] = startTime
+			if startTime.After(highWater) {
+				highWater = startTime
+			}
+			traces = append(traces, overview)
+		}
+		sort.SliceStable(traces, func(i, j int) bool {
+			return sent[traces[i].TraceID].Before(sent[traces[j].TraceID])
+		})
+
+		// Forget traces that fell out of the window, they can no longer be returned by a poll
+		for traceID, startTime := range sent {
+			if startTime.Before(highWater.Add(-lookback)) {
+				delete(sent, traceID)
+			}
+		}
+
+		if err := send(traces); err != nil {
+			return err
+		}
+	}
+}
+
+// pollTailSpans fetches the spans that started between windowStart and windowEnd, oldest first. When a search
+// returns tailMaxSpansPerPoll spans, the window is advanced to the start time of the last span and searched
+// again, so that a burst of spans cannot keep the tail on the same oldest page.
+func (s *TracingController) pollTailSpans(ctx context.Context, params opensearch.TraceQueryParams, windowStart, windowEnd time.Time) ([]opensearch.Span, error) {
+	params.EndTime = windowEnd.Format(time.RFC3339Nano)
+	params.SortOrder = "asc"
+	params.Limit = tailMaxSpansPerPoll
+	params.Offset = 0
+
+	spans := []opensearch.Span{}
+	seen := make(map[string]bool) // Spans starting at a page boundary are returned by both pages
+	cursor := windowStart
+	for {
+		params.StartTime = cursor.Format(time.RFC3339Nano)
+		page, err := s.traceStore.SearchSpans(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, span := range page {
+			key := span.TraceID + "/" + span.SpanID
+			if !seen[key] {
+				seen[key] = true
+				spans = append(spans, span)
+			}
+		}
+
+		if len(page) < tailMaxSpansPerPoll {
+			return spans, nil
+		}
+		next := page[len(page)-1].StartTime
+		if !next.After(cursor) {
+			// The whole page started at the same instant, step past it so that the poll keeps moving
+			next = cursor.Add(time.Nanosecond)
+		}
+		cursor = next
+	}
+}
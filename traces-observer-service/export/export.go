@@ -0,0 +1,129 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// Format is a trace interchange format that spans can be exported to
+type Format string
+
+const (
+	// FormatOTLPJSON is the OTLP/JSON encoding of ExportTraceServiceRequest, as accepted by OTLP/HTTP receivers
+	FormatOTLPJSON Format = "otlp-json"
+	// FormatJaeger is the Jaeger JSON format that can be loaded into the Jaeger UI
+	FormatJaeger Format = "jaeger"
+)
+
+// ParseFormat validates a format query parameter, defaulting to OTLP JSON
+func ParseFormat(value string) (Format, error) {
+	switch Format(value) {
+	case "", FormatOTLPJSON:
+		return FormatOTLPJSON, nil
+	case FormatJaeger:
+		return FormatJaeger, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q: must be '%s' or '%s'", value, FormatOTLPJSON, FormatJaeger)
+	}
+}
+
+// Convert converts the spans of a single trace into the given format
+func Convert(format Format, spans []opensearch.Span) (interface{}, error) {
+	switch format {
+	case FormatOTLPJSON:
+		return ToOTLP(spans), nil
+	case FormatJaeger:
+		return ToJaeger(spans), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// ConvertLine converts the spans of a single trace into one NDJSON record for bulk exports.
+// OTLP records are TracesData messages as written by the OpenTelemetry Collector file exporter,
+// and Jaeger records are bare trace objects without the data envelope.
+func ConvertLine(format Format, spans []opensearch.Span) (interface{}, error) {
+	switch format {
+	case FormatOTLPJSON:
+		return ToOTLP(spans), nil
+	case FormatJaeger:
+		return ToJaeger(spans).Data[0], nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// OTLP status codes
+const (
+	statusCodeUnset = 0
+	statusCodeOk    = 1
+	statusCodeError = 2
+)
+
+// statusCodes maps the OTLP status code names to their enum values
+var statusCodes = map[string]int{
+	"STATUS_CODE_UNSET": statusCodeUnset,
+	"STATUS_CODE_OK":    statusCodeOk,
+	"STATUS_CODE_ERROR": statusCodeError,
+}
+
+// statusCode normalizes the stored span status, which is either the numeric OTLP code or its enum name
+func statusCode(status string) int {
+	if code, err := strconv.Atoi(status); err == nil {
+		return code
+	}
+	switch strings.TrimPrefix(strings.ToUpper(status), "STATUS_CODE_") {
+	case "OK":
+		return statusCodeOk
+	case "ERROR":
+		return statusCodeError
+	default:
+		return statusCodeUnset
+	}
+}
+
+// spanKinds maps the stored span kind to its OTLP enum value
+var spanKinds = map[string]int{
+	"SPAN_KIND_UNSPECIFIED": 0,
+	"SPAN_KIND_INTERNAL":    1,
+	"SPAN_KIND_SERVER":      2,
+	"SPAN_KIND_CLIENT":      3,
+	"SPAN_KIND_PRODUCER":    4,
+	"SPAN_KIND_CONSUMER":    5,
+}
+
+// spanKind normalizes the stored span kind, accepting both SPAN_KIND_SERVER and server
+func spanKind(kind string) int {
+	kind = strings.ToUpper(kind)
+	if !strings.HasPrefix(kind, "SPAN_KIND_") {
+		kind = "SPAN_KIND_" + kind
+	}
+	return spanKinds[kind]
+}
+
+// serviceName returns the service.name resource attribute, falling back to the component UID
+func serviceName(span opensearch.Span) string {
+	if name, ok := span.Resource["service.name"].(string); ok && name != "" {
+		return name
+	}
+	return span.Service
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// JaegerTraces is the envelope returned by the Jaeger query API and accepted by the Jaeger UI's JSON upload
+type JaegerTraces struct {
+	Data []JaegerTrace `json:"data"`
+}
+
+// JaegerTrace is a single trace in Jaeger JSON format
+type JaegerTrace struct {
+	TraceID   string                   `json:"traceID"`
+	Spans     []JaegerSpan             `json:"spans"`
+	Processes map[string]JaegerProcess `json:"processes"`
+}
+
+// JaegerSpan is a single span in Jaeger JSON format. Times are in microseconds.
+type JaegerSpan struct {
+	TraceID       string            `json:"traceID"`
+	SpanID        string            `json:"spanID"`
+	OperationName string            `json:"operationName"`
+	References    []JaegerReference `json:"references"`
+	StartTime     int64             `json:"startTime"`
+	Duration      int64             `json:"duration"`
+	Tags          []JaegerKeyValue  `json:"tags"`
+	Logs          []JaegerLog       `json:"logs"`
+	ProcessID     string            `json:"processID"`
+}
+
+// JaegerReference links a span to its parent (CHILD_OF) or to a linked span (FOLLOWS_FROM)
+type JaegerReference struct {
+	RefType string `json:"refType"`
+	TraceID string `json:"traceID"`
+	SpanID  string `json:"spanID"`
+}
+
+// JaegerLog is a span event in Jaeger JSON format
+type JaegerLog struct {
+	Timestamp int64            `json:"timestamp"`
+	Fields    []JaegerKeyValue `json:"fields"`
+}
+
+// JaegerProcess describes the service that emitted a span
+type JaegerProcess struct {
+	ServiceName string           `json:"serviceName"`
+	Tags        []JaegerKeyValue `json:"tags"`
+}
+
+// JaegerKeyValue is a typed Jaeger tag
+type JaegerKeyValue struct {
+	Key   string      `json:"key"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// ToJaeger converts the spans of a trace to Jaeger JSON with one process per distinct resource.
+// OTLP concepts without a Jaeger equivalent are mapped to the tags used by the Jaeger OTLP receiver.
+func ToJaeger(spans []opensearch.Span) JaegerTraces {
+	trace := JaegerTrace{
+		Spans:     make([]JaegerSpan, 0, len(spans)),
+		Processes: make(map[string]JaegerProcess),
+	}
+	processIDs := make(map[string]string)
+
+	for _, span := range spans {
+		if trace.TraceID == "" {
+			trace.TraceID = span.TraceID
+		}
+
+		key, err := json.Marshal(span.Resource)
+		if err != nil {
+			key = []byte(span.Service)
+		}
+		processID, ok := processIDs[string(key)]
+		if !ok {
+			processID = fmt.Sprintf("p%d", len(processIDs)+1)
+			processIDs[string(key)] = processID
+			trace.Processes[processID] = JaegerProcess{
+				ServiceName: serviceName(span),
+				Tags:        toJaegerTags(span.Resource),
+			}
+		}
+
+		trace.Spans = append(trace.Spans, toJaegerSpan(span, processID))
+	}
+
+	return JaegerTraces{Data: []JaegerTrace{trace}}
+}
+
+// toJaegerSpan converts a single span to Jaeger JSON
+func toJaegerSpan(span opensearch.Span, processID string) JaegerSpan {
+	jaegerSpan := JaegerSpan{
+		TraceID:       span.TraceID,
+		SpanID:        span.SpanID,
+		OperationName: span.Name,
+		References:    []JaegerReference{},
+		StartTime:     span.StartTime.UnixMicro(),
+		Duration:      span.DurationInNanos / 1000,
+		Tags:          toJaegerTags(span.Attributes),
+		Logs:          []JaegerLog{},
+		ProcessID:     processID,
+	}
+
+	if span.ParentSpanID != "" {
+		jaegerSpan.References = append(jaegerSpan.References, JaegerReference{
+			RefType: "CHILD_OF",
+			TraceID: span.TraceID,
+			SpanID:  span.ParentSpanID,
+		})
+	}
+	for _, link := range span.Links {
+		jaegerSpan.References = append(jaegerSpan.References, JaegerReference{
+			RefType: "FOLLOWS_FROM",
+			TraceID: link.TraceID,
+			SpanID:  link.SpanID,
+		})
+	}
+
+	if kind := spanKind(span.Kind); kind > 1 {
+		jaegerSpan.Tags = append(jaegerSpan.Tags, stringTag("span.kind", strings.ToLower(strings.TrimPrefix(strings.ToUpper(span.Kind), "SPAN_KIND_"))))
+	}
+	switch statusCode(span.Status) {
+	case statusCodeOk:
+		jaegerSpan.Tags = append(jaegerSpan.Tags, stringTag("otel.status_code", "OK"))
+	case statusCodeError:
+		jaegerSpan.Tags = append(jaegerSpan.Tags,
+			stringTag("otel.status_code", "ERROR"),
+			JaegerKeyValue{Key: "error", Type: "bool", Value: true},
+		)
+	}
+	if span.StatusMessage != "" {
+		jaegerSpan.Tags = append(jaegerSpan.Tags, stringTag("otel.status_description", span.StatusMessage))
+	}
+
+	for _, event := range span.Events {
+		fields := append([]JaegerKeyValue{stringTag("event", event.Name)}, toJaegerTags(event.Attributes)...)
+		jaegerSpan.Logs = append(jaegerSpan.Logs, JaegerLog{
+			Timestamp: event.Time.UnixMicro(),
+			Fields:    fields,
+		})
+	}
+
+	return jaegerSpan
+}
+
+// toJaegerTags converts an attribute map to key-sorted Jaeger tags.
+// Arrays and nested maps have no Jaeger type and are encoded as JSON strings.
+func toJaegerTags(attributes map[string]interface{}) []JaegerKeyValue {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	tags := make([]JaegerKeyValue, 0, len(keys))
+	for _, key := range keys {
+		switch v := attributes[key].(type) {
+		case string:
+			tags = append(tags, stringTag(key, v))
+		case bool:
+			tags = append(tags, JaegerKeyValue{Key: key, Type: "bool", Value: v})
+		case float64:
+			if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+				tags = append(tags, JaegerKeyValue{Key: key, Type: "int64", Value: int64(v)})
+			} else {
+				tags = append(tags, JaegerKeyValue{Key: key, Type: "float64", Value: v})
+			}
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				encoded = []byte(fmt.Sprintf("%v", v))
+			}
+			tags = append(tags, stringTag(key, string(encoded)))
+		}
+	}
+	return tags
+}
+
+// stringTag creates a Jaeger string tag
+func stringTag(key, value string) JaegerKeyValue {
+	return JaegerKeyValue{Key: key, Type: "string", Value: value}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+var testStartTime = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+// typedAttributes has one attribute of each JSON type that can be stored on a span
+var typedAttributes = map[string]interface{}{
+	"bool":   true,
+	"float":  1.5,
+	"int":    float64(42),
+	"list":   []interface{}{"a", float64(1)},
+	"map":    map[string]interface{}{"nested": "value"},
+	"nil":    nil,
+	"string": "value",
+}
+
+// assertJSONEqual compares the JSON encoding of got with the expected JSON document
+func assertJSONEqual(t *testing.T, want string, got interface{}) {
+	t.Helper()
+	encoded, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("failed to marshal result: %v", err)
+	}
+	var gotValue, wantValue interface{}
+	if err := json.Unmarshal(encoded, &gotValue); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("invalid expected JSON: %v", err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("expected %s, got %s", want, encoded)
+	}
+}
+
+func TestToJaeger(t *testing.T) {
+	tests := []struct {
+		name  string
+		spans []opensearch.Span
+		want  string
+	}{
+		{
+			name: "typed attribute values",
+			spans: []opensearch.Span{{
+				TraceID:         "trace-1",
+				SpanID:          "span-1",
+				Name:            "invoke_agent",
+				StartTime:       testStartTime,
+				DurationInNanos: 2500000,
+				Attributes:      typedAttributes,
+				Resource:        map[string]interface{}{"service.name": "my-agent"},
+			}},
+			want: `{"data": [{
+				"traceID": "trace-1",
+				"spans": [{
+					"traceID": "trace-1", "spanID": "span-1", "operationName": "invoke_agent",
+					"references": [], "startTime": 1736510400000000, "duration": 2500,
+					"tags": [
+						{"key": "bool", "type": "bool", "value": true},
+						{"key": "float", "type": "float64", "value": 1.5},
+						{"key": "int", "type": "int64", "value": 42},
+						{"key": "list", "type": "string", "value": "[\"a\",1]"},
+						{"key": "map", "type": "string", "value": "{\"nested\":\"value\"}"},
+						{"key": "nil", "type": "string", "value": "null"},
+						{"key": "string", "type": "string", "value": "value"}
+					],
+					"logs": [], "processID": "p1"
+				}],
+				"processes": {"p1": {"serviceName": "my-agent", "tags": [{"key": "service.name", "type": "string", "value": "my-agent"}]}}
+			}]}`,
+		},
+		{
+			name: "spans without a service name fall back to the component",
+			spans: []opensearch.Span{
+				{TraceID: "trace-1", SpanID: "span-1", Name: "root", Service: "component-uid-1", StartTime: testStartTime},
+				{TraceID: "trace-1", SpanID: "span-2", Name: "other", StartTime: testStartTime, Resource: map[string]interface{}{"host.name": "host-1"}},
+			},
+			want: `{"data": [{
+				"traceID": "trace-1",
+				"spans": [
+					{"traceID": "trace-1", "spanID": "span-1", "operationName": "root", "references": [], "startTime": 1736510400000000, "duration": 0, "tags": [], "logs": [], "processID": "p1"},
+					{"traceID": "trace-1", "spanID": "span-2", "operationName": "other", "references": [], "startTime": 1736510400000000, "duration": 0, "tags": [], "logs": [], "processID": "p2"}
+				],
+				"processes": {
+					"p1": {"serviceName": "component-uid-1", "tags": []},
+					"p2": {"serviceName": "", "tags": [{"key": "host.name", "type": "string", "value": "host-1"}]}
+				}
+			}]}`,
+		},
+		{
+			name: "spans sharing a resource share a process and carry their parent, kind and status",
+			spans: []opensearch.Span{
+				{
+					TraceID: "trace-1", SpanID: "span-1", Name: "root", StartTime: testStartTime, DurationInNanos: 3000,
+					Kind: "SPAN_KIND_SERVER", Status: "1",
+					Resource: map[string]interface{}{"service.name": "my-agent"},
+				},
+				{
+					TraceID: "trace-1", SpanID: "span-2", ParentSpanID: "span-1", Name: "chat", StartTime: testStartTime, DurationInNanos: 1000,
+					Kind: "client", Status: "STATUS_CODE_ERROR", StatusMessage: "rate limited",
+					Resource: map[string]interface{}{"service.name": "my-agent"},
+				},
+				{
+					TraceID: "trace-1", SpanID: "span-3", ParentSpanID: "span-1", Name: "internal", StartTime: testStartTime,
+					Kind: "SPAN_KIND_INTERNAL",
+				},
+			},
+			want: `{"data": [{
+				"traceID": "trace-1",
+				"spans": [
+					{
+						"traceID": "trace-1", "spanID": "span-1", "operationName": "root", "references": [],
+						"startTime": 1736510400000000, "duration": 3,
+						"tags": [
+							{"key": "span.kind", "type": "string", "value": "server"},
+							{"key": "otel.status_code", "type": "string", "value": "OK"}
+						],
+						"logs": [], "processID": "p1"
+					},
+					{
+						"traceID": "trace-1", "spanID": "span-2", "operationName": "chat",
+						"references": [{"refType": "CHILD_OF", "traceID": "trace-1", "spanID": "span-1"}],
+						"startTime": 1736510400000000, "duration": 1,
+						"tags": [
+							{"key": "span.kind", "type": "string", "value": "client"},
+							{"key": "otel.status_code", "type": "string", "value": "ERROR"},
+							{"key": "error", "type": "bool", "value": true},
+							{"key": "otel.status_description", "type": "string", "value": "rate limited"}
+						],
+						"logs": [], "processID": "p1"
+					},
+					{
+						"traceID": "trace-1", "spanID": "span-3", "operationName": "internal",
+						"references": [{"refType": "CHILD_OF", "traceID": "trace-1", "spanID": "span-1"}],
+						"startTime": 1736510400000000, "duration": 0, "tags": [], "logs": [], "processID": "p2"
+					}
+				],
+				"processes": {
+					"p1": {"serviceName": "my-agent", "tags": [{"key": "service.name", "type": "string", "value": "my-agent"}]},
+					"p2": {"serviceName": "", "tags": []}
+				}
+			}]}`,
+		},
+		{
+			name:  "no spans",
+			spans: nil,
+			want:  `{"data": [{"traceID": "", "spans": [], "processes": {}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertJSONEqual(t, tt.want, ToJaeger(tt.spans))
+		})
+	}
+}
@@ -0,0 +1,411 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// OTLPTracesData is the OTLP/JSON encoding of a TracesData message
+type OTLPTracesData struct {
+	ResourceSpans []OTLPResourceSpans `json:"resourceSpans"`
+}
+
+// OTLPResourceSpans groups the spans produced by a single resource
+type OTLPResourceSpans struct {
+	Resource   OTLPResource     `json:"resource"`
+	ScopeSpans []OTLPScopeSpans `json:"scopeSpans"`
+}
+
+// OTLPResource describes the entity that produced the spans
+type OTLPResource struct {
+	Attributes []OTLPKeyValue `json:"attributes"`
+}
+
+// OTLPScopeSpans groups the spans produced by a single instrumentation scope
+type OTLPScopeSpans struct {
+	Scope OTLPScope  `json:"scope"`
+	Spans []OTLPSpan `json:"spans"`
+}
+
+// OTLPScope identifies an instrumentation scope
+type OTLPScope struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// OTLPSpan is the OTLP/JSON encoding of a span. Trace and span IDs are hex encoded.
+type OTLPSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              OTLPSpanKind   `json:"kind"`
+	StartTimeUnixNano OTLPInt64      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   OTLPInt64      `json:"endTimeUnixNano"`
+	Attributes        []OTLPKeyValue `json:"attributes,omitempty"`
+	Events            []OTLPEvent    `json:"events,omitempty"`
+	Links             []OTLPLink     `json:"links,omitempty"`
+	Status            OTLPStatus     `json:"status"`
+}
+
+// OTLPEvent is the OTLP/JSON encoding of a span event
+type OTLPEvent struct {
+	TimeUnixNano OTLPInt64      `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []OTLPKeyValue `json:"attributes,omitempty"`
+}
+
+// OTLPLink is the OTLP/JSON encoding of a span link
+type OTLPLink struct {
+	TraceID    string         `json:"traceId"`
+	SpanID     string         `json:"spanId"`
+	TraceState string         `json:"traceState,omitempty"`
+	Attributes []OTLPKeyValue `json:"attributes,omitempty"`
+}
+
+// OTLPStatus is the OTLP/JSON encoding of a span status
+type OTLPStatus struct {
+	Code    OTLPStatusCode `json:"code,omitempty"`
+	Message string         `json:"message,omitempty"`
+}
+
+// OTLPKeyValue is an attribute key and its typed value
+type OTLPKeyValue struct {
+	Key   string       `json:"key"`
+	Value OTLPAnyValue `json:"value"`
+}
+
+// OTLPAnyValue holds exactly one of the typed attribute values. Integers are encoded as strings as per the OTLP/JSON spec.
+type OTLPAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *OTLPInt64      `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *OTLPArrayValue `json:"arrayValue,omitempty"`
+	KvlistValue *OTLPKvlist     `json:"kvlistValue,omitempty"`
+}
+
+// OTLPArrayValue is a list of attribute values
+type OTLPArrayValue struct {
+	Values []OTLPAnyValue `json:"values"`
+}
+
+// OTLPKvlist is a nested list of attributes
+type OTLPKvlist struct {
+	Values []OTLPKeyValue `json:"values"`
+}
+
+// ToOTLP converts the spans of a trace to OTLP/JSON, grouping spans that share a resource
+func ToOTLP(spans []opensearch.Span) OTLPTracesData {
+	data := OTLPTracesData{ResourceSpans: []OTLPResourceSpans{}}
+	resourceIndex := make(map[string]int)
+
+	for _, span := range spans {
+		// json.Marshal sorts map keys, so equal resources produce the same key
+		key, err := json.Marshal(span.Resource)
+		if err != nil {
+			key = []byte(span.Service)
+		}
+		idx, ok := resourceIndex[string(key)]
+		if !ok {
+			idx = len(data.ResourceSpans)
+			resourceIndex[string(key)] = idx
+			data.ResourceSpans = append(data.ResourceSpans, OTLPResourceSpans{
+				Resource:   OTLPResource{Attributes: toOTLPAttributes(span.Resource)},
+				ScopeSpans: []OTLPScopeSpans{{Spans: []OTLPSpan{}}},
+			})
+		}
+		scopeSpans := &data.ResourceSpans[idx].ScopeSpans[0]
+		scopeSpans.Spans = append(scopeSpans.Spans, toOTLPSpan(span))
+	}
+
+	return data
+}
+
+// toOTLPSpan converts a single span to OTLP/JSON
+func toOTLPSpan(span opensearch.Span) OTLPSpan {
+	otlpSpan := OTLPSpan{
+		TraceID:           span.TraceID,
+		SpanID:            span.SpanID,
+		ParentSpanID:      span.ParentSpanID,
+		Name:              span.Name,
+		Kind:              OTLPSpanKind(spanKind(span.Kind)),
+		StartTimeUnixNano: unixNano(span.StartTime.UnixNano()),
+		EndTimeUnixNano:   unixNano(span.EndTime.UnixNano()),
+		Attributes:        toOTLPAttributes(span.Attributes),
+		Status:            OTLPStatus{Code: OTLPStatusCode(statusCode(span.Status)), Message: span.StatusMessage},
+	}
+	if span.EndTime.IsZero() {
+		otlpSpan.EndTimeUnixNano = unixNano(span.StartTime.UnixNano() + span.DurationInNanos)
+	}
+
+	for _, event := range span.Events {
+		otlpSpan.Events = append(otlpSpan.Events, OTLPEvent{
+			TimeUnixNano: unixNano(event.Time.UnixNano()),
+			Name:         event.Name,
+			Attributes:   toOTLPAttributes(event.Attributes),
+		})
+	}
+	for _, link := range span.Links {
+		otlpSpan.Links = append(otlpSpan.Links, OTLPLink{
+			TraceID:    link.TraceID,
+			SpanID:     link.SpanID,
+			TraceState: link.TraceState,
+			Attributes: toOTLPAttributes(link.Attributes),
+		})
+	}
+
+	return otlpSpan
+}
+
+// toOTLPAttributes converts an attribute map to a key-sorted OTLP attribute list
+func toOTLPAttributes(attributes map[string]interface{}) []OTLPKeyValue {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	keyValues := make([]OTLPKeyValue, 0, len(keys))
+	for _, key := range keys {
+		keyValues = append(keyValues, OTLPKeyValue{Key: key, Value: toOTLPAnyValue(attributes[key])})
+	}
+	return keyValues
+}
+
+// toOTLPAnyValue converts a decoded JSON value to an OTLP typed value.
+// JSON numbers without a fractional part are exported as integers.
+func toOTLPAnyValue(value interface{}) OTLPAnyValue {
+	switch v := value.(type) {
+	case string:
+		return OTLPAnyValue{StringValue: &v}
+	case bool:
+		return OTLPAnyValue{BoolValue: &v}
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			i := OTLPInt64(strconv.FormatInt(int64(v), 10))
+			return OTLPAnyValue{IntValue: &i}
+		}
+		return OTLPAnyValue{DoubleValue: &v}
+	case []interface{}:
+		values := make([]OTLPAnyValue, 0, len(v))
+		for _, item := range v {
+			values = append(values, toOTLPAnyValue(item))
+		}
+		return OTLPAnyValue{ArrayValue: &OTLPArrayValue{Values: values}}
+	case map[string]interface{}:
+		return OTLPAnyValue{KvlistValue: &OTLPKvlist{Values: toOTLPAttributes(v)}}
+	case nil:
+		empty := ""
+		return OTLPAnyValue{StringValue: &empty}
+	default:
+		s := fmt.Sprintf("%v", v)
+		return OTLPAnyValue{StringValue: &s}
+	}
+}
+
+// unixNano formats a Unix nanosecond timestamp as the decimal string OTLP/JSON expects for fixed64 fields
+func unixNano(nanos int64) OTLPInt64 {
+	if nanos < 0 {
+		nanos = 0
+	}
+	return OTLPInt64(strconv.FormatInt(nanos, 10))
+}
+
+// OTLPInt64 is a 64-bit integer encoded as a decimal string, as OTLP/JSON requires for int64 and fixed64 fields.
+// Plain JSON numbers are accepted when decoding.
+type OTLPInt64 string
+
+// UnmarshalJSON accepts both quoted and unquoted integers
+func (v *OTLPInt64) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if _, err := strconv.ParseUint(strings.TrimPrefix(value, "-"), 10, 64); err != nil {
+		return fmt.Errorf("invalid OTLP integer %s", string(data))
+	}
+	*v = OTLPInt64(value)
+	return nil
+}
+
+// Int64 returns the integer value, or 0 when it is empty or out of range
+func (v OTLPInt64) Int64() int64 {
+	i, _ := strconv.ParseInt(string(v), 10, 64)
+	return i
+}
+
+// OTLPSpanKind is the span kind enum. Encoded as an integer, and decoded from either the integer or the enum name.
+type OTLPSpanKind int
+
+// UnmarshalJSON accepts both the enum value and its name, e.g. 2 or "SPAN_KIND_SERVER"
+func (k *OTLPSpanKind) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalOTLPEnum(data, spanKinds)
+	*k = OTLPSpanKind(value)
+	return err
+}
+
+// OTLPStatusCode is the span status code enum. Encoded as an integer, and decoded from either the integer or the enum name.
+type OTLPStatusCode int
+
+// UnmarshalJSON accepts both the enum value and its name, e.g. 2 or "STATUS_CODE_ERROR"
+func (c *OTLPStatusCode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalOTLPEnum(data, statusCodes)
+	*c = OTLPStatusCode(value)
+	return err
+}
+
+// unmarshalOTLPEnum decodes a protobuf JSON enum, which may be written as its number or its name
+func unmarshalOTLPEnum(data []byte, names map[string]int) (int, error) {
+	var number int
+	if err := json.Unmarshal(data, &number); err == nil {
+		return number, nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return 0, fmt.Errorf("invalid OTLP enum %s", string(data))
+	}
+	value, ok := names[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown OTLP enum %q", name)
+	}
+	return value, nil
+}
+
+// DocumentsFromOTLP converts OTLP/JSON trace data into span documents in the layout stored in OpenSearch,
+// with resource and span attributes flattened into maps
+func DocumentsFromOTLP(data OTLPTracesData) []map[string]interface{} {
+	documents := []map[string]interface{}{}
+	for _, resourceSpans := range data.ResourceSpans {
+		resource := fromOTLPAttributes(resourceSpans.Resource.Attributes)
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			for _, span := range scopeSpans.Spans {
+				documents = append(documents, spanDocument(span, resource, scopeSpans.Scope))
+			}
+		}
+	}
+	return documents
+}
+
+// spanDocument converts a single OTLP span into a span document
+func spanDocument(span OTLPSpan, resource map[string]interface{}, scope OTLPScope) map[string]interface{} {
+	start := span.StartTimeUnixNano.Int64()
+	end := span.EndTimeUnixNano.Int64()
+
+	document := map[string]interface{}{
+		"traceId":         span.TraceID,
+		"spanId":          span.SpanID,
+		"parentSpanId":    span.ParentSpanID,
+		"name":            span.Name,
+		"kind":            spanKindName(int(span.Kind)),
+		"startTime":       formatUnixNano(start),
+		"endTime":         formatUnixNano(end),
+		"durationInNanos": end - start,
+		"status": map[string]interface{}{
+			"code":    int(span.Status.Code),
+			"message": span.Status.Message,
+		},
+		"attributes": fromOTLPAttributes(span.Attributes),
+		"resource":   resource,
+	}
+	if serviceName, ok := resource["service.name"].(string); ok {
+		document["serviceName"] = serviceName
+	}
+	if scope.Name != "" {
+		document["instrumentationScope"] = map[string]interface{}{
+			"name":    scope.Name,
+			"version": scope.Version,
+		}
+	}
+
+	events := make([]interface{}, 0, len(span.Events))
+	for _, event := range span.Events {
+		events = append(events, map[string]interface{}{
+			"name":       event.Name,
+			"time":       formatUnixNano(event.TimeUnixNano.Int64()),
+			"attributes": fromOTLPAttributes(event.Attributes),
+		})
+	}
+	document["events"] = events
+
+	links := make([]interface{}, 0, len(span.Links))
+	for _, link := range span.Links {
+		links = append(links, map[string]interface{}{
+			"traceId":    link.TraceID,
+			"spanId":     link.SpanID,
+			"traceState": link.TraceState,
+			"attributes": fromOTLPAttributes(link.Attributes),
+		})
+	}
+	document["links"] = links
+
+	return document
+}
+
+// fromOTLPAttributes converts an OTLP attribute list to a map
+func fromOTLPAttributes(keyValues []OTLPKeyValue) map[string]interface{} {
+	attributes := make(map[string]interface{}, len(keyValues))
+	for _, keyValue := range keyValues {
+		attributes[keyValue.Key] = fromOTLPAnyValue(keyValue.Value)
+	}
+	return attributes
+}
+
+// fromOTLPAnyValue converts an OTLP typed value to a plain Go value
+func fromOTLPAnyValue(value OTLPAnyValue) interface{} {
+	switch {
+	case value.StringValue != nil:
+		return *value.StringValue
+	case value.BoolValue != nil:
+		return *value.BoolValue
+	case value.IntValue != nil:
+		return value.IntValue.Int64()
+	case value.DoubleValue != nil:
+		return *value.DoubleValue
+	case value.ArrayValue != nil:
+		values := make([]interface{}, 0, len(value.ArrayValue.Values))
+		for _, item := range value.ArrayValue.Values {
+			values = append(values, fromOTLPAnyValue(item))
+		}
+		return values
+	case value.KvlistValue != nil:
+		return fromOTLPAttributes(value.KvlistValue.Values)
+	default:
+		return nil
+	}
+}
+
+// spanKindName returns the enum name of an OTLP span kind
+func spanKindName(kind int) string {
+	for name, value := range spanKinds {
+		if value == kind {
+			return name
+		}
+	}
+	return "SPAN_KIND_UNSPECIFIED"
+}
+
+// formatUnixNano formats a Unix nanosecond timestamp the way span documents store timestamps
+func formatUnixNano(nanos int64) string {
+	return time.Unix(0, nanos).UTC().Format(time.RFC3339Nano)
+}
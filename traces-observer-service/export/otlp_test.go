@@ -0,0 +1,127 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+func TestToOTLP(t *testing.T) {
+	tests := []struct {
+		name  string
+		spans []opensearch.Span
+		want  string
+	}{
+		{
+			name: "typed attribute values",
+			spans: []opensearch.Span{{
+				TraceID:         "trace-1",
+				SpanID:          "span-1",
+				Name:            "invoke_agent",
+				StartTime:       testStartTime,
+				EndTime:         testStartTime.Add(2500 * time.Microsecond),
+				DurationInNanos: 2500000,
+				Attributes:      typedAttributes,
+				Resource:        map[string]interface{}{"service.name": "my-agent"},
+			}},
+			want: `{"resourceSpans": [{
+				"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "my-agent"}}]},
+				"scopeSpans": [{"scope": {}, "spans": [{
+					"traceId": "trace-1", "spanId": "span-1", "name": "invoke_agent", "kind": 0,
+					"startTimeUnixNano": "1736510400000000000", "endTimeUnixNano": "1736510400002500000",
+					"attributes": [
+						{"key": "bool", "value": {"boolValue": true}},
+						{"key": "float", "value": {"doubleValue": 1.5}},
+						{"key": "int", "value": {"intValue": "42"}},
+						{"key": "list", "value": {"arrayValue": {"values": [{"stringValue": "a"}, {"intValue": "1"}]}}},
+						{"key": "map", "value": {"kvlistValue": {"values": [{"key": "nested", "value": {"stringValue": "value"}}]}}},
+						{"key": "nil", "value": {"stringValue": ""}},
+						{"key": "string", "value": {"stringValue": "value"}}
+					],
+					"status": {}
+				}]}]
+			}]}`,
+		},
+		{
+			name: "spans without a service name keep their own resource",
+			spans: []opensearch.Span{
+				{TraceID: "trace-1", SpanID: "span-1", Name: "root", Service: "component-uid-1", StartTime: testStartTime, EndTime: testStartTime},
+				{TraceID: "trace-1", SpanID: "span-2", Name: "other", StartTime: testStartTime, EndTime: testStartTime, Resource: map[string]interface{}{"host.name": "host-1"}},
+			},
+			want: `{"resourceSpans": [
+				{
+					"resource": {"attributes": []},
+					"scopeSpans": [{"scope": {}, "spans": [{
+						"traceId": "trace-1", "spanId": "span-1", "name": "root", "kind": 0,
+						"startTimeUnixNano": "1736510400000000000", "endTimeUnixNano": "1736510400000000000", "status": {}
+					}]}]
+				},
+				{
+					"resource": {"attributes": [{"key": "host.name", "value": {"stringValue": "host-1"}}]},
+					"scopeSpans": [{"scope": {}, "spans": [{
+						"traceId": "trace-1", "spanId": "span-2", "name": "other", "kind": 0,
+						"startTimeUnixNano": "1736510400000000000", "endTimeUnixNano": "1736510400000000000", "status": {}
+					}]}]
+				}
+			]}`,
+		},
+		{
+			name: "spans sharing a resource are grouped and carry their parent, kind and status",
+			spans: []opensearch.Span{
+				{
+					TraceID: "trace-1", SpanID: "span-1", Name: "root", StartTime: testStartTime, DurationInNanos: 3000,
+					Kind: "SPAN_KIND_SERVER", Status: "1",
+					Resource: map[string]interface{}{"service.name": "my-agent"},
+				},
+				{
+					TraceID: "trace-1", SpanID: "span-2", ParentSpanID: "span-1", Name: "chat", StartTime: testStartTime, DurationInNanos: 1000,
+					Kind: "client", Status: "STATUS_CODE_ERROR", StatusMessage: "rate limited",
+					Resource: map[string]interface{}{"service.name": "my-agent"},
+				},
+			},
+			want: `{"resourceSpans": [{
+				"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "my-agent"}}]},
+				"scopeSpans": [{"scope": {}, "spans": [
+					{
+						"traceId": "trace-1", "spanId": "span-1", "name": "root", "kind": 2,
+						"startTimeUnixNano": "1736510400000000000", "endTimeUnixNano": "1736510400000003000",
+						"status": {"code": 1}
+					},
+					{
+						"traceId": "trace-1", "spanId": "span-2", "parentSpanId": "span-1", "name": "chat", "kind": 3,
+						"startTimeUnixNano": "1736510400000000000", "endTimeUnixNano": "1736510400000001000",
+						"status": {"code": 2, "message": "rate limited"}
+					}
+				]}]
+			}]}`,
+		},
+		{
+			name:  "no spans",
+			spans: nil,
+			want:  `{"resourceSpans": []}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertJSONEqual(t, tt.want, ToOTLP(tt.spans))
+		})
+	}
+}
@@ -18,12 +18,17 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/auth"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
 )
 
@@ -74,6 +79,9 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 		h.writeError(w, http.StatusBadRequest, "componentUid is required")
 		return
 	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
 
 	environmentUid := query.Get("environmentUid")
 	if environmentUid == "" {
@@ -116,6 +124,22 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	attributes, err := parseAttributeFilters(query["attribute"])
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Parse trace ID filter, comma separated or repeated
+	traceIDs := []string{}
+	for _, value := range query["traceIds"] {
+		for _, traceID := range strings.Split(value, ",") {
+			if traceID = strings.TrimSpace(traceID); traceID != "" {
+				traceIDs = append(traceIDs, traceID)
+			}
+		}
+	}
+
 	// Build query parameters
 	params := opensearch.TraceQueryParams{
 		ComponentUid:   componentUid,
@@ -125,13 +149,15 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 		Limit:          limit,
 		Offset:         offset,
 		SortOrder:      sortOrder,
+		Attributes:     attributes,
+		TraceIDs:       traceIDs,
 	}
 
 	// Execute query
 	ctx := r.Context()
 	result, err := h.controllers.GetTraceOverviews(ctx, params)
 	if err != nil {
-		log.Printf("Failed to get trace overviews: %v", err)
+		slog.ErrorContext(r.Context(), "Failed to get trace overviews", "error", err)
 		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve trace overviews")
 		return
 	}
@@ -156,6 +182,9 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 		h.writeError(w, http.StatusBadRequest, "componentUid is required")
 		return
 	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
 
 	environmentUid := query.Get("environmentUid")
 	if environmentUid == "" {
@@ -184,6 +213,16 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 		limit = parsedLimit
 	}
 
+	// Parse view (default: flat)
+	view := query.Get("view")
+	if view == "" {
+		view = "flat"
+	}
+	if view != "flat" && view != "tree" {
+		h.writeError(w, http.StatusBadRequest, "view must be 'flat' or 'tree'")
+		return
+	}
+
 	// Build query parameters
 	params := opensearch.TraceByIdAndServiceParams{
 		TraceID:        traceID,
@@ -195,9 +234,20 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 
 	// Execute query
 	ctx := r.Context()
+	if view == "tree" {
+		tree, err := h.controllers.GetTraceTree(ctx, params)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "Failed to get trace tree", "error", err)
+			h.writeError(w, http.StatusInternalServerError, "Failed to retrieve traces")
+			return
+		}
+		h.writeJSON(w, http.StatusOK, tree)
+		return
+	}
+
 	result, err := h.controllers.GetTraceByIdAndService(ctx, params)
 	if err != nil {
-		log.Printf("Failed to get trace by ID and service: %v", err)
+		slog.ErrorContext(r.Context(), "Failed to get trace by ID and service", "error", err)
 		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve traces")
 		return
 	}
@@ -206,11 +256,318 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 	h.writeJSON(w, http.StatusOK, result)
 }
 
+// GetTokenUsage handles GET /api/v1/usage with query parameters
+func (h *Handler) GetTokenUsage(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters
+	query := r.URL.Query()
+
+	// projectUid and componentUid may be repeated; at least one scope is required
+	projectUids := query["projectUid"]
+	componentUids := query["componentUid"]
+	if len(projectUids) == 0 && len(componentUids) == 0 {
+		h.writeError(w, http.StatusBadRequest, "at least one projectUid or componentUid is required")
+		return
+	}
+	if !h.authorize(w, r, componentUids, projectUids) {
+		return
+	}
+
+	startTime := query.Get("startTime")
+	endTime := query.Get("endTime")
+	if startTime == "" || endTime == "" {
+		h.writeError(w, http.StatusBadRequest, "startTime and endTime are required")
+		return
+	}
+
+	// Build query parameters
+	params := opensearch.TokenUsageParams{
+		ProjectUids:    projectUids,
+		ComponentUids:  componentUids,
+		EnvironmentUid: query.Get("environmentUid"),
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetTokenUsage(ctx, params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to get token usage", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve token usage")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// ListSessions handles GET /api/v1/sessions with query parameters
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	startTime := query.Get("startTime")
+	endTime := query.Get("endTime")
+
+	// Parse limit (default: 10)
+	limit := 10
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			h.writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	// Parse offset for pagination (default: 0)
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			h.writeError(w, http.StatusBadRequest, "offset must be a non-negative integer")
+			return
+		}
+		offset = parsedOffset
+	}
+
+	// Build query parameters
+	params := opensearch.SessionQueryParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Limit:          limit,
+		Offset:         offset,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.ListSessions(ctx, params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to list sessions", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetSessionTraces handles GET /api/v1/session with query parameters
+func (h *Handler) GetSessionTraces(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters
+	query := r.URL.Query()
+
+	sessionID := query.Get("sessionId")
+	if sessionID == "" {
+		h.writeError(w, http.StatusBadRequest, "sessionId is required")
+		return
+	}
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	// Build query parameters
+	params := opensearch.SessionTracesParams{
+		SessionID:      sessionID,
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      query.Get("startTime"),
+		EndTime:        query.Get("endTime"),
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetSessionTraces(ctx, params)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to get session traces", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve session traces")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// ExportTrace handles GET /api/v1/trace/export with query parameters
+func (h *Handler) ExportTrace(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters
+	query := r.URL.Query()
+
+	traceID := query.Get("traceId")
+	if traceID == "" {
+		h.writeError(w, http.StatusBadRequest, "traceId is required")
+		return
+	}
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	format, err := export.ParseFormat(query.Get("format"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := opensearch.TraceByIdAndServiceParams{
+		TraceID:        traceID,
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.ExportTrace(ctx, params, format)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to export trace", "error", err)
+		if errors.Is(err, controllers.ErrTraceNotFound) {
+			h.writeError(w, http.StatusNotFound, "Trace not found")
+			return
+		}
+		h.writeError(w, http.StatusInternalServerError, "Failed to export trace")
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("trace-%s.json", traceID)))
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// ExportTraces handles GET /api/v1/traces/export with query parameters.
+// The response is streamed as NDJSON with one trace per line.
+func (h *Handler) ExportTraces(w http.ResponseWriter, r *http.Request) {
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	startTime := query.Get("startTime")
+	endTime := query.Get("endTime")
+	if startTime == "" || endTime == "" {
+		h.writeError(w, http.StatusBadRequest, "startTime and endTime are required")
+		return
+	}
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "startTime must be in RFC3339 format")
+		return
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "endTime must be in RFC3339 format")
+		return
+	}
+	if start.After(end) {
+		h.writeError(w, http.StatusBadRequest, "startTime must be before endTime")
+		return
+	}
+
+	format, err := export.ParseFormat(query.Get("format"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := opensearch.ExportTracesParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+
+	// Large exports outlive the server write timeout, so lift it for this response only
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		slog.WarnContext(r.Context(), "Failed to clear write deadline for trace export", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "traces.ndjson"))
+
+	// The status is only written with the first record so that failures before any output still return an error response
+	ctx := r.Context()
+	encoder := json.NewEncoder(w)
+	started := false
+	err = h.controllers.ExportTraces(ctx, params, format, func(record interface{}) error {
+		if !started {
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+		return rc.Flush()
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to export traces", "error", err)
+		if !started {
+			w.Header().Del("Content-Disposition")
+			h.writeError(w, http.StatusInternalServerError, "Failed to export traces")
+		}
+		return
+	}
+	if !started {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
 // Health handles GET /health
 func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	if err := h.controllers.HealthCheck(ctx); err != nil {
-		log.Printf("Health check failed: %v", err)
+		slog.ErrorContext(r.Context(), "Health check failed", "error", err)
 		h.writeJSON(w, http.StatusServiceUnavailable, map[string]string{
 			"status": "unhealthy",
 			"error":  "service unavailable",
@@ -225,11 +582,36 @@ func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 }
 
 // Helper functions
+// parseAttributeFilters parses repeated attribute=key=value query parameters into root span attribute filters
+func parseAttributeFilters(values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	attributes := make(map[string]string, len(values))
+	for _, value := range values {
+		key, attributeValue, ok := strings.Cut(value, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("attribute filters must be in the form key=value")
+		}
+		attributes[key] = attributeValue
+	}
+	return attributes, nil
+}
+
+// authorize checks that the caller may query the given components and projects, writing a 403 response if not
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, componentUids []string, projectUids []string) bool {
+	if err := auth.Authorize(r.Context(), componentUids, projectUids); err != nil {
+		h.writeError(w, http.StatusForbidden, err.Error())
+		return false
+	}
+	return true
+}
+
 func (h *Handler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	if err := json.NewEncoder(w).Encode(data); err != nil {
-		log.Printf("Failed to encode JSON: %v", err)
+		slog.Error("Failed to encode JSON", "error", err)
 	}
 }
 
@@ -0,0 +1,215 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store/memory"
+)
+
+const (
+	testTraceID        = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testComponentUid   = "component-uid-1"
+	testEnvironmentUid = "environment-uid-1"
+)
+
+// newTestHandler creates a handler backed by an in-memory store seeded from testdata
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	traceStore := memory.NewStore()
+	if err := traceStore.LoadOTLPPath("testdata"); err != nil {
+		t.Fatalf("failed to seed trace store: %v", err)
+	}
+	return NewHandler(controllers.NewTracingController(traceStore, &config.TracingConfig{SessionKeyAttribute: "session.id"}))
+}
+
+// serve sends a GET request to a handler function and decodes the JSON response into out
+func serve(t *testing.T, handlerFunc http.HandlerFunc, target string, out interface{}) int {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	handlerFunc(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	if out != nil && recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), out); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+	}
+	return recorder.Code
+}
+
+func TestGetTraceOverviews(t *testing.T) {
+	h := newTestHandler(t)
+
+	var response opensearch.TraceOverviewResponse
+	status := serve(t, h.GetTraceOverviews, "/api/v1/traces?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+
+		"&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z", &response)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if response.TotalCount != 1 || len(response.Traces) != 1 {
+		t.Fatalf("expected 1 trace, got %d", response.TotalCount)
+	}
+	trace := response.Traces[0]
+	if trace.TraceID != testTraceID || trace.RootSpanName != "agent.invoke" || trace.SpanCount != 2 {
+		t.Errorf("unexpected trace overview: %+v", trace)
+	}
+
+	// Attribute filters match the root span attributes
+	status = serve(t, h.GetTraceOverviews, "/api/v1/traces?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+
+		"&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z&attribute=session.id%3Dsession-1", &response)
+	if status != http.StatusOK || response.TotalCount != 1 {
+		t.Errorf("expected 1 trace for a matching attribute filter, got status %d and %d traces", status, response.TotalCount)
+	}
+	status = serve(t, h.GetTraceOverviews, "/api/v1/traces?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+
+		"&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z&attribute=session.id%3Dsession-2", &response)
+	if status != http.StatusOK || response.TotalCount != 0 {
+		t.Errorf("expected no traces for a non-matching attribute filter, got status %d and %d traces", status, response.TotalCount)
+	}
+
+	// Trace ID filters only list the requested traces
+	status = serve(t, h.GetTraceOverviews, "/api/v1/traces?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+
+		"&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z&traceIds=unknown,"+testTraceID, &response)
+	if status != http.StatusOK || response.TotalCount != 1 || response.Traces[0].SpanCount != 2 || response.Truncated {
+		t.Errorf("expected the requested trace with all of its spans, got status %d and %+v", status, response)
+	}
+	status = serve(t, h.GetTraceOverviews, "/api/v1/traces?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+
+		"&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z&traceIds=unknown", &response)
+	if status != http.StatusOK || response.TotalCount != 0 {
+		t.Errorf("expected no traces for an unknown trace ID, got status %d and %d traces", status, response.TotalCount)
+	}
+
+	status = serve(t, h.GetTraceOverviews, "/api/v1/traces?componentUid=other&environmentUid="+testEnvironmentUid, &response)
+	if status != http.StatusOK || response.TotalCount != 0 {
+		t.Errorf("expected no traces for another component, got status %d and %d traces", status, response.TotalCount)
+	}
+
+	status = serve(t, h.GetTraceOverviews, "/api/v1/traces?environmentUid="+testEnvironmentUid, nil)
+	if status != http.StatusBadRequest {
+		t.Errorf("expected status 400 without componentUid, got %d", status)
+	}
+}
+
+func TestGetTraceByIdAndService(t *testing.T) {
+	h := newTestHandler(t)
+
+	var response opensearch.TraceResponse
+	status := serve(t, h.GetTraceByIdAndService, "/api/v1/trace?traceId="+testTraceID+"&componentUid="+testComponentUid+
+		"&environmentUid="+testEnvironmentUid+"&sortOrder=asc", &response)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if response.TotalCount != 2 {
+		t.Fatalf("expected 2 spans, got %d", response.TotalCount)
+	}
+
+	llmSpan := response.Spans[1]
+	if llmSpan.Kind != "SPAN_KIND_CLIENT" || llmSpan.Status != "2" || llmSpan.StatusMessage != "rate limited" {
+		t.Errorf("unexpected span kind or status: %+v", llmSpan)
+	}
+	if llmSpan.Attributes[opensearch.AttributeGenAIInputTokens] != float64(120) {
+		t.Errorf("expected input tokens attribute 120, got %v", llmSpan.Attributes[opensearch.AttributeGenAIInputTokens])
+	}
+	if len(llmSpan.Events) != 1 || llmSpan.Events[0].Exception == nil || llmSpan.Events[0].Exception.Type != "RateLimitError" {
+		t.Errorf("expected an exception event, got %+v", llmSpan.Events)
+	}
+
+	var tree opensearch.TraceTreeResponse
+	status = serve(t, h.GetTraceByIdAndService, "/api/v1/trace?traceId="+testTraceID+"&componentUid="+testComponentUid+
+		"&environmentUid="+testEnvironmentUid+"&view=tree", &tree)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if len(tree.Roots) != 1 || len(tree.Roots[0].Children) != 1 || !tree.Roots[0].Children[0].CriticalPath {
+		t.Errorf("unexpected span tree: %+v", tree.Roots)
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	h := newTestHandler(t)
+
+	var response opensearch.SessionOverviewResponse
+	status := serve(t, h.ListSessions, "/api/v1/sessions?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid, &response)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if response.TotalCount != 1 || len(response.Sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", response.TotalCount)
+	}
+	session := response.Sessions[0]
+	if session.SessionID != "session-1" || session.TurnCount != 1 || session.SpanCount != 2 || session.TotalTokens != 150 {
+		t.Errorf("unexpected session overview: %+v", session)
+	}
+}
+
+func TestGetTokenUsage(t *testing.T) {
+	h := newTestHandler(t)
+
+	var response opensearch.TokenUsageResponse
+	status := serve(t, h.GetTokenUsage, "/api/v1/usage?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+
+		"&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z", &response)
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if len(response.Usage) != 1 {
+		t.Fatalf("expected 1 usage bucket, got %d", len(response.Usage))
+	}
+	usage := response.Usage[0]
+	if usage.Model != "gpt-4o" || usage.InputTokens != 120 || usage.OutputTokens != 30 || usage.SpanCount != 1 {
+		t.Errorf("unexpected token usage: %+v", usage)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	h := newTestHandler(t)
+
+	if status := serve(t, h.Health, "/health", nil); status != http.StatusOK {
+		t.Errorf("expected status 200, got %d", status)
+	}
+}
+
+func TestQueriesAreAuthorizedAgainstTokenClaims(t *testing.T) {
+	h := newTestHandler(t)
+	claims := &auth.Claims{ComponentUids: []string{testComponentUid}}
+
+	// serveWithClaims sends a request as a caller whose token covers testComponentUid only
+	serveWithClaims := func(handlerFunc http.HandlerFunc, target string) int {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+		handlerFunc(recorder, request.WithContext(auth.WithClaims(request.Context(), claims)))
+		return recorder.Code
+	}
+
+	if status := serveWithClaims(h.GetTraceOverviews, "/api/v1/traces?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid); status != http.StatusOK {
+		t.Errorf("expected status 200 for an authorized component, got %d", status)
+	}
+	if status := serveWithClaims(h.GetTraceOverviews, "/api/v1/traces?componentUid=other&environmentUid="+testEnvironmentUid); status != http.StatusForbidden {
+		t.Errorf("expected status 403 for another component, got %d", status)
+	}
+	if status := serveWithClaims(h.GetTraceByIdAndService, "/api/v1/trace?traceId="+testTraceID+"&componentUid=other&environmentUid="+testEnvironmentUid); status != http.StatusForbidden {
+		t.Errorf("expected status 403 for a trace of another component, got %d", status)
+	}
+	if status := serveWithClaims(h.GetTokenUsage, "/api/v1/usage?projectUid=project-uid-1&startTime=2025-01-10T00:00:00Z&endTime=2025-01-11T00:00:00Z"); status != http.StatusForbidden {
+		t.Errorf("expected status 403 for usage of an unauthorized project, got %d", status)
+	}
+}
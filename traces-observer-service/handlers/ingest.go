@@ -0,0 +1,171 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/ingest"
+)
+
+// OTLP/HTTP content types
+const (
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+)
+
+// IngestHandler handles OTLP/HTTP trace exports pushed by agents
+type IngestHandler struct {
+	controller   *controllers.IngestController
+	maxBodyBytes int64
+}
+
+// NewIngestHandler creates a new ingestion handler that rejects request bodies larger than maxBodyBytes
+func NewIngestHandler(controller *controllers.IngestController, maxBodyBytes int64) *IngestHandler {
+	return &IngestHandler{
+		controller:   controller,
+		maxBodyBytes: maxBodyBytes,
+	}
+}
+
+// ExportTraces handles POST /v1/traces with an OTLP/HTTP protobuf or JSON encoded ExportTraceServiceRequest.
+// The ingestion key is read from the Authorization header as a bearer token.
+func (h *IngestHandler) ExportTraces(w http.ResponseWriter, r *http.Request) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != contentTypeProtobuf && mediaType != contentTypeJSON {
+		h.writeStatus(w, contentTypeJSON, http.StatusUnsupportedMediaType, codes.InvalidArgument,
+			fmt.Sprintf("Content-Type must be %s or %s", contentTypeProtobuf, contentTypeJSON))
+		return
+	}
+
+	ctx := r.Context()
+	scope, err := h.controller.Authenticate(ctx, auth.BearerToken(r))
+	if err != nil {
+		if errors.Is(err, ingest.ErrInvalidKey) {
+			h.writeStatus(w, mediaType, http.StatusUnauthorized, codes.Unauthenticated, "A valid ingestion key is required")
+			return
+		}
+		slog.ErrorContext(ctx, "Failed to resolve ingestion key", "error", err)
+		h.writeStatus(w, mediaType, http.StatusServiceUnavailable, codes.Unavailable, "Failed to verify ingestion key")
+		return
+	}
+
+	body, err := h.readBody(w, r)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.writeStatus(w, mediaType, http.StatusRequestEntityTooLarge, codes.InvalidArgument, "Request body is too large")
+			return
+		}
+		h.writeStatus(w, mediaType, http.StatusBadRequest, codes.InvalidArgument, "Failed to read request body")
+		return
+	}
+
+	var data export.OTLPTracesData
+	if mediaType == contentTypeProtobuf {
+		var request coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &request); err != nil {
+			h.writeStatus(w, mediaType, http.StatusBadRequest, codes.InvalidArgument, "Failed to decode protobuf request")
+			return
+		}
+		data = ingest.FromProto(&request)
+	} else if err := json.Unmarshal(body, &data); err != nil {
+		h.writeStatus(w, mediaType, http.StatusBadRequest, codes.InvalidArgument, "Failed to decode JSON request")
+		return
+	}
+
+	if _, err := h.controller.IngestTraces(ctx, scope, data); err != nil {
+		slog.ErrorContext(ctx, "Failed to ingest traces", "error", err)
+		// 503 tells OTLP exporters that the export can be retried
+		h.writeStatus(w, mediaType, http.StatusServiceUnavailable, codes.Unavailable, "Failed to store spans")
+		return
+	}
+
+	h.writeMessage(w, mediaType, http.StatusOK, &coltracepb.ExportTraceServiceResponse{})
+}
+
+// readBody reads the request body, decompressing it if it is gzip encoded
+func (h *IngestHandler) readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	var body io.Reader = http.MaxBytesReader(w, r.Body, h.maxBodyBytes)
+	if strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		// Limit the decompressed size as well
+		body = io.LimitReader(gzipReader, h.maxBodyBytes+1)
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) > h.maxBodyBytes {
+			return nil, &http.MaxBytesError{Limit: h.maxBodyBytes}
+		}
+		return data, nil
+	}
+	return io.ReadAll(body)
+}
+
+// writeStatus writes an error response as a google.rpc.Status message, as required by OTLP/HTTP
+func (h *IngestHandler) writeStatus(w http.ResponseWriter, mediaType string, status int, code codes.Code, message string) {
+	h.writeMessage(w, mediaType, status, &statuspb.Status{
+		Code:    int32(code),
+		Message: message,
+	})
+}
+
+// writeMessage writes a protobuf message in the encoding of the request
+func (h *IngestHandler) writeMessage(w http.ResponseWriter, mediaType string, status int, message proto.Message) {
+	var data []byte
+	var err error
+	if mediaType == contentTypeProtobuf {
+		data, err = proto.Marshal(message)
+	} else {
+		mediaType = contentTypeJSON
+		data, err = protojson.Marshal(message)
+	}
+	if err != nil {
+		slog.Error("Failed to encode response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(status)
+	if _, err := w.Write(data); err != nil {
+		slog.Error("Failed to write response", "error", err)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/ingest"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store/memory"
+)
+
+const testIngestionKey = "test-ingestion-key"
+
+// newTestIngestHandler creates an ingestion handler that accepts testIngestionKey for the test component
+func newTestIngestHandler(t *testing.T) (*IngestHandler, *memory.Store) {
+	t.Helper()
+	keysFile := filepath.Join(t.TempDir(), "keys.json")
+	keys := `[{"keySha256": "` + ingest.HashKey(testIngestionKey) + `", "componentUid": "` + testComponentUid +
+		`", "environmentUid": "` + testEnvironmentUid + `"}]`
+	if err := os.WriteFile(keysFile, []byte(keys), 0o600); err != nil {
+		t.Fatalf("failed to write keys file: %v", err)
+	}
+	keyResolver, err := ingest.NewFileKeyResolver(keysFile)
+	if err != nil {
+		t.Fatalf("failed to load keys file: %v", err)
+	}
+
+	traceStore := memory.NewStore()
+	return NewIngestHandler(controllers.NewIngestController(traceStore, keyResolver), 1024*1024), traceStore
+}
+
+// postTraces sends an OTLP/HTTP export request to the ingestion handler
+func postTraces(h *IngestHandler, contentType, key string, body []byte) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/v1/traces", bytes.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	if key != "" {
+		request.Header.Set("Authorization", "Bearer "+key)
+	}
+	recorder := httptest.NewRecorder()
+	h.ExportTraces(recorder, request)
+	return recorder
+}
+
+// traceSpans returns the stored spans of a trace in the test component
+func traceSpans(t *testing.T, traceStore *memory.Store, traceID string) []opensearch.Span {
+	t.Helper()
+	spans, err := traceStore.GetTraceSpans(context.Background(), opensearch.TraceByIdAndServiceParams{
+		TraceID:        traceID,
+		ComponentUid:   testComponentUid,
+		EnvironmentUid: testEnvironmentUid,
+	})
+	if err != nil {
+		t.Fatalf("failed to get trace spans: %v", err)
+	}
+	return spans
+}
+
+func TestIngestJSON(t *testing.T) {
+	h, traceStore := newTestIngestHandler(t)
+
+	// Resource UIDs sent by the agent are replaced with the ones of the ingestion key
+	body := `{"resourceSpans": [{"resource": {"attributes": [
+		{"key": "openchoreo.dev/component-uid", "value": {"stringValue": "spoofed"}}
+	]}, "scopeSpans": [{"spans": [{
+		"traceId": "5b8efff798038103d269b633813fc60c", "spanId": "eee19b7ec3c1b174", "name": "agent.invoke",
+		"startTimeUnixNano": "1736503200000000000", "endTimeUnixNano": "1736503201000000000"
+	}]}]}]}`
+	recorder := postTraces(h, contentTypeJSON, testIngestionKey, []byte(body))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	spans := traceSpans(t, traceStore, "5b8efff798038103d269b633813fc60c")
+	if len(spans) != 1 || spans[0].Service != testComponentUid {
+		t.Fatalf("expected 1 span stamped with the test component, got %+v", spans)
+	}
+}
+
+func TestIngestProtobuf(t *testing.T) {
+	h, traceStore := newTestIngestHandler(t)
+
+	traceID, _ := hex.DecodeString("0af7651916cd43dd8448eb211c80319c")
+	spanID, _ := hex.DecodeString("b7ad6b7169203331")
+	request := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: &resourcepb.Resource{},
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: []*tracepb.Span{{
+					TraceId:           traceID,
+					SpanId:            spanID,
+					Name:              "chat gpt-4o",
+					Kind:              tracepb.Span_SPAN_KIND_CLIENT,
+					StartTimeUnixNano: 1736503200000000000,
+					EndTimeUnixNano:   1736503200500000000,
+					Attributes: []*commonpb.KeyValue{{
+						Key:   opensearch.AttributeGenAIInputTokens,
+						Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 42}},
+					}},
+					Status: &tracepb.Status{Code: tracepb.Status_STATUS_CODE_ERROR, Message: "timeout"},
+				}},
+			}},
+		}},
+	}
+	body, err := proto.Marshal(request)
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+
+	recorder := postTraces(h, contentTypeProtobuf, testIngestionKey, body)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+	if recorder.Header().Get("Content-Type") != contentTypeProtobuf {
+		t.Errorf("expected a protobuf response, got %s", recorder.Header().Get("Content-Type"))
+	}
+
+	spans := traceSpans(t, traceStore, "0af7651916cd43dd8448eb211c80319c")
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.SpanID != "b7ad6b7169203331" || span.Kind != "SPAN_KIND_CLIENT" || span.Status != "2" || span.DurationInNanos != 500000000 {
+		t.Errorf("unexpected span: %+v", span)
+	}
+	if span.Attributes[opensearch.AttributeGenAIInputTokens] != float64(42) {
+		t.Errorf("expected input tokens attribute 42, got %v", span.Attributes[opensearch.AttributeGenAIInputTokens])
+	}
+}
+
+func TestIngestRejectsInvalidRequests(t *testing.T) {
+	h, _ := newTestIngestHandler(t)
+
+	if recorder := postTraces(h, contentTypeJSON, "", []byte(`{}`)); recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 without a key, got %d", recorder.Code)
+	}
+	if recorder := postTraces(h, contentTypeJSON, "unknown-key", []byte(`{}`)); recorder.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 for an unknown key, got %d", recorder.Code)
+	}
+	if recorder := postTraces(h, "text/plain", testIngestionKey, []byte(`{}`)); recorder.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("expected status 415 for an unsupported content type, got %d", recorder.Code)
+	}
+	if recorder := postTraces(h, contentTypeJSON, testIngestionKey, []byte(`{`)); recorder.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for a malformed body, got %d", recorder.Code)
+	}
+}
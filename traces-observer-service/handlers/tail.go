@@ -0,0 +1,98 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// TailTraces handles GET /api/v1/traces/tail, streaming new trace overviews of a component and environment
+// as server-sent events until the client disconnects. Each trace is sent as a "trace" event, and a comment
+// is sent on polls that found nothing new to keep idle connections open through proxies.
+func (h *Handler) TailTraces(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+	if !h.authorize(w, r, []string{componentUid}, nil) {
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	attributes, err := parseAttributeFilters(query["attribute"])
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// The stream outlives the server write timeout, so lift it for this response only
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		slog.WarnContext(r.Context(), "Failed to clear write deadline for trace tail", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to start trace tail", "error", err)
+		return
+	}
+
+	params := opensearch.TraceQueryParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		Attributes:     attributes,
+	}
+	err = h.controllers.TailTraces(r.Context(), params, func(traces []opensearch.TraceOverview) error {
+		if len(traces) == 0 {
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+			return rc.Flush()
+		}
+		for _, trace := range traces {
+			data, err := json.Marshal(trace)
+			if err != nil {
+				return fmt.Errorf("failed to encode trace overview: %w", err)
+			}
+			if _, err := fmt.Fprintf(w, "event: trace\nid: %s\ndata: %s\n\n", trace.TraceID, data); err != nil {
+				return err
+			}
+		}
+		return rc.Flush()
+	})
+	if err != nil {
+		slog.InfoContext(r.Context(), "Trace tail ended", "error", err)
+	}
+}
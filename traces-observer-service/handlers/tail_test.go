@@ -0,0 +1,184 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store/memory"
+)
+
+// tailSpan builds a span of the test component starting now
+func tailSpan(traceID, spanID, parentSpanID, componentUid string, attributes map[string]interface{}) opensearch.Span {
+	startTime := time.Now().UTC()
+	return opensearch.Span{
+		TraceID:         traceID,
+		SpanID:          spanID,
+		ParentSpanID:    parentSpanID,
+		Name:            "invoke_agent",
+		StartTime:       startTime,
+		EndTime:         startTime.Add(time.Millisecond),
+		DurationInNanos: int64(time.Millisecond),
+		Attributes:      attributes,
+		Resource: map[string]interface{}{
+			"openchoreo.dev/component-uid":   componentUid,
+			"openchoreo.dev/environment-uid": testEnvironmentUid,
+		},
+	}
+}
+
+// nextTraceEvent reads server-sent events until a trace event and returns its overview
+func nextTraceEvent(t *testing.T, scanner *bufio.Scanner) opensearch.TraceOverview {
+	t.Helper()
+	event := ""
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: ") && event == "trace":
+			var overview opensearch.TraceOverview
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &overview); err != nil {
+				t.Fatalf("failed to decode trace event: %v", err)
+			}
+			return overview
+		}
+	}
+	t.Fatalf("stream ended before a trace event: %v", scanner.Err())
+	return opensearch.TraceOverview{}
+}
+
+func TestTailTraces(t *testing.T) {
+	traceStore := memory.NewStore()
+	h := NewHandler(controllers.NewTracingController(traceStore, &config.TracingConfig{
+		SessionKeyAttribute: "session.id",
+		TailPollInterval:    20 * time.Millisecond,
+		TailLookback:        time.Minute,
+	}))
+	server := httptest.NewServer(http.HandlerFunc(h.TailTraces))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		server.URL+"?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+"&attribute=gen_ai.system%3Dopenai", nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to open tail: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("expected text/event-stream, got %s", contentType)
+	}
+
+	openai := map[string]interface{}{"gen_ai.system": "openai"}
+	traceStore.Add(
+		tailSpan("trace-other-component", "span-1", "", "component-uid-2", openai),
+		tailSpan("trace-other-system", "span-2", "", testComponentUid, map[string]interface{}{"gen_ai.system": "anthropic"}),
+		tailSpan("trace-1", "span-3", "", testComponentUid, openai),
+		tailSpan("trace-1", "span-4", "span-3", testComponentUid, nil),
+	)
+
+	scanner := bufio.NewScanner(resp.Body)
+	first := nextTraceEvent(t, scanner)
+	if first.TraceID != "trace-1" || first.SpanCount != 2 {
+		t.Fatalf("expected trace-1 with 2 spans, got %s with %d spans", first.TraceID, first.SpanCount)
+	}
+
+	// A trace is only sent once, even though later polls still cover it
+	traceStore.Add(tailSpan("trace-2", "span-5", "", testComponentUid, openai))
+	second := nextTraceEvent(t, scanner)
+	if second.TraceID != "trace-2" {
+		t.Fatalf("expected trace-2, got %s", second.TraceID)
+	}
+}
+
+func TestTailTracesPagesPastTheSpanCap(t *testing.T) {
+	traceStore := memory.NewStore()
+	h := NewHandler(controllers.NewTracingController(traceStore, &config.TracingConfig{
+		SessionKeyAttribute: "session.id",
+		TailPollInterval:    20 * time.Millisecond,
+		TailLookback:        time.Minute,
+	}))
+	server := httptest.NewServer(http.HandlerFunc(h.TailTraces))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		server.URL+"?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid, nil)
+	if err != nil {
+		t.Fatalf("failed to build request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to open tail: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// A burst of more spans than a single search returns
+	const traceCount = 1500
+	spans := make([]opensearch.Span, 0, traceCount)
+	for i := 0; i < traceCount; i++ {
+		spans = append(spans, tailSpan(fmt.Sprintf("trace-%d", i), "span-1", "", testComponentUid, nil))
+	}
+	traceStore.Add(spans...)
+
+	scanner := bufio.NewScanner(resp.Body)
+	received := make(map[string]bool)
+	for len(received) < traceCount {
+		overview := nextTraceEvent(t, scanner)
+		if received[overview.TraceID] {
+			t.Fatalf("trace %s was sent twice", overview.TraceID)
+		}
+		received[overview.TraceID] = true
+	}
+	if !received[fmt.Sprintf("trace-%d", traceCount-1)] {
+		t.Errorf("expected the newest trace of the burst to be sent")
+	}
+}
+
+func TestTailTracesRequiresScope(t *testing.T) {
+	h := newTestHandler(t)
+
+	if status := serve(t, h.TailTraces, "/api/v1/traces/tail?environmentUid="+testEnvironmentUid, nil); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 without componentUid, got %d", status)
+	}
+	if status := serve(t, h.TailTraces, "/api/v1/traces/tail?componentUid="+testComponentUid+"&environmentUid="+testEnvironmentUid+"&attribute=invalid", nil); status != http.StatusBadRequest {
+		t.Errorf("expected status 400 for an invalid attribute filter, got %d", status)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AgentManagerKeyResolver resolves ingestion keys issued by the agent-manager service through
+// its internal API key verification endpoint. Verified keys are cached for a short time so that
+// every export request does not result in a round trip to the agent-manager.
+type AgentManagerKeyResolver struct {
+	verifyURL    string
+	apiKeyHeader string
+	apiKey       string
+	cacheTTL     time.Duration
+	httpClient   *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedScope // Keyed by the hex encoded SHA-256 hash of the ingestion key
+}
+
+type cachedScope struct {
+	scope     *Scope // nil for keys the agent-manager rejected
+	expiresAt time.Time
+}
+
+var _ KeyResolver = (*AgentManagerKeyResolver)(nil)
+
+// NewAgentManagerKeyResolver creates a resolver that verifies keys against the agent-manager
+// endpoint at verifyURL, authenticating with apiKey sent in the apiKeyHeader header
+func NewAgentManagerKeyResolver(verifyURL, apiKeyHeader, apiKey string, cacheTTL time.Duration) *AgentManagerKeyResolver {
+	return &AgentManagerKeyResolver{
+		verifyURL:    verifyURL,
+		apiKeyHeader: apiKeyHeader,
+		apiKey:       apiKey,
+		cacheTTL:     cacheTTL,
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		cache:        make(map[string]cachedScope),
+	}
+}
+
+// verifyResponse is the response of the agent-manager API key verification endpoint
+type verifyResponse struct {
+	ProjectUid     string `json:"projectUid"`
+	ComponentUid   string `json:"componentUid"`
+	EnvironmentUid string `json:"environmentUid"`
+}
+
+// Resolve returns the scope of an ingestion key
+func (r *AgentManagerKeyResolver) Resolve(ctx context.Context, key string) (*Scope, error) {
+	if key == "" {
+		return nil, ErrInvalidKey
+	}
+
+	hash := HashKey(key)
+	if scope, ok := r.cached(hash); ok {
+		if scope == nil {
+			return nil, ErrInvalidKey
+		}
+		return scope, nil
+	}
+
+	scope, err := r.verify(ctx, key)
+	if err != nil && err != ErrInvalidKey {
+		return nil, err
+	}
+	r.store(hash, scope)
+	if scope == nil {
+		return nil, ErrInvalidKey
+	}
+	return scope, nil
+}
+
+func (r *AgentManagerKeyResolver) verify(ctx context.Context, key string) (*Scope, error) {
+	body, err := json.Marshal(map[string]string{"key": key})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal verification request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.verifyURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create verification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.apiKey != "" {
+		req.Header.Set(r.apiKeyHeader, r.apiKey)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify ingestion key: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return nil, ErrInvalidKey
+	default:
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("ingestion key verification failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result verifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode verification response: %w", err)
+	}
+	if result.ComponentUid == "" || result.EnvironmentUid == "" {
+		return nil, fmt.Errorf("verification response is missing the component or environment uid")
+	}
+
+	return &Scope{
+		ProjectUid:     result.ProjectUid,
+		ComponentUid:   result.ComponentUid,
+		EnvironmentUid: result.EnvironmentUid,
+	}, nil
+}
+
+func (r *AgentManagerKeyResolver) cached(hash string) (*Scope, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.cache[hash]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(r.cache, hash)
+		return nil, false
+	}
+	return entry.scope, true
+}
+
+func (r *AgentManagerKeyResolver) store(hash string, scope *Scope) {
+	if r.cacheTTL <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	// Drop expired entries so that the cache does not grow with every key ever presented
+	for k, entry := range r.cache {
+		if now.After(entry.expiresAt) {
+			delete(r.cache, k)
+		}
+	}
+	r.cache[hash] = cachedScope{scope: scope, expiresAt: now.Add(r.cacheTTL)}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAgentManagerKeyResolver(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("X-API-KEY") != "internal-key" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var req struct {
+			Key string `json:"key"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.Key != "amp_valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"keyId":          "key-1",
+			"projectUid":     "project-uid-1",
+			"componentUid":   "component-uid-1",
+			"environmentUid": "environment-uid-1",
+		})
+	}))
+	defer server.Close()
+
+	resolver := NewAgentManagerKeyResolver(server.URL, "X-API-KEY", "internal-key", time.Minute)
+	ctx := context.Background()
+
+	scope, err := resolver.Resolve(ctx, "amp_valid")
+	if err != nil {
+		t.Fatalf("expected key to resolve, got %v", err)
+	}
+	if scope.ComponentUid != "component-uid-1" || scope.EnvironmentUid != "environment-uid-1" || scope.ProjectUid != "project-uid-1" {
+		t.Errorf("unexpected scope %+v", scope)
+	}
+
+	// The second lookup is served from the cache
+	if _, err := resolver.Resolve(ctx, "amp_valid"); err != nil {
+		t.Fatalf("expected cached key to resolve, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 verification call, got %d", calls)
+	}
+
+	if _, err := resolver.Resolve(ctx, "amp_unknown"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected ErrInvalidKey for an unknown key, got %v", err)
+	}
+	if _, err := resolver.Resolve(ctx, ""); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected ErrInvalidKey for an empty key, got %v", err)
+	}
+
+	// Verification failures other than a rejected key are not reported as invalid keys
+	unauthorized := NewAgentManagerKeyResolver(server.URL, "X-API-KEY", "wrong", time.Minute)
+	if _, err := unauthorized.Resolve(ctx, "amp_valid"); err == nil || errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected a verification error, got %v", err)
+	}
+}
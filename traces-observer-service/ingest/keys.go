@@ -0,0 +1,102 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrInvalidKey is returned when an ingestion key is missing or unknown
+var ErrInvalidKey = errors.New("invalid ingestion key")
+
+// Scope identifies the agent deployment an ingestion key was issued for. Ingested spans are
+// stamped with these UIDs so that they show up in the trace queries of that agent.
+type Scope struct {
+	ProjectUid     string `json:"projectUid,omitempty"`
+	ComponentUid   string `json:"componentUid"`
+	EnvironmentUid string `json:"environmentUid"`
+}
+
+// KeyResolver resolves an ingestion key to the scope it was issued for
+type KeyResolver interface {
+	// Resolve returns ErrInvalidKey if the key is not known
+	Resolve(ctx context.Context, key string) (*Scope, error)
+}
+
+// fileKey is an entry of an ingestion keys file. Only the SHA-256 hash of the key is stored.
+type fileKey struct {
+	KeySha256 string `json:"keySha256"`
+	Scope
+}
+
+// FileKeyResolver resolves ingestion keys listed in a JSON file
+type FileKeyResolver struct {
+	scopes map[string]Scope // Keyed by the hex encoded SHA-256 hash of the ingestion key
+}
+
+var _ KeyResolver = (*FileKeyResolver)(nil)
+
+// NewFileKeyResolver loads ingestion keys from a JSON file holding an array of
+// {"keySha256", "projectUid", "componentUid", "environmentUid"} entries
+func NewFileKeyResolver(path string) (*FileKeyResolver, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ingestion keys file: %w", err)
+	}
+
+	var keys []fileKey
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to decode ingestion keys file: %w", err)
+	}
+
+	scopes := make(map[string]Scope, len(keys))
+	for i, key := range keys {
+		if key.KeySha256 == "" || key.ComponentUid == "" || key.EnvironmentUid == "" {
+			return nil, fmt.Errorf("ingestion key %d must have keySha256, componentUid and environmentUid", i)
+		}
+		scopes[strings.ToLower(key.KeySha256)] = key.Scope
+	}
+
+	return &FileKeyResolver{
+		scopes: scopes,
+	}, nil
+}
+
+// Resolve returns the scope of an ingestion key
+func (r *FileKeyResolver) Resolve(ctx context.Context, key string) (*Scope, error) {
+	if key == "" {
+		return nil, ErrInvalidKey
+	}
+	scope, ok := r.scopes[HashKey(key)]
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return &scope, nil
+}
+
+// HashKey returns the hex encoded SHA-256 hash of an ingestion key
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
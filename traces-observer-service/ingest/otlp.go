@@ -0,0 +1,170 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package ingest accepts OTLP trace data pushed by agents running outside the platform
+package ingest
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
+)
+
+// Resource attributes that tie spans to an agent deployment
+const (
+	ResourceProjectUid     = "openchoreo.dev/project-uid"
+	ResourceComponentUid   = "openchoreo.dev/component-uid"
+	ResourceEnvironmentUid = "openchoreo.dev/environment-uid"
+)
+
+// StampScope sets the UID resource attributes of every resource to the given scope,
+// removing any values sent by the agent. The project UID is left off when the scope has none.
+func StampScope(data *export.OTLPTracesData, scope Scope) {
+	stamped := map[string]string{
+		ResourceProjectUid:     scope.ProjectUid,
+		ResourceComponentUid:   scope.ComponentUid,
+		ResourceEnvironmentUid: scope.EnvironmentUid,
+	}
+
+	for i := range data.ResourceSpans {
+		resource := &data.ResourceSpans[i].Resource
+		attributes := make([]export.OTLPKeyValue, 0, len(resource.Attributes)+len(stamped))
+		for _, attribute := range resource.Attributes {
+			if _, ok := stamped[attribute.Key]; !ok {
+				attributes = append(attributes, attribute)
+			}
+		}
+		for _, key := range []string{ResourceProjectUid, ResourceComponentUid, ResourceEnvironmentUid} {
+			if value := stamped[key]; value != "" {
+				attributes = append(attributes, export.OTLPKeyValue{Key: key, Value: export.OTLPAnyValue{StringValue: &value}})
+			}
+		}
+		resource.Attributes = attributes
+	}
+}
+
+// FromProto converts an OTLP/protobuf export request into its OTLP/JSON representation
+func FromProto(request *coltracepb.ExportTraceServiceRequest) export.OTLPTracesData {
+	data := export.OTLPTracesData{
+		ResourceSpans: make([]export.OTLPResourceSpans, 0, len(request.GetResourceSpans())),
+	}
+
+	for _, resourceSpans := range request.GetResourceSpans() {
+		converted := export.OTLPResourceSpans{
+			Resource: export.OTLPResource{
+				Attributes: fromProtoAttributes(resourceSpans.GetResource().GetAttributes()),
+			},
+		}
+
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			convertedScope := export.OTLPScopeSpans{
+				Scope: export.OTLPScope{
+					Name:    scopeSpans.GetScope().GetName(),
+					Version: scopeSpans.GetScope().GetVersion(),
+				},
+			}
+
+			for _, span := range scopeSpans.GetSpans() {
+				convertedSpan := export.OTLPSpan{
+					TraceID:           hex.EncodeToString(span.GetTraceId()),
+					SpanID:            hex.EncodeToString(span.GetSpanId()),
+					ParentSpanID:      hex.EncodeToString(span.GetParentSpanId()),
+					Name:              span.GetName(),
+					Kind:              export.OTLPSpanKind(span.GetKind()),
+					StartTimeUnixNano: protoUnixNano(span.GetStartTimeUnixNano()),
+					EndTimeUnixNano:   protoUnixNano(span.GetEndTimeUnixNano()),
+					Attributes:        fromProtoAttributes(span.GetAttributes()),
+					Status: export.OTLPStatus{
+						Code:    export.OTLPStatusCode(span.GetStatus().GetCode()),
+						Message: span.GetStatus().GetMessage(),
+					},
+				}
+				for _, event := range span.GetEvents() {
+					convertedSpan.Events = append(convertedSpan.Events, export.OTLPEvent{
+						TimeUnixNano: protoUnixNano(event.GetTimeUnixNano()),
+						Name:         event.GetName(),
+						Attributes:   fromProtoAttributes(event.GetAttributes()),
+					})
+				}
+				for _, link := range span.GetLinks() {
+					convertedSpan.Links = append(convertedSpan.Links, export.OTLPLink{
+						TraceID:    hex.EncodeToString(link.GetTraceId()),
+						SpanID:     hex.EncodeToString(link.GetSpanId()),
+						TraceState: link.GetTraceState(),
+						Attributes: fromProtoAttributes(link.GetAttributes()),
+					})
+				}
+				convertedScope.Spans = append(convertedScope.Spans, convertedSpan)
+			}
+
+			converted.ScopeSpans = append(converted.ScopeSpans, convertedScope)
+		}
+
+		data.ResourceSpans = append(data.ResourceSpans, converted)
+	}
+
+	return data
+}
+
+// fromProtoAttributes converts protobuf attributes into their OTLP/JSON representation
+func fromProtoAttributes(keyValues []*commonpb.KeyValue) []export.OTLPKeyValue {
+	attributes := make([]export.OTLPKeyValue, 0, len(keyValues))
+	for _, keyValue := range keyValues {
+		attributes = append(attributes, export.OTLPKeyValue{
+			Key:   keyValue.GetKey(),
+			Value: fromProtoAnyValue(keyValue.GetValue()),
+		})
+	}
+	return attributes
+}
+
+// fromProtoAnyValue converts a protobuf typed value into its OTLP/JSON representation.
+// Bytes values are hex encoded as they have no equivalent in span documents.
+func fromProtoAnyValue(value *commonpb.AnyValue) export.OTLPAnyValue {
+	switch v := value.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return export.OTLPAnyValue{StringValue: &v.StringValue}
+	case *commonpb.AnyValue_BoolValue:
+		return export.OTLPAnyValue{BoolValue: &v.BoolValue}
+	case *commonpb.AnyValue_IntValue:
+		intValue := export.OTLPInt64(strconv.FormatInt(v.IntValue, 10))
+		return export.OTLPAnyValue{IntValue: &intValue}
+	case *commonpb.AnyValue_DoubleValue:
+		return export.OTLPAnyValue{DoubleValue: &v.DoubleValue}
+	case *commonpb.AnyValue_ArrayValue:
+		values := make([]export.OTLPAnyValue, 0, len(v.ArrayValue.GetValues()))
+		for _, item := range v.ArrayValue.GetValues() {
+			values = append(values, fromProtoAnyValue(item))
+		}
+		return export.OTLPAnyValue{ArrayValue: &export.OTLPArrayValue{Values: values}}
+	case *commonpb.AnyValue_KvlistValue:
+		return export.OTLPAnyValue{KvlistValue: &export.OTLPKvlist{Values: fromProtoAttributes(v.KvlistValue.GetValues())}}
+	case *commonpb.AnyValue_BytesValue:
+		encoded := hex.EncodeToString(v.BytesValue)
+		return export.OTLPAnyValue{StringValue: &encoded}
+	default:
+		return export.OTLPAnyValue{}
+	}
+}
+
+// protoUnixNano converts a protobuf timestamp in Unix nanoseconds into its OTLP/JSON representation
+func protoUnixNano(nanos uint64) export.OTLPInt64 {
+	return export.OTLPInt64(strconv.FormatUint(nanos, 10))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
+)
+
+func TestStampScope(t *testing.T) {
+	stringAttribute := func(key, value string) export.OTLPKeyValue {
+		return export.OTLPKeyValue{Key: key, Value: export.OTLPAnyValue{StringValue: &value}}
+	}
+	newData := func() *export.OTLPTracesData {
+		return &export.OTLPTracesData{
+			ResourceSpans: []export.OTLPResourceSpans{{
+				Resource: export.OTLPResource{
+					Attributes: []export.OTLPKeyValue{
+						stringAttribute("service.name", "my-agent"),
+						stringAttribute(ResourceProjectUid, "spoofed-project"),
+						stringAttribute(ResourceComponentUid, "spoofed-component"),
+					},
+				},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		scope Scope
+		want  []export.OTLPKeyValue
+	}{
+		{
+			name:  "scope with a project replaces the client values",
+			scope: Scope{ProjectUid: "project-uid-1", ComponentUid: "component-uid-1", EnvironmentUid: "environment-uid-1"},
+			want: []export.OTLPKeyValue{
+				stringAttribute("service.name", "my-agent"),
+				stringAttribute(ResourceProjectUid, "project-uid-1"),
+				stringAttribute(ResourceComponentUid, "component-uid-1"),
+				stringAttribute(ResourceEnvironmentUid, "environment-uid-1"),
+			},
+		},
+		{
+			name:  "scope without a project drops the client project",
+			scope: Scope{ComponentUid: "component-uid-1", EnvironmentUid: "environment-uid-1"},
+			want: []export.OTLPKeyValue{
+				stringAttribute("service.name", "my-agent"),
+				stringAttribute(ResourceComponentUid, "component-uid-1"),
+				stringAttribute(ResourceEnvironmentUid, "environment-uid-1"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := newData()
+			StampScope(data, tt.scope)
+			if got := data.ResourceSpans[0].Resource.Attributes; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -29,37 +29,77 @@ import (
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/handlers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/ingest"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store/memory"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/telemetry"
 )
 
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		slog.Error("Failed to load config", "error", err)
+		os.Exit(1)
 	}
 
-	log.Printf("Starting tracing service on port %d", cfg.Server.Port)
+	telemetry.SetupLogger(os.Stdout, cfg.Telemetry.LogLevel)
+	slog.Info("Starting tracing service", "port", cfg.Server.Port)
 
-	// Initialize OpenSearch client
-	osClient, err := opensearch.NewClient(&cfg.OpenSearch)
+	// Self-tracing of the service's own requests
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), &cfg.Telemetry)
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+
+	// Background jobs run until the server shuts down
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	// Initialize trace store
+	traceStore, err := newTraceStore(jobsCtx, cfg)
 	if err != nil {
-		// log.Fatalf internally calls os.Exit(1)
-		log.Fatalf("Failed to create OpenSearch client: %v", err)
+		slog.Error("Failed to create trace store", "error", err)
+		os.Exit(1)
 	}
 
 	// Initialize service
-	tracingController := controllers.NewTracingController(osClient)
+	tracingController := controllers.NewTracingController(traceStore, &cfg.Tracing)
 
 	// Initialize handlers
 	handler := handlers.NewHandler(tracingController)
 
 	// Setup routes
+	apiMux := http.NewServeMux()
+	apiMux.Handle("/api/v1/traces", telemetry.InstrumentHandler("traces", http.HandlerFunc(handler.GetTraceOverviews)))
+	apiMux.Handle("/api/v1/trace", telemetry.InstrumentHandler("trace", http.HandlerFunc(handler.GetTraceByIdAndService)))
+	apiMux.Handle("/api/v1/trace/export", telemetry.InstrumentHandler("trace_export", http.HandlerFunc(handler.ExportTrace)))
+	apiMux.Handle("/api/v1/traces/export", telemetry.InstrumentHandler("traces_export", http.HandlerFunc(handler.ExportTraces)))
+	apiMux.Handle("/api/v1/traces/tail", telemetry.InstrumentHandler("traces_tail", http.HandlerFunc(handler.TailTraces)))
+	apiMux.Handle("/api/v1/usage", telemetry.InstrumentHandler("usage", http.HandlerFunc(handler.GetTokenUsage)))
+	apiMux.Handle("/api/v1/sessions", telemetry.InstrumentHandler("sessions", http.HandlerFunc(handler.ListSessions)))
+	apiMux.Handle("/api/v1/session", telemetry.InstrumentHandler("session", http.HandlerFunc(handler.GetSessionTraces)))
+
+	// Query API requests are authenticated, health checks and ingestion (which has its own keys) are not
+	if cfg.Auth.Mode == config.AuthModeNone {
+		slog.Warn("Query API authentication is disabled by TRACES_OBSERVER_AUTH_MODE=none, traces are readable by anyone who can reach the service")
+	} else {
+		slog.Info("Query API authentication enabled", "mode", cfg.Auth.Mode)
+	}
 	mux := http.NewServeMux()
-	mux.HandleFunc("/api/v1/traces", handler.GetTraceOverviews)
-	mux.HandleFunc("/api/v1/trace", handler.GetTraceByIdAndService)
+	mux.Handle("/api/v1/", middleware.Authentication(&cfg.Auth)(apiMux))
 	mux.HandleFunc("/health", handler.Health)
+	mux.Handle("/metrics", telemetry.MetricsHandler())
+
+	// OTLP/HTTP ingestion for agents that push traces directly
+	if keyResolver := newKeyResolver(cfg); keyResolver != nil {
+		ingestHandler := handlers.NewIngestHandler(controllers.NewIngestController(traceStore, keyResolver), int64(cfg.Ingest.MaxBodyBytes))
+		mux.Handle("POST /v1/traces", telemetry.InstrumentHandler("ingest", http.HandlerFunc(ingestHandler.ExportTraces)))
+		slog.Info("OTLP/HTTP trace ingestion enabled on /v1/traces")
+	}
 
 	// Apply CORS middleware
 	corsConfig := middleware.DefaultCORSConfig()
@@ -75,9 +115,10 @@ func main() {
 
 	// Start server in a goroutine
 	go func() {
-		log.Printf("Server listening on :%d", cfg.Server.Port)
+		slog.Info("Server listening", "port", cfg.Server.Port)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Server failed: %v", err)
+			slog.Error("Server failed", "error", err)
+			os.Exit(1)
 		}
 	}()
 
@@ -86,15 +127,69 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
+	slog.Info("Shutting down server...")
+	stopJobs()
 
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	if err := server.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		slog.Error("Server forced to shutdown", "error", err)
+		os.Exit(1)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Failed to flush traces", "error", err)
 	}
 
-	log.Println("Server exited")
+	slog.Info("Server exited")
+}
+
+// newKeyResolver returns the ingestion key resolver for the configured key source, or nil if ingestion is disabled
+func newKeyResolver(cfg *config.Config) ingest.KeyResolver {
+	switch {
+	case cfg.Ingest.KeysFile != "":
+		keyResolver, err := ingest.NewFileKeyResolver(cfg.Ingest.KeysFile)
+		if err != nil {
+			slog.Error("Failed to load ingestion keys", "error", err)
+			os.Exit(1)
+		}
+		return keyResolver
+	case cfg.Ingest.KeyVerifyURL != "":
+		slog.Info("Verifying ingestion keys against agent-manager", "url", cfg.Ingest.KeyVerifyURL)
+		return ingest.NewAgentManagerKeyResolver(
+			cfg.Ingest.KeyVerifyURL,
+			cfg.Ingest.KeyVerifyAPIKeyHeader,
+			cfg.Ingest.KeyVerifyAPIKey,
+			time.Duration(cfg.Ingest.KeyCacheTTLSeconds)*time.Second,
+		)
+	default:
+		return nil
+	}
+}
+
+// newTraceStore creates the configured trace store backend and starts its retention job, if enabled
+func newTraceStore(ctx context.Context, cfg *config.Config) (store.TraceStore, error) {
+	if cfg.Store.Backend == config.StoreBackendMemory {
+		slog.Info("Using in-memory trace store")
+		memoryStore := memory.NewStore()
+		if cfg.Store.SeedPath != "" {
+			if err := memoryStore.LoadOTLPPath(cfg.Store.SeedPath); err != nil {
+				return nil, err
+			}
+		}
+		if cfg.Retention.Days > 0 {
+			slog.Warn("Trace retention only applies to the opensearch store, ignoring TRACE_RETENTION_DAYS")
+		}
+		return memoryStore, nil
+	}
+
+	osClient, err := opensearch.NewClient(&cfg.OpenSearch)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Retention.Days > 0 {
+		go opensearch.NewRetentionJob(osClient, &cfg.Retention).Start(ctx)
+	}
+	return opensearch.NewStore(osClient), nil
 }
@@ -0,0 +1,72 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// Authentication returns a middleware that authenticates query API requests with the configured auth mode.
+// In jwt mode the verified token claims are added to the request context, so that handlers can authorize the query.
+func Authentication(cfg *config.AuthConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch cfg.Mode {
+			case config.AuthModeSharedSecret:
+				token := auth.BearerToken(r)
+				if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(cfg.SharedSecret)) != 1 {
+					writeUnauthorized(w, "invalid or missing credentials")
+					return
+				}
+			case config.AuthModeJWT:
+				token := auth.BearerToken(r)
+				if token == "" {
+					writeUnauthorized(w, "missing bearer token")
+					return
+				}
+				claims, err := auth.ParseToken(token, []byte(cfg.JWTSigningKey), cfg.JWTAudience, time.Now())
+				if err != nil {
+					if !errors.Is(err, auth.ErrInvalidToken) {
+						slog.ErrorContext(r.Context(), "Failed to verify query token", "error", err)
+					}
+					writeUnauthorized(w, "invalid bearer token")
+					return
+				}
+				r = r.WithContext(auth.WithClaims(r.Context(), claims))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", `Bearer realm="traces-observer"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": "error", "message": message}); err != nil {
+		slog.Error("Failed to encode JSON", "error", err)
+	}
+}
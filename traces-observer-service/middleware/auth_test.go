@@ -0,0 +1,93 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// serveAuthenticated sends a request with the given Authorization header through the authentication middleware
+// and returns the response status and whether the request carried token claims to the next handler
+func serveAuthenticated(cfg *config.AuthConfig, authorization string) (int, bool) {
+	var hasClaims bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hasClaims = auth.ClaimsFromContext(r.Context()) != nil
+		w.WriteHeader(http.StatusOK)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/traces", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	recorder := httptest.NewRecorder()
+	Authentication(cfg)(next).ServeHTTP(recorder, request)
+	return recorder.Code, hasClaims
+}
+
+func TestAuthenticationSharedSecret(t *testing.T) {
+	cfg := &config.AuthConfig{Mode: config.AuthModeSharedSecret, SharedSecret: "secret"}
+
+	if status, hasClaims := serveAuthenticated(cfg, "Bearer secret"); status != http.StatusOK || hasClaims {
+		t.Errorf("expected the shared secret to be accepted without claims, got status %d", status)
+	}
+	for _, authorization := range []string{"", "Bearer other", "Basic secret"} {
+		if status, _ := serveAuthenticated(cfg, authorization); status != http.StatusUnauthorized {
+			t.Errorf("expected status 401 for %q, got %d", authorization, status)
+		}
+	}
+}
+
+func TestAuthenticationJWT(t *testing.T) {
+	cfg := &config.AuthConfig{Mode: config.AuthModeJWT, JWTSigningKey: "signing-key", JWTAudience: "traces-observer"}
+
+	token, err := auth.SignToken(auth.Claims{
+		Audience:      "traces-observer",
+		ExpiresAt:     time.Now().Add(time.Minute).Unix(),
+		ComponentUids: []string{"component-uid-1"},
+	}, []byte("signing-key"))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if status, hasClaims := serveAuthenticated(cfg, "Bearer "+token); status != http.StatusOK || !hasClaims {
+		t.Errorf("expected the token to be accepted with claims, got status %d", status)
+	}
+
+	expired, err := auth.SignToken(auth.Claims{
+		Audience:  "traces-observer",
+		ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+	}, []byte("signing-key"))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	for _, authorization := range []string{"", "Bearer " + expired, "Bearer signing-key"} {
+		if status, _ := serveAuthenticated(cfg, authorization); status != http.StatusUnauthorized {
+			t.Errorf("expected status 401 for %q, got %d", authorization, status)
+		}
+	}
+}
+
+func TestAuthenticationDisabled(t *testing.T) {
+	if status, _ := serveAuthenticated(&config.AuthConfig{Mode: config.AuthModeNone}, ""); status != http.StatusOK {
+		t.Errorf("expected requests to pass through with authentication disabled, got %d", status)
+	}
+}
@@ -22,12 +22,18 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/opensearch-project/opensearch-go"
 	"github.com/opensearch-project/opensearch-go/opensearchapi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/telemetry"
 )
 
 // Client wraps the OpenSearch client
@@ -62,7 +68,7 @@ func NewClient(cfg *config.OpenSearchConfig) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to OpenSearch: %w", err)
 	} else {
-		log.Printf("Connected to OpenSearch, status: %s", info.Status())
+		slog.Info("Connected to OpenSearch", "status", info.Status())
 	}
 
 	return &Client{
@@ -72,8 +78,16 @@ func NewClient(cfg *config.OpenSearchConfig) (*Client, error) {
 }
 
 // Search executes a search query against one or more indices
-func (c *Client) Search(ctx context.Context, indices []string, query map[string]interface{}) (*SearchResponse, error) {
-	log.Printf("Executing search on indices: %v", indices)
+func (c *Client) Search(ctx context.Context, indices []string, query map[string]interface{}) (_ *SearchResponse, err error) {
+	ctx, span := startSpan(ctx, "search", attribute.StringSlice("opensearch.indices", indices))
+	start := time.Now()
+	resultSize := -1
+	defer func() {
+		endSpan(span, err)
+		telemetry.ObserveOpenSearchRequest("search", len(indices), resultSize, time.Since(start), err)
+	}()
+
+	slog.DebugContext(ctx, "Executing search", "indices", indices)
 
 	// Convert query to JSON
 	var buf bytes.Buffer
@@ -91,13 +105,13 @@ func (c *Client) Search(ctx context.Context, indices []string, query map[string]
 	// Execute search
 	res, err := req.Do(ctx, c.client)
 	if err != nil {
-		log.Printf("Search request failed: %v", err)
+		slog.ErrorContext(ctx, "Search request failed", "error", err)
 		return nil, fmt.Errorf("search request failed: %w", err)
 	}
 	defer res.Body.Close()
 
 	if res.IsError() {
-		log.Printf("Search request returned error: %s", res.Status())
+		slog.ErrorContext(ctx, "Search request returned error", "status", res.Status())
 		return nil, fmt.Errorf("search request failed with status: %s", res.Status())
 	}
 
@@ -107,13 +121,203 @@ func (c *Client) Search(ctx context.Context, indices []string, query map[string]
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	log.Printf("Search completed: total_hits=%d, returned_hits=%d", response.Hits.Total.Value, len(response.Hits.Hits))
+	resultSize = len(response.Hits.Hits)
+	span.SetAttributes(attribute.Int("opensearch.total_hits", response.Hits.Total.Value), attribute.Int("opensearch.returned_hits", resultSize))
+	slog.DebugContext(ctx, "Search completed", "totalHits", response.Hits.Total.Value, "returnedHits", resultSize)
 
 	return &response, nil
 }
 
+// bulkResponse is the part of an OpenSearch bulk response needed to detect failed items
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Index  string `json:"_index"`
+		Status int    `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error,omitempty"`
+	} `json:"items"`
+}
+
+// Bulk executes a bulk request with the given NDJSON body and fails if any item was rejected
+func (c *Client) Bulk(ctx context.Context, body *bytes.Buffer) (err error) {
+	ctx, span := startSpan(ctx, "bulk")
+	start := time.Now()
+	indexCount, resultSize := 0, -1
+	defer func() {
+		endSpan(span, err)
+		telemetry.ObserveOpenSearchRequest("bulk", indexCount, resultSize, time.Since(start), err)
+	}()
+
+	req := opensearchapi.BulkRequest{
+		Body: body,
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		slog.ErrorContext(ctx, "Bulk request failed", "error", err)
+		return fmt.Errorf("bulk request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		slog.ErrorContext(ctx, "Bulk request returned error", "status", res.Status())
+		return fmt.Errorf("bulk request failed with status: %s", res.Status())
+	}
+
+	var response bulkResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	indexCount, resultSize = countBulkIndices(response), len(response.Items)
+	if !response.Errors {
+		return nil
+	}
+
+	failed := 0
+	var firstError string
+	for _, item := range response.Items {
+		for _, result := range item {
+			if result.Error != nil {
+				failed++
+				if firstError == "" {
+					firstError = fmt.Sprintf("%s: %s", result.Error.Type, result.Error.Reason)
+				}
+			}
+		}
+	}
+	return fmt.Errorf("bulk request failed for %d of %d documents: %s", failed, len(response.Items), firstError)
+}
+
+// ListIndices returns the names of the indices matching a pattern
+func (c *Client) ListIndices(ctx context.Context, pattern string) ([]string, error) {
+	req := opensearchapi.CatIndicesRequest{
+		Index:  []string{pattern},
+		Format: "json",
+		H:      []string{"index"},
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("list indices request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	// No index matches the pattern
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("list indices request failed with status: %s", res.Status())
+	}
+
+	var rows []struct {
+		Index string `json:"index"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode indices: %w", err)
+	}
+
+	indices := make([]string, 0, len(rows))
+	for _, row := range rows {
+		indices = append(indices, row.Index)
+	}
+	return indices, nil
+}
+
+// DeleteIndex deletes an index
+func (c *Client) DeleteIndex(ctx context.Context, index string) error {
+	req := opensearchapi.IndicesDeleteRequest{
+		Index:             []string{index},
+		IgnoreUnavailable: opensearchapi.BoolPtr(true),
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("delete index request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("delete index request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+// SnapshotIndex snapshots an index into a snapshot repository and waits for the snapshot to complete
+func (c *Client) SnapshotIndex(ctx context.Context, repository, snapshot, index string) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{
+		"indices":              index,
+		"include_global_state": false,
+	}); err != nil {
+		return fmt.Errorf("failed to encode snapshot request: %w", err)
+	}
+
+	req := opensearchapi.SnapshotCreateRequest{
+		Repository:        repository,
+		Snapshot:          snapshot,
+		Body:              &buf,
+		WaitForCompletion: opensearchapi.BoolPtr(true),
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("snapshot request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("snapshot request failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Snapshot struct {
+			State string `json:"state"`
+		} `json:"snapshot"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode snapshot response: %w", err)
+	}
+	if response.Snapshot.State != "SUCCESS" {
+		return fmt.Errorf("snapshot %s finished in state %s", snapshot, response.Snapshot.State)
+	}
+	return nil
+}
+
 // HealthCheck checks if OpenSearch is accessible
 func (c *Client) HealthCheck(ctx context.Context) error {
 	_, err := c.client.Info()
 	return err
 }
+
+// startSpan starts a client span for an OpenSearch call
+func startSpan(ctx context.Context, operation string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	attributes = append(attributes, attribute.String("db.system", "opensearch"), attribute.String("db.operation", operation))
+	return telemetry.Tracer().Start(ctx, "opensearch."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// endSpan ends the span of an OpenSearch call, recording its error if it failed
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// countBulkIndices returns the number of distinct indices the items of a bulk request were written to
+func countBulkIndices(response bulkResponse) int {
+	indices := make(map[string]struct{})
+	for _, item := range response.Items {
+		for _, result := range item {
+			indices[result.Index] = struct{}{}
+		}
+	}
+	return len(indices)
+}
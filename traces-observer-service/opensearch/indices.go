@@ -0,0 +1,119 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// IndexNaming maps span start times to the trace indices they are stored in. Spans are stored in one
+// index per UTC day, named by a prefix followed by the date, unless an alias is configured, in which case
+// all reads and writes go through the alias.
+type IndexNaming struct {
+	prefix          string
+	dateLayout      string
+	alias           string
+	maxQueryIndices int
+}
+
+// NewIndexNaming creates the index naming of the given OpenSearch configuration
+func NewIndexNaming(cfg *config.OpenSearchConfig) *IndexNaming {
+	return &IndexNaming{
+		prefix:          cfg.IndexPrefix,
+		dateLayout:      cfg.IndexDateLayout,
+		alias:           cfg.IndexAlias,
+		maxQueryIndices: cfg.MaxQueryIndices,
+	}
+}
+
+// IndicesForTimeRange returns the indices holding spans that start in the given RFC3339 time range.
+// Ranges spanning more days than the configured maximum are searched through the index wildcard instead.
+func (n *IndexNaming) IndicesForTimeRange(startTime, endTime string) ([]string, error) {
+	if startTime == "" || endTime == "" {
+		return nil, fmt.Errorf("start time and end time are required")
+	}
+
+	// Parse the time strings (expecting RFC3339 format)
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time format: %w", err)
+	}
+
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time format: %w", err)
+	}
+
+	// Ensure start is before end
+	if start.After(end) {
+		return nil, fmt.Errorf("start time must be before end time")
+	}
+
+	if n.alias != "" {
+		return []string{n.alias}, nil
+	}
+
+	// Days are bucketed in UTC, the same as the indices are written, whatever the offset of the query times
+	currentDay := utcDay(start)
+	endDay := utcDay(end)
+	if days := int(endDay.Sub(currentDay).Hours()/24) + 1; n.maxQueryIndices > 0 && days > n.maxQueryIndices {
+		return []string{n.Wildcard()}, nil
+	}
+
+	indices := []string{}
+	for !currentDay.After(endDay) {
+		indices = append(indices, n.IndexForTime(currentDay))
+		currentDay = currentDay.AddDate(0, 0, 1) // Add one day
+	}
+
+	return indices, nil
+}
+
+// IndexForTime returns the index that a span starting at the given time is written to
+func (n *IndexNaming) IndexForTime(t time.Time) string {
+	if n.alias != "" {
+		return n.alias
+	}
+	return n.prefix + t.UTC().Format(n.dateLayout)
+}
+
+// Wildcard returns the pattern matching all daily trace indices
+func (n *IndexNaming) Wildcard() string {
+	return n.prefix + "*"
+}
+
+// IndexDate returns the UTC day of a daily trace index, or false if the index is not named by the configured pattern
+func (n *IndexNaming) IndexDate(index string) (time.Time, bool) {
+	if !strings.HasPrefix(index, n.prefix) {
+		return time.Time{}, false
+	}
+	day, err := time.ParseInLocation(n.dateLayout, strings.TrimPrefix(index, n.prefix), time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
+
+// utcDay returns the start of the UTC day of t
+func utcDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+func newTestIndexNaming(alias string) *IndexNaming {
+	return NewIndexNaming(&config.OpenSearchConfig{
+		IndexPrefix:     "otel-traces-",
+		IndexDateLayout: "2006-01-02",
+		IndexAlias:      alias,
+		MaxQueryIndices: 7,
+	})
+}
+
+func TestIndicesForTimeRange(t *testing.T) {
+	naming := newTestIndexNaming("")
+
+	tests := []struct {
+		name      string
+		startTime string
+		endTime   string
+		want      []string
+	}{
+		{
+			name:      "single day",
+			startTime: "2025-01-10T00:00:00Z",
+			endTime:   "2025-01-10T23:59:59Z",
+			want:      []string{"otel-traces-2025-01-10"},
+		},
+		{
+			name:      "times with an offset are bucketed by their UTC day",
+			startTime: "2025-01-10T02:00:00+05:30",
+			endTime:   "2025-01-10T06:00:00+05:30",
+			want:      []string{"otel-traces-2025-01-09", "otel-traces-2025-01-10"},
+		},
+		{
+			name:      "range at the index cap",
+			startTime: "2025-01-01T12:00:00Z",
+			endTime:   "2025-01-07T12:00:00Z",
+			want: []string{
+				"otel-traces-2025-01-01", "otel-traces-2025-01-02", "otel-traces-2025-01-03", "otel-traces-2025-01-04",
+				"otel-traces-2025-01-05", "otel-traces-2025-01-06", "otel-traces-2025-01-07",
+			},
+		},
+		{
+			name:      "range beyond the index cap falls back to the wildcard",
+			startTime: "2025-01-01T12:00:00Z",
+			endTime:   "2025-01-08T12:00:00Z",
+			want:      []string{"otel-traces-*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := naming.IndicesForTimeRange(tt.startTime, tt.endTime)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := naming.IndicesForTimeRange("2025-01-10T00:00:00Z", "2025-01-09T00:00:00Z"); err == nil {
+		t.Error("expected an error when the start time is after the end time")
+	}
+}
+
+func TestIndexAlias(t *testing.T) {
+	naming := newTestIndexNaming("otel-traces")
+
+	got, err := naming.IndicesForTimeRange("2025-01-01T00:00:00Z", "2025-03-01T00:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"otel-traces"}) {
+		t.Errorf("expected the alias, got %v", got)
+	}
+	if index := naming.IndexForTime(time.Now()); index != "otel-traces" {
+		t.Errorf("expected spans to be written to the alias, got %s", index)
+	}
+}
+
+func TestIndexForTime(t *testing.T) {
+	naming := newTestIndexNaming("")
+
+	offset := time.FixedZone("IST", 5*60*60+30*60)
+	if index := naming.IndexForTime(time.Date(2025, time.January, 10, 2, 0, 0, 0, offset)); index != "otel-traces-2025-01-09" {
+		t.Errorf("expected the UTC day index, got %s", index)
+	}
+}
+
+func TestExpiredIndices(t *testing.T) {
+	naming := newTestIndexNaming("")
+	now := time.Date(2025, time.January, 31, 10, 0, 0, 0, time.UTC)
+
+	indices := []string{
+		"otel-traces-2025-01-30",
+		"otel-traces-2025-01-21",
+		"otel-traces-2025-01-20",
+		"otel-traces-2024-12-31",
+		"otel-traces-archive",
+		"other-index-2024-01-01",
+	}
+
+	got := ExpiredIndices(naming, indices, 10, now)
+	want := []string{"otel-traces-2024-12-31", "otel-traces-2025-01-20"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -17,6 +17,7 @@
 package opensearch
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -33,6 +34,11 @@ func ParseSpans(response *SearchResponse) []Span {
 	return spans
 }
 
+// ParseSpanDocument converts a span document, as stored in the trace indices, to a Span
+func ParseSpanDocument(source map[string]interface{}) Span {
+	return parseSpan(source)
+}
+
 // parseSpan extracts span information from a source document
 func parseSpan(source map[string]interface{}) Span {
 	span := Span{}
@@ -91,6 +97,9 @@ func parseSpan(source map[string]interface{}) Span {
 		} else if code, ok := status["code"].(float64); ok {
 			span.Status = fmt.Sprintf("%d", int(code))
 		}
+		if message, ok := status["message"].(string); ok {
+			span.StatusMessage = message
+		}
 	}
 
 	// Parse attributes
@@ -98,5 +107,307 @@ func parseSpan(source map[string]interface{}) Span {
 		span.Attributes = attributes
 	}
 
+	// Parse events
+	if events, ok := source["events"].([]interface{}); ok {
+		for _, e := range events {
+			if event, ok := e.(map[string]interface{}); ok {
+				span.Events = append(span.Events, parseSpanEvent(event))
+			}
+		}
+	}
+
+	// Parse links
+	if links, ok := source["links"].([]interface{}); ok {
+		for _, l := range links {
+			if link, ok := l.(map[string]interface{}); ok {
+				span.Links = append(span.Links, parseSpanLink(link))
+			}
+		}
+	}
+
 	return span
 }
+
+// parseSpanEvent extracts a span event from its source document
+func parseSpanEvent(source map[string]interface{}) SpanEvent {
+	event := SpanEvent{}
+	if name, ok := source["name"].(string); ok {
+		event.Name = name
+	}
+	if eventTime, ok := source["time"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, eventTime); err == nil {
+			event.Time = t
+		}
+	}
+	if attributes, ok := source["attributes"].(map[string]interface{}); ok {
+		event.Attributes = attributes
+	}
+	if event.Name == "exception" {
+		event.Exception = parseSpanException(event.Attributes)
+	}
+	return event
+}
+
+// parseSpanException extracts the exception.* attributes of an exception event
+func parseSpanException(attributes map[string]interface{}) *SpanException {
+	exception := &SpanException{}
+	if exceptionType, ok := attributes["exception.type"].(string); ok {
+		exception.Type = exceptionType
+	}
+	if message, ok := attributes["exception.message"].(string); ok {
+		exception.Message = message
+	}
+	if stacktrace, ok := attributes["exception.stacktrace"].(string); ok {
+		exception.Stacktrace = stacktrace
+	}
+	switch escaped := attributes["exception.escaped"].(type) {
+	case bool:
+		exception.Escaped = escaped
+	case string:
+		exception.Escaped = escaped == "true"
+	}
+	return exception
+}
+
+// parseSpanLink extracts a span link from its source document
+func parseSpanLink(source map[string]interface{}) SpanLink {
+	link := SpanLink{}
+	if traceID, ok := source["traceId"].(string); ok {
+		link.TraceID = traceID
+	}
+	if spanID, ok := source["spanId"].(string); ok {
+		link.SpanID = spanID
+	}
+	if traceState, ok := source["traceState"].(string); ok {
+		link.TraceState = traceState
+	}
+	if attributes, ok := source["attributes"].(map[string]interface{}); ok {
+		link.Attributes = attributes
+	}
+	return link
+}
+
+type sumAggregation struct {
+	Value float64 `json:"value"`
+}
+
+type tokenUsageAggregationResult struct {
+	AfterKey map[string]interface{} `json:"after_key,omitempty"`
+	Buckets  []struct {
+		Key          map[string]interface{} `json:"key"`
+		DocCount     int64                  `json:"doc_count"`
+		InputTokens  sumAggregation         `json:"input_tokens"`
+		OutputTokens sumAggregation         `json:"output_tokens"`
+	} `json:"buckets"`
+}
+
+// ParseTokenUsage extracts token usage buckets from a token usage aggregation response.
+// It returns the after_key to request the next page, which is nil once all buckets are read.
+func ParseTokenUsage(response *SearchResponse) ([]TokenUsage, map[string]interface{}, error) {
+	raw, ok := response.Aggregations[tokenUsageAggregation]
+	if !ok {
+		return []TokenUsage{}, nil, nil
+	}
+
+	var result tokenUsageAggregationResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode token usage aggregation: %w", err)
+	}
+
+	usage := make([]TokenUsage, 0, len(result.Buckets))
+	for _, bucket := range result.Buckets {
+		projectUid, _ := bucket.Key["projectUid"].(string)
+		componentUid, _ := bucket.Key["componentUid"].(string)
+		model, _ := bucket.Key["model"].(string)
+
+		inputTokens := int64(bucket.InputTokens.Value)
+		outputTokens := int64(bucket.OutputTokens.Value)
+		usage = append(usage, TokenUsage{
+			ProjectUid:   projectUid,
+			ComponentUid: componentUid,
+			Model:        model,
+			InputTokens:  inputTokens,
+			OutputTokens: outputTokens,
+			TotalTokens:  inputTokens + outputTokens,
+			SpanCount:    bucket.DocCount,
+		})
+	}
+
+	// A short page means there are no more buckets to fetch
+	if len(result.Buckets) < tokenUsagePageSize {
+		return usage, nil, nil
+	}
+	return usage, result.AfterKey, nil
+}
+
+type metricAggregation struct {
+	Value         *float64 `json:"value"`
+	ValueAsString string   `json:"value_as_string,omitempty"`
+}
+
+type traceIdsAggregationResult struct {
+	SumOtherDocCount int64 `json:"sum_other_doc_count"`
+	Buckets          []struct {
+		Key string `json:"key"`
+	} `json:"buckets"`
+}
+
+// traceIds returns the trace IDs of the buckets and whether more traces matched than were returned
+func (result traceIdsAggregationResult) traceIds() ([]string, bool) {
+	traceIDs := make([]string, 0, len(result.Buckets))
+	for _, bucket := range result.Buckets {
+		traceIDs = append(traceIDs, bucket.Key)
+	}
+	return traceIDs, result.SumOtherDocCount > 0
+}
+
+type sessionsAggregationResult struct {
+	Buckets []struct {
+		Key      string                    `json:"key"`
+		TraceIds traceIdsAggregationResult `json:"trace_ids"`
+	} `json:"buckets"`
+}
+
+// ParseSessions extracts the trace IDs of each session and the total number of sessions from a sessions aggregation response
+func ParseSessions(response *SearchResponse) ([]SessionTraceIds, int, error) {
+	sessions := []SessionTraceIds{}
+
+	totalCount := 0
+	if raw, ok := response.Aggregations[sessionCountAggregation]; ok {
+		var count metricAggregation
+		if err := json.Unmarshal(raw, &count); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode session count aggregation: %w", err)
+		}
+		if count.Value != nil {
+			totalCount = int(*count.Value)
+		}
+	}
+
+	raw, ok := response.Aggregations[sessionsAggregation]
+	if !ok {
+		return sessions, totalCount, nil
+	}
+
+	var result sessionsAggregationResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode sessions aggregation: %w", err)
+	}
+
+	for _, bucket := range result.Buckets {
+		traceIDs, truncated := bucket.TraceIds.traceIds()
+		sessions = append(sessions, SessionTraceIds{
+			SessionID: bucket.Key,
+			TraceIDs:  traceIDs,
+			Truncated: truncated,
+		})
+	}
+
+	// Cardinality is approximate, so never report fewer sessions than were returned
+	if totalCount < len(sessions) {
+		totalCount = len(sessions)
+	}
+
+	return sessions, totalCount, nil
+}
+
+// ParseSessionTraceIds extracts the trace IDs of a session from a session trace IDs aggregation response.
+// It also reports whether the session has more traces than were returned.
+func ParseSessionTraceIds(response *SearchResponse) ([]string, bool, error) {
+	raw, ok := response.Aggregations[traceIdsAggregation]
+	if !ok {
+		return []string{}, false, nil
+	}
+
+	var result traceIdsAggregationResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, false, fmt.Errorf("failed to decode trace IDs aggregation: %w", err)
+	}
+
+	traceIDs, truncated := result.traceIds()
+	return traceIDs, truncated, nil
+}
+
+type traceStatsAggregationResult struct {
+	Buckets []struct {
+		Key          string            `json:"key"`
+		DocCount     int64             `json:"doc_count"`
+		FirstStart   metricAggregation `json:"first_start"`
+		LastEnd      metricAggregation `json:"last_end"`
+		InputTokens  sumAggregation    `json:"input_tokens"`
+		OutputTokens sumAggregation    `json:"output_tokens"`
+	} `json:"buckets"`
+}
+
+// ParseTraceStats extracts the span count, time range and token usage of each trace from a trace stats aggregation response
+func ParseTraceStats(response *SearchResponse) (map[string]TraceStats, error) {
+	stats := map[string]TraceStats{}
+
+	raw, ok := response.Aggregations[traceStatsAggregation]
+	if !ok {
+		return stats, nil
+	}
+
+	var result traceStatsAggregationResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode trace stats aggregation: %w", err)
+	}
+
+	for _, bucket := range result.Buckets {
+		trace := TraceStats{
+			SpanCount:    bucket.DocCount,
+			InputTokens:  int64(bucket.InputTokens.Value),
+			OutputTokens: int64(bucket.OutputTokens.Value),
+		}
+
+		// Date metrics are returned as epoch milliseconds
+		if bucket.FirstStart.Value != nil {
+			trace.StartTime = time.UnixMilli(int64(*bucket.FirstStart.Value)).UTC()
+		}
+		if bucket.LastEnd.Value != nil {
+			trace.EndTime = time.UnixMilli(int64(*bucket.LastEnd.Value)).UTC()
+		}
+		stats[bucket.Key] = trace
+	}
+
+	return stats, nil
+}
+
+// BuildSessionOverview sums the stats of the traces of a session into a session overview.
+// Traces without stats, such as traces whose spans have all expired, are still counted as turns.
+func BuildSessionOverview(session SessionTraceIds, stats map[string]TraceStats) SessionOverview {
+	overview := SessionOverview{
+		SessionID: session.SessionID,
+		TurnCount: len(session.TraceIDs),
+		Truncated: session.Truncated,
+	}
+
+	var start, end time.Time
+	for _, traceID := range session.TraceIDs {
+		trace, ok := stats[traceID]
+		if !ok {
+			continue
+		}
+		overview.SpanCount += trace.SpanCount
+		overview.InputTokens += trace.InputTokens
+		overview.OutputTokens += trace.OutputTokens
+		if !trace.StartTime.IsZero() && (start.IsZero() || trace.StartTime.Before(start)) {
+			start = trace.StartTime
+		}
+		if trace.EndTime.After(end) {
+			end = trace.EndTime
+		}
+	}
+
+	if !start.IsZero() {
+		overview.StartTime = start.Format(time.RFC3339Nano)
+	}
+	if !end.IsZero() {
+		overview.EndTime = end.Format(time.RFC3339Nano)
+	}
+	if !start.IsZero() && !end.IsZero() {
+		overview.DurationInNanos = end.Sub(start).Nanoseconds()
+	}
+	overview.TotalTokens = overview.InputTokens + overview.OutputTokens
+
+	return overview
+}
@@ -16,54 +16,6 @@
 
 package opensearch
 
-import (
-	"fmt"
-	"time"
-)
-
-// GetIndicesForTimeRange generates index names for the given time range
-// Returns indices in format: otel-traces-YYYY-MM-DD
-func GetIndicesForTimeRange(startTime, endTime string) ([]string, error) {
-	if startTime == "" || endTime == "" {
-		return nil, fmt.Errorf("start time and end time are required")
-	}
-
-	// Parse the time strings (expecting RFC3339 format)
-	start, err := time.Parse(time.RFC3339, startTime)
-	if err != nil {
-		return nil, fmt.Errorf("invalid start time format: %w", err)
-	}
-
-	end, err := time.Parse(time.RFC3339, endTime)
-	if err != nil {
-		return nil, fmt.Errorf("invalid end time format: %w", err)
-	}
-
-	// Ensure start is before end
-	if start.After(end) {
-		return nil, fmt.Errorf("start time must be before end time")
-	}
-
-	// Generate indices for each day in the range
-	indices := []string{}
-	indexMap := make(map[string]bool) // To avoid duplicates
-
-	// Iterate through each day from start to end
-	currentDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
-	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
-
-	for !currentDay.After(endDay) {
-		indexName := fmt.Sprintf("otel-traces-%04d-%02d-%02d", currentDay.Year(), currentDay.Month(), currentDay.Day())
-		if !indexMap[indexName] {
-			indices = append(indices, indexName)
-			indexMap[indexName] = true
-		}
-		currentDay = currentDay.AddDate(0, 0, 1) // Add one day
-	}
-
-	return indices, nil
-}
-
 // BuildTraceQuery builds an OpenSearch query for traces
 func BuildTraceQuery(params TraceQueryParams) map[string]interface{} {
 	// Build the must conditions
@@ -198,3 +150,443 @@ func BuildTraceByIdAndServiceQuery(params TraceByIdAndServiceParams) map[string]
 
 	return query
 }
+
+// GenAI semantic convention attributes used for token usage reporting. Older instrumentations
+// report prompt/completion tokens while newer ones report input/output tokens. Some SDKs report
+// both, so the legacy attribute of a span is only counted when it lacks the newer one.
+const (
+	AttributeGenAIRequestModel     = "gen_ai.request.model"
+	AttributeGenAIInputTokens      = "gen_ai.usage.input_tokens"
+	AttributeGenAIOutputTokens     = "gen_ai.usage.output_tokens"
+	AttributeGenAIPromptTokens     = "gen_ai.usage.prompt_tokens"
+	AttributeGenAICompletionTokens = "gen_ai.usage.completion_tokens"
+
+	tokenUsageAggregation = "token_usage"
+	tokenUsagePageSize    = 1000
+)
+
+// TokenUsageAttributes lists the attributes a span can report token counts with
+var TokenUsageAttributes = []string{
+	AttributeGenAIInputTokens,
+	AttributeGenAIOutputTokens,
+	AttributeGenAIPromptTokens,
+	AttributeGenAICompletionTokens,
+}
+
+// tokenSumScript sums an attribute per span, falling back to the legacy attribute when the span lacks it
+const tokenSumScript = `if (doc.containsKey(params.field) && doc[params.field].size() > 0) { return doc[params.field].value; }
+if (doc.containsKey(params.legacyField) && doc[params.legacyField].size() > 0) { return doc[params.legacyField].value; }
+return 0;`
+
+// tokenUsageSumAggregations returns the sub-aggregations that sum the input and output tokens of spans
+func tokenUsageSumAggregations() map[string]interface{} {
+	return map[string]interface{}{
+		"input_tokens":  tokenSumAggregation(AttributeGenAIInputTokens, AttributeGenAIPromptTokens),
+		"output_tokens": tokenSumAggregation(AttributeGenAIOutputTokens, AttributeGenAICompletionTokens),
+	}
+}
+
+func tokenSumAggregation(field string, legacyField string) map[string]interface{} {
+	return map[string]interface{}{
+		"sum": map[string]interface{}{
+			"script": map[string]interface{}{
+				"lang":   "painless",
+				"source": tokenSumScript,
+				"params": map[string]interface{}{
+					"field":       "attributes." + field,
+					"legacyField": "attributes." + legacyField,
+				},
+			},
+		},
+	}
+}
+
+// BuildTokenUsageQuery builds a composite aggregation that sums token usage per project, component and model.
+// afterKey is the after_key of the previous page and is nil for the first page.
+func BuildTokenUsageQuery(params TokenUsageParams, afterKey map[string]interface{}) map[string]interface{} {
+	mustConditions := []map[string]interface{}{}
+
+	// Add project UID filter
+	if len(params.ProjectUids) > 0 {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"resource.openchoreo.dev/project-uid": params.ProjectUids,
+			},
+		})
+	}
+
+	// Add component UID filter
+	if len(params.ComponentUids) > 0 {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": params.ComponentUids,
+			},
+		})
+	}
+
+	// Add environment UID filter
+	if params.EnvironmentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
+			},
+		})
+	}
+
+	// Add time range filter
+	if params.StartTime != "" && params.EndTime != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"range": map[string]interface{}{
+				"startTime": map[string]interface{}{
+					"gte": params.StartTime,
+					"lte": params.EndTime,
+				},
+			},
+		})
+	}
+
+	// Only spans that carry token counts contribute to usage
+	shouldConditions := make([]map[string]interface{}, 0, len(TokenUsageAttributes))
+	for _, field := range TokenUsageAttributes {
+		shouldConditions = append(shouldConditions, map[string]interface{}{
+			"exists": map[string]interface{}{
+				"field": "attributes." + field,
+			},
+		})
+	}
+	subAggregations := tokenUsageSumAggregations()
+
+	composite := map[string]interface{}{
+		"size": tokenUsagePageSize,
+		"sources": []map[string]interface{}{
+			{"projectUid": map[string]interface{}{"terms": map[string]interface{}{"field": "resource.openchoreo.dev/project-uid", "missing_bucket": true}}},
+			{"componentUid": map[string]interface{}{"terms": map[string]interface{}{"field": "resource.openchoreo.dev/component-uid", "missing_bucket": true}}},
+			{"model": map[string]interface{}{"terms": map[string]interface{}{"field": "attributes." + AttributeGenAIRequestModel, "missing_bucket": true}}},
+		},
+	}
+	if len(afterKey) > 0 {
+		composite["after"] = afterKey
+	}
+
+	query := map[string]interface{}{
+		"size": 0,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":                 mustConditions,
+				"should":               shouldConditions,
+				"minimum_should_match": 1,
+			},
+		},
+		"aggs": map[string]interface{}{
+			tokenUsageAggregation: map[string]interface{}{
+				"composite": composite,
+				"aggs":      subAggregations,
+			},
+		},
+	}
+
+	return query
+}
+
+const (
+	sessionsAggregation     = "sessions"
+	sessionCountAggregation = "session_count"
+	traceIdsAggregation     = "trace_ids"
+	traceStatsAggregation   = "traces"
+)
+
+// SessionMaxTraces is the maximum number of traces (turns) resolved for a single session
+const SessionMaxTraces = 1000
+
+// MaxTraceSpans is the maximum number of spans fetched for a set of traces, such as the traces of a session
+const MaxTraceSpans = 50000
+
+// BuildSessionsQuery builds an aggregation that groups the spans carrying the session key attribute by session,
+// most recently active first, and collects the IDs of the traces of each session.
+// Buckets up to offset+limit are requested so that the caller can paginate over them.
+func BuildSessionsQuery(params SessionQueryParams, sessionKeyAttribute string) map[string]interface{} {
+	sessionField := "attributes." + sessionKeyAttribute
+
+	mustConditions := []map[string]interface{}{
+		{
+			"exists": map[string]interface{}{
+				"field": sessionField,
+			},
+		},
+	}
+
+	// Add component UID filter
+	if params.ComponentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": params.ComponentUid,
+			},
+		})
+	}
+
+	// Add environment UID filter
+	if params.EnvironmentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
+			},
+		})
+	}
+
+	// Add time range filter
+	if params.StartTime != "" && params.EndTime != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"range": map[string]interface{}{
+				"startTime": map[string]interface{}{
+					"gte": params.StartTime,
+					"lte": params.EndTime,
+				},
+			},
+		})
+	}
+
+	// Set default limit if not provided
+	limit := params.Limit
+	if limit == 0 {
+		limit = 10
+	}
+
+	offset := params.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := map[string]interface{}{
+		"size": 0,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"aggs": map[string]interface{}{
+			sessionCountAggregation: map[string]interface{}{
+				"cardinality": map[string]interface{}{
+					"field": sessionField,
+				},
+			},
+			sessionsAggregation: map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": sessionField,
+					"size":  offset + limit,
+					"order": map[string]string{
+						"last_activity": "desc",
+					},
+				},
+				"aggs": map[string]interface{}{
+					"last_activity":     map[string]interface{}{"max": map[string]interface{}{"field": "startTime"}},
+					traceIdsAggregation: traceIdsTermsAggregation(),
+				},
+			},
+		},
+	}
+
+	return query
+}
+
+// BuildTraceStatsQuery builds an aggregation that counts the spans, time range and token usage of each of the
+// given traces. Every span of a trace is included, whether or not it carries the session key attribute.
+func BuildTraceStatsQuery(params SessionQueryParams, traceIDs []string) map[string]interface{} {
+	mustConditions := traceScopeConditions(params.ComponentUid, params.EnvironmentUid, traceIDs)
+
+	subAggregations := map[string]interface{}{
+		"first_start": map[string]interface{}{"min": map[string]interface{}{"field": "startTime"}},
+		"last_end":    map[string]interface{}{"max": map[string]interface{}{"field": "endTime"}},
+	}
+	for name, aggregation := range tokenUsageSumAggregations() {
+		subAggregations[name] = aggregation
+	}
+
+	query := map[string]interface{}{
+		"size": 0,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"aggs": map[string]interface{}{
+			traceStatsAggregation: map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "traceId",
+					"size":  len(traceIDs),
+				},
+				"aggs": subAggregations,
+			},
+		},
+	}
+
+	return query
+}
+
+// BuildSessionTraceIdsQuery builds an aggregation that collects the IDs of the traces in which any span
+// carries the session ID
+func BuildSessionTraceIdsQuery(params SessionTracesParams, sessionKeyAttribute string) map[string]interface{} {
+	mustConditions := []map[string]interface{}{
+		{
+			"term": map[string]interface{}{
+				"attributes." + sessionKeyAttribute: params.SessionID,
+			},
+		},
+	}
+
+	// Add component UID filter
+	if params.ComponentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": params.ComponentUid,
+			},
+		})
+	}
+
+	// Add environment UID filter
+	if params.EnvironmentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
+			},
+		})
+	}
+
+	query := map[string]interface{}{
+		"size": 0,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"aggs": map[string]interface{}{
+			traceIdsAggregation: traceIdsTermsAggregation(),
+		},
+	}
+
+	return query
+}
+
+// BuildTraceSpansQuery builds a query that pages through all spans of the given traces, limited to the time range
+// when one is given. Spans are sorted by traceId with spanId as a tiebreaker for search_after. searchAfter is the
+// sort value of the last hit of the previous page and is nil for the first page.
+func BuildTraceSpansQuery(params TraceSpansParams, searchAfter []interface{}) map[string]interface{} {
+	mustConditions := traceScopeConditions(params.ComponentUid, params.EnvironmentUid, params.TraceIDs)
+
+	// Add time range filter
+	if params.StartTime != "" && params.EndTime != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"range": map[string]interface{}{
+				"startTime": map[string]interface{}{
+					"gte": params.StartTime,
+					"lte": params.EndTime,
+				},
+			},
+		})
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size": ExportPageSize,
+		"sort": []map[string]interface{}{
+			{"traceId": map[string]string{"order": "asc"}},
+			{"spanId": map[string]string{"order": "asc"}},
+		},
+	}
+	if searchAfter != nil {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+// traceIdsTermsAggregation returns a terms aggregation over trace IDs, capped at SessionMaxTraces
+func traceIdsTermsAggregation() map[string]interface{} {
+	return map[string]interface{}{
+		"terms": map[string]interface{}{
+			"field": "traceId",
+			"size":  SessionMaxTraces,
+		},
+	}
+}
+
+// traceScopeConditions returns the conditions matching the spans of the given traces of a component
+func traceScopeConditions(componentUid string, environmentUid string, traceIDs []string) []map[string]interface{} {
+	mustConditions := []map[string]interface{}{
+		{
+			"terms": map[string]interface{}{
+				"traceId": traceIDs,
+			},
+		},
+	}
+
+	// Add component UID filter
+	if componentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": componentUid,
+			},
+		})
+	}
+
+	// Add environment UID filter
+	if environmentUid != "" {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/environment-uid": environmentUid,
+			},
+		})
+	}
+
+	return mustConditions
+}
+
+// ExportPageSize is the number of spans fetched per page while exporting traces
+const ExportPageSize = 1000
+
+// BuildExportTracesQuery builds a query that pages through the spans of a component in a time range.
+// Spans are sorted by traceId so that all spans of a trace arrive together, with spanId as a tiebreaker
+// for search_after. searchAfter is the sort value of the last hit of the previous page and is nil for the first page.
+func BuildExportTracesQuery(params ExportTracesParams, searchAfter []interface{}) map[string]interface{} {
+	mustConditions := []map[string]interface{}{
+		{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": params.ComponentUid,
+			},
+		},
+		{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
+			},
+		},
+		{
+			"range": map[string]interface{}{
+				"startTime": map[string]interface{}{
+					"gte": params.StartTime,
+					"lte": params.EndTime,
+				},
+			},
+		},
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size": ExportPageSize,
+		"sort": []map[string]interface{}{
+			{"traceId": map[string]string{"order": "asc"}},
+			{"spanId": map[string]string{"order": "asc"}},
+		},
+	}
+	if searchAfter != nil {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// RetentionJob removes daily trace indices once they are older than the retention period.
+// When a snapshot repository is configured each index is snapshotted before it is deleted,
+// and an index whose snapshot fails is kept so that the next run retries it.
+type RetentionJob struct {
+	client  *Client
+	indices *IndexNaming
+	config  *config.RetentionConfig
+}
+
+// NewRetentionJob creates a retention job for the trace indices of the given client
+func NewRetentionJob(client *Client, cfg *config.RetentionConfig) *RetentionJob {
+	return &RetentionJob{
+		client:  client,
+		indices: NewIndexNaming(client.config),
+		config:  cfg,
+	}
+}
+
+// Start runs the retention job immediately and then at the configured interval until ctx is done
+func (j *RetentionJob) Start(ctx context.Context) {
+	slog.Info("Trace retention enabled", "retentionDays", j.config.Days, "interval", j.config.Interval.String())
+
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Run(ctx, time.Now()); err != nil {
+			slog.ErrorContext(ctx, "Trace retention run failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run removes the daily trace indices that are past retention at the given time
+func (j *RetentionJob) Run(ctx context.Context, now time.Time) error {
+	indices, err := j.client.ListIndices(ctx, j.indices.Wildcard())
+	if err != nil {
+		return fmt.Errorf("failed to list trace indices: %w", err)
+	}
+
+	expired := ExpiredIndices(j.indices, indices, j.config.Days, now)
+	if len(expired) == 0 {
+		return nil
+	}
+	slog.InfoContext(ctx, "Removing trace indices past retention", "count", len(expired))
+
+	failed := 0
+	for _, index := range expired {
+		if err := j.remove(ctx, index, now); err != nil {
+			slog.ErrorContext(ctx, "Failed to remove trace index", "index", index, "error", err)
+			failed++
+			continue
+		}
+		slog.InfoContext(ctx, "Removed trace index", "index", index)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to remove %d of %d trace indices", failed, len(expired))
+	}
+	return nil
+}
+
+func (j *RetentionJob) remove(ctx context.Context, index string, now time.Time) error {
+	if j.config.SnapshotRepository != "" {
+		snapshot := fmt.Sprintf("%s-%s", index, now.UTC().Format("20060102t150405"))
+		if err := j.client.SnapshotIndex(ctx, j.config.SnapshotRepository, snapshot, index); err != nil {
+			return fmt.Errorf("failed to snapshot index: %w", err)
+		}
+	}
+	return j.client.DeleteIndex(ctx, index)
+}
+
+// ExpiredIndices returns the daily trace indices whose whole day is more than retentionDays before now, oldest first.
+// Indices that are not named by the daily index pattern are never expired.
+func ExpiredIndices(naming *IndexNaming, indices []string, retentionDays int, now time.Time) []string {
+	cutoff := utcDay(now).AddDate(0, 0, -retentionDays)
+
+	expired := []string{}
+	for _, index := range indices {
+		day, ok := naming.IndexDate(index)
+		if ok && day.Before(cutoff) {
+			expired = append(expired, index)
+		}
+	}
+	sort.Slice(expired, func(i, k int) bool {
+		dayI, _ := naming.IndexDate(expired[i])
+		dayK, _ := naming.IndexDate(expired[k])
+		return dayI.Before(dayK)
+	})
+	return expired
+}
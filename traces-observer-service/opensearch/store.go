@@ -0,0 +1,327 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// traceByIdLookbackDays is how far back trace lookups by ID search when no time range is known
+const traceByIdLookbackDays = 7
+
+// traceIdsBatchSize is the number of trace IDs filtered on per query when aggregating or fetching a set of traces
+const traceIdsBatchSize = 10000
+
+// Store serves trace queries from the daily OpenSearch trace indices
+type Store struct {
+	client  *Client
+	indices *IndexNaming
+}
+
+// NewStore creates a trace store backed by the given OpenSearch client, using the index naming of its configuration
+func NewStore(client *Client) *Store {
+	return &Store{
+		client:  client,
+		indices: NewIndexNaming(client.config),
+	}
+}
+
+// SearchSpans retrieves the spans of a component in a time range
+func (s *Store) SearchSpans(ctx context.Context, params TraceQueryParams) ([]Span, error) {
+	indices, err := s.indices.IndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	slog.DebugContext(ctx, "Searching indices for traces", "indices", indices)
+
+	response, err := s.client.Search(ctx, indices, BuildTraceQuery(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search trace overviews: %w", err)
+	}
+
+	return ParseSpans(response), nil
+}
+
+// GetTraceSpans retrieves the spans of a trace
+func (s *Store) GetTraceSpans(ctx context.Context, params TraceByIdAndServiceParams) ([]Span, error) {
+	// For trace by ID queries, we need to search across a broader time range
+	// Use current day and previous 7 days as default
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -traceByIdLookbackDays)
+	indices, err := s.indices.IndicesForTimeRange(
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	slog.DebugContext(ctx, "Searching indices for trace ID", "indices", indices)
+
+	response, err := s.client.Search(ctx, indices, BuildTraceByIdAndServiceQuery(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+
+	return ParseSpans(response), nil
+}
+
+// GetTokenUsage sums GenAI token usage per project, component and model
+func (s *Store) GetTokenUsage(ctx context.Context, params TokenUsageParams) ([]TokenUsage, error) {
+	indices, err := s.indices.IndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	slog.DebugContext(ctx, "Searching indices for token usage", "indices", indices)
+
+	// Page through the composite aggregation until all buckets are read
+	usage := []TokenUsage{}
+	var afterKey map[string]interface{}
+	for {
+		response, err := s.client.Search(ctx, indices, BuildTokenUsageQuery(params, afterKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to search token usage: %w", err)
+		}
+
+		page, nextKey, err := ParseTokenUsage(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse token usage: %w", err)
+		}
+		usage = append(usage, page...)
+
+		if nextKey == nil {
+			break
+		}
+		afterKey = nextKey
+	}
+
+	return usage, nil
+}
+
+// ListSessions retrieves the sessions of a component ordered by last activity, along with the total number of sessions.
+// The trace IDs of each session are resolved first, so that every span of those traces is counted even when
+// only some of them carry the session key attribute.
+func (s *Store) ListSessions(ctx context.Context, params SessionQueryParams, sessionKeyAttribute string) ([]SessionOverview, int, error) {
+	indices, err := s.indices.IndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	slog.DebugContext(ctx, "Searching indices for sessions", "indices", indices)
+
+	response, err := s.client.Search(ctx, indices, BuildSessionsQuery(params, sessionKeyAttribute))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search sessions: %w", err)
+	}
+
+	sessions, totalCount, err := ParseSessions(response)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse sessions: %w", err)
+	}
+
+	traceIDs := []string{}
+	for _, session := range sessions {
+		traceIDs = append(traceIDs, session.TraceIDs...)
+	}
+
+	// Aggregate the spans of the session traces in batches to keep the terms filter bounded
+	stats := map[string]TraceStats{}
+	for start := 0; start < len(traceIDs); start += traceIdsBatchSize {
+		end := min(start+traceIdsBatchSize, len(traceIDs))
+		response, err := s.client.Search(ctx, indices, BuildTraceStatsQuery(params, traceIDs[start:end]))
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to search session traces: %w", err)
+		}
+
+		batch, err := ParseTraceStats(response)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to parse session traces: %w", err)
+		}
+		for traceID, trace := range batch {
+			stats[traceID] = trace
+		}
+	}
+
+	overviews := make([]SessionOverview, 0, len(sessions))
+	for _, session := range sessions {
+		overviews = append(overviews, BuildSessionOverview(session, stats))
+	}
+	return overviews, totalCount, nil
+}
+
+// GetSessionSpans retrieves all spans of the traces of a session in chronological order. The session's trace IDs
+// are resolved first, so that spans without the session key attribute are included. It also reports whether
+// the session has more traces or spans than were returned.
+func (s *Store) GetSessionSpans(ctx context.Context, params SessionTracesParams, sessionKeyAttribute string) ([]Span, bool, error) {
+	indices, err := s.indices.IndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	slog.DebugContext(ctx, "Searching indices for session traces", "indices", indices)
+
+	response, err := s.client.Search(ctx, indices, BuildSessionTraceIdsQuery(params, sessionKeyAttribute))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to search session traces: %w", err)
+	}
+
+	traceIDs, truncated, err := ParseSessionTraceIds(response)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse session traces: %w", err)
+	}
+
+	spans, spansTruncated, err := s.searchTraceSpans(ctx, indices, TraceSpansParams{
+		TraceIDs:       traceIDs,
+		ComponentUid:   params.ComponentUid,
+		EnvironmentUid: params.EnvironmentUid,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return spans, truncated || spansTruncated, nil
+}
+
+// GetSpansOfTraces retrieves all spans of the given traces of a component in chronological order.
+// It also reports whether the traces have more spans than were returned.
+func (s *Store) GetSpansOfTraces(ctx context.Context, params TraceSpansParams) ([]Span, bool, error) {
+	indices, err := s.indices.IndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	slog.DebugContext(ctx, "Searching indices for traces by ID", "indices", indices, "traceCount", len(params.TraceIDs))
+
+	return s.searchTraceSpans(ctx, indices, params)
+}
+
+// searchTraceSpans pages through the spans of the given traces with search_after, up to MaxTraceSpans of them,
+// and returns them in chronological order along with whether spans were left out
+func (s *Store) searchTraceSpans(ctx context.Context, indices []string, params TraceSpansParams) ([]Span, bool, error) {
+	spans := []Span{}
+	traceIDs := params.TraceIDs
+	for start := 0; start < len(traceIDs); start += traceIdsBatchSize {
+		params.TraceIDs = traceIDs[start:min(start+traceIdsBatchSize, len(traceIDs))]
+
+		var searchAfter []interface{}
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, false, err
+			}
+
+			response, err := s.client.Search(ctx, indices, BuildTraceSpansQuery(params, searchAfter))
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to search trace spans: %w", err)
+			}
+			spans = append(spans, ParseSpans(response)...)
+
+			hits := response.Hits.Hits
+			if len(hits) < ExportPageSize {
+				break
+			}
+			if len(spans) >= MaxTraceSpans {
+				sortByStartTime(spans)
+				return spans, true, nil
+			}
+			searchAfter = hits[len(hits)-1].Sort
+		}
+	}
+
+	sortByStartTime(spans)
+	return spans, false, nil
+}
+
+// sortByStartTime orders spans chronologically
+func sortByStartTime(spans []Span) {
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].StartTime.Before(spans[j].StartTime)
+	})
+}
+
+// ScanSpans pages through the spans of a component in a time range, ordered by traceId and spanId,
+// and calls fn for each span. Scanning stops at the first error returned by fn.
+func (s *Store) ScanSpans(ctx context.Context, params ExportTracesParams, fn func(span Span) error) error {
+	indices, err := s.indices.IndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	var searchAfter []interface{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		response, err := s.client.Search(ctx, indices, BuildExportTracesQuery(params, searchAfter))
+		if err != nil {
+			return fmt.Errorf("failed to search traces: %w", err)
+		}
+
+		for i, span := range ParseSpans(response) {
+			if err := fn(span); err != nil {
+				return err
+			}
+			searchAfter = response.Hits.Hits[i].Sort
+		}
+
+		if len(response.Hits.Hits) < ExportPageSize {
+			return nil
+		}
+	}
+}
+
+// WriteSpans indexes span documents into the daily index of their start time
+func (s *Store) WriteSpans(ctx context.Context, documents []map[string]interface{}) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, document := range documents {
+		index := s.indices.IndexForTime(time.Now())
+		if startTime, ok := document["startTime"].(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, startTime); err == nil {
+				index = s.indices.IndexForTime(t)
+			}
+		}
+
+		action := map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index": index,
+			},
+		}
+		if err := encoder.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		if err := encoder.Encode(document); err != nil {
+			return fmt.Errorf("failed to encode span document: %w", err)
+		}
+	}
+
+	if err := s.client.Bulk(ctx, &body); err != nil {
+		return fmt.Errorf("failed to write spans: %w", err)
+	}
+	slog.DebugContext(ctx, "Wrote spans", "count", len(documents))
+	return nil
+}
+
+// HealthCheck checks if OpenSearch is accessible
+func (s *Store) HealthCheck(ctx context.Context) error {
+	return s.client.HealthCheck(ctx)
+}
@@ -16,7 +16,10 @@
 
 package opensearch
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // TraceQueryParams holds parameters for trace queries
 type TraceQueryParams struct {
@@ -27,6 +30,17 @@ type TraceQueryParams struct {
 	Limit          int
 	Offset         int
 	SortOrder      string
+	Attributes     map[string]string // Only traces whose root span has all of these attribute values
+	TraceIDs       []string          // Only these traces, all of whose spans are fetched
+}
+
+// TraceSpansParams holds parameters for fetching all spans of a set of traces
+type TraceSpansParams struct {
+	TraceIDs       []string
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
 }
 
 // TraceByIdAndServiceParams holds parameters for querying by both traceId and componentUid
@@ -38,6 +52,42 @@ type TraceByIdAndServiceParams struct {
 	Limit          int
 }
 
+// SessionQueryParams holds parameters for session list queries
+type SessionQueryParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Limit          int
+	Offset         int
+}
+
+// SessionTracesParams holds parameters for querying the traces of a single session
+type SessionTracesParams struct {
+	SessionID      string
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+}
+
+// TokenUsageParams holds parameters for token usage aggregation queries
+type TokenUsageParams struct {
+	ProjectUids    []string
+	ComponentUids  []string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+}
+
+// ExportTracesParams holds parameters for exporting all traces of a component in a time range
+type ExportTracesParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+}
+
 // Span represents a single trace span
 type Span struct {
 	TraceID         string                 `json:"traceId"`
@@ -50,8 +100,35 @@ type Span struct {
 	DurationInNanos int64                  `json:"durationInNanos"` // in nanoseconds
 	Kind            string                 `json:"kind,omitempty"`
 	Status          string                 `json:"status,omitempty"`
+	StatusMessage   string                 `json:"statusMessage,omitempty"`
 	Attributes      map[string]interface{} `json:"attributes,omitempty"`
 	Resource        map[string]interface{} `json:"resource,omitempty"`
+	Events          []SpanEvent            `json:"events,omitempty"`
+	Links           []SpanLink             `json:"links,omitempty"`
+}
+
+// SpanEvent represents a timestamped event recorded on a span
+type SpanEvent struct {
+	Name       string                 `json:"name"`
+	Time       time.Time              `json:"time"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Exception  *SpanException         `json:"exception,omitempty"` // Set for events named "exception"
+}
+
+// SpanException holds the exception details of an exception event, as defined by the OTEL semantic conventions
+type SpanException struct {
+	Type       string `json:"type,omitempty"`
+	Message    string `json:"message,omitempty"`
+	Stacktrace string `json:"stacktrace,omitempty"`
+	Escaped    bool   `json:"escaped,omitempty"`
+}
+
+// SpanLink represents a link from a span to a span in the same or another trace
+type SpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	TraceState string                 `json:"traceState,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // TraceResponse represents the response for trace queries
@@ -69,6 +146,26 @@ type TraceDetailResponse struct {
 	Services   []string `json:"services"` // List of services involved
 }
 
+// SpanNode is a span placed in the span tree of its trace, with timing derived for waterfall rendering
+type SpanNode struct {
+	Span
+	Depth           int         `json:"depth"`            // 0 for root spans
+	OffsetInNanos   int64       `json:"offsetInNanos"`    // Start time relative to the start of the trace
+	SelfTimeInNanos int64       `json:"selfTimeInNanos"`  // Time not covered by any child span
+	CriticalPath    bool        `json:"criticalPath"`     // Whether the span is on the critical path of the trace
+	Orphan          bool        `json:"orphan,omitempty"` // The parent span is not part of the trace
+	Children        []*SpanNode `json:"children,omitempty"`
+}
+
+// TraceTreeResponse represents a trace as a tree of spans
+type TraceTreeResponse struct {
+	TraceID         string      `json:"traceId"`
+	StartTime       time.Time   `json:"startTime"`
+	DurationInNanos int64       `json:"durationInNanos"`
+	Roots           []*SpanNode `json:"roots"` // The root span followed by any orphan spans
+	TotalCount      int         `json:"totalCount"`
+}
+
 // TraceOverview represents a single trace overview with root span info
 type TraceOverview struct {
 	TraceID            string                 `json:"traceId"`
@@ -85,6 +182,67 @@ type TraceOverview struct {
 type TraceOverviewResponse struct {
 	Traces     []TraceOverview `json:"traces"`
 	TotalCount int             `json:"totalCount"`
+	Truncated  bool            `json:"truncated"` // Set when the requested traces have more spans than were fetched
+}
+
+// SessionOverview represents a conversation made up of one trace per turn
+type SessionOverview struct {
+	SessionID       string `json:"sessionId"`
+	TurnCount       int    `json:"turnCount"`
+	SpanCount       int64  `json:"spanCount"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	DurationInNanos int64  `json:"durationInNanos"` // From the first span start to the last span end
+	InputTokens     int64  `json:"inputTokens"`
+	OutputTokens    int64  `json:"outputTokens"`
+	TotalTokens     int64  `json:"totalTokens"`
+	Truncated       bool   `json:"truncated"` // Set when the session has more traces than were counted
+}
+
+// SessionTraceIds holds the IDs of the traces of a session
+type SessionTraceIds struct {
+	SessionID string
+	TraceIDs  []string
+	Truncated bool // Set when the session has more traces than were resolved
+}
+
+// TraceStats holds the span count, time range and token usage of a trace
+type TraceStats struct {
+	SpanCount    int64
+	StartTime    time.Time
+	EndTime      time.Time
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// SessionOverviewResponse represents the response for session list queries
+type SessionOverviewResponse struct {
+	Sessions   []SessionOverview `json:"sessions"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// SessionTracesResponse represents the traces of a session in chronological order
+type SessionTracesResponse struct {
+	SessionID  string          `json:"sessionId"`
+	Traces     []TraceOverview `json:"traces"`
+	TotalCount int             `json:"totalCount"`
+	Truncated  bool            `json:"truncated"` // Set when the session has more traces or spans than were returned
+}
+
+// TokenUsage holds the summed GenAI token counts for one project, component and model combination
+type TokenUsage struct {
+	ProjectUid   string `json:"projectUid"`
+	ComponentUid string `json:"componentUid"`
+	Model        string `json:"model"`
+	InputTokens  int64  `json:"inputTokens"`
+	OutputTokens int64  `json:"outputTokens"`
+	TotalTokens  int64  `json:"totalTokens"`
+	SpanCount    int64  `json:"spanCount"`
+}
+
+// TokenUsageResponse represents the response for token usage queries
+type TokenUsageResponse struct {
+	Usage []TokenUsage `json:"usage"`
 }
 
 // SearchResponse represents OpenSearch search response
@@ -95,6 +253,8 @@ type SearchResponse struct {
 		} `json:"total"`
 		Hits []struct {
 			Source map[string]interface{} `json:"_source"`
+			Sort   []interface{}          `json:"sort,omitempty"`
 		} `json:"hits"`
 	} `json:"hits"`
+	Aggregations map[string]json.RawMessage `json:"aggregations,omitempty"`
 }
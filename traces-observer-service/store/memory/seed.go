@@ -0,0 +1,99 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/export"
+)
+
+// seedFileExtensions are the file extensions loaded when seeding from a directory
+var seedFileExtensions = map[string]bool{
+	".json":   true,
+	".jsonl":  true,
+	".ndjson": true,
+}
+
+// LoadOTLPPath seeds the store from an OTLP/JSON file, or from every JSON file in a directory
+func (s *Store) LoadOTLPPath(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to read seed path: %w", err)
+	}
+	if !info.IsDir() {
+		return s.LoadOTLPFile(path)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("failed to read seed directory: %w", err)
+	}
+	files := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && seedFileExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		if err := s.LoadOTLPFile(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadOTLPFile seeds the store from a file holding one OTLP/JSON TracesData object, or one per line
+// as written by the bulk trace export
+func (s *Store) LoadOTLPFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open seed file: %w", err)
+	}
+	defer file.Close()
+
+	count := 0
+	decoder := json.NewDecoder(file)
+	for {
+		var data export.OTLPTracesData
+		if err := decoder.Decode(&data); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("failed to decode seed file %s: %w", path, err)
+		}
+
+		documents := export.DocumentsFromOTLP(data)
+		if err := s.AddDocuments(documents); err != nil {
+			return fmt.Errorf("failed to load seed file %s: %w", path, err)
+		}
+		count += len(documents)
+	}
+
+	slog.Info("Loaded spans", "count", count, "path", path)
+	return nil
+}
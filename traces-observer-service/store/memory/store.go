@@ -0,0 +1,462 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package memory provides a TraceStore that keeps spans in memory, for local development and tests
+// without an OpenSearch cluster. Spans can be seeded from OTLP/JSON files.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/store"
+)
+
+// Resource attributes spans are filtered by, matching the fields queried in OpenSearch
+const (
+	resourceComponentUid   = "openchoreo.dev/component-uid"
+	resourceEnvironmentUid = "openchoreo.dev/environment-uid"
+	resourceProjectUid     = "openchoreo.dev/project-uid"
+)
+
+// Store keeps spans in memory and answers trace queries by filtering them
+type Store struct {
+	mu    sync.RWMutex
+	spans []opensearch.Span
+}
+
+var _ store.TraceStore = (*Store)(nil)
+
+// NewStore creates an empty in-memory trace store
+func NewStore() *Store {
+	return &Store{}
+}
+
+// Add adds spans to the store
+func (s *Store) Add(spans ...opensearch.Span) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spans = append(s.spans, spans...)
+}
+
+// AddDocuments adds spans given as span documents in the layout stored in OpenSearch
+func (s *Store) AddDocuments(documents []map[string]interface{}) error {
+	spans := make([]opensearch.Span, 0, len(documents))
+	for _, document := range documents {
+		// Round trip through JSON so that values have the same types as documents read from OpenSearch
+		data, err := json.Marshal(document)
+		if err != nil {
+			return fmt.Errorf("failed to encode span document: %w", err)
+		}
+		var source map[string]interface{}
+		if err := json.Unmarshal(data, &source); err != nil {
+			return fmt.Errorf("failed to decode span document: %w", err)
+		}
+		spans = append(spans, opensearch.ParseSpanDocument(source))
+	}
+	s.Add(spans...)
+	return nil
+}
+
+// SearchSpans retrieves the spans of a component in a time range
+func (s *Store) SearchSpans(ctx context.Context, params opensearch.TraceQueryParams) ([]opensearch.Span, error) {
+	inRange, err := timeRangeFilter(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	spans := s.filter(func(span opensearch.Span) bool {
+		return matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid) &&
+			inRange(span)
+	})
+	sortByStartTime(spans, params.SortOrder == "asc")
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = 100
+	}
+	return page(spans, params.Offset, limit), nil
+}
+
+// GetTraceSpans retrieves the spans of a trace. Unlike the OpenSearch store, lookups are not limited to recent days.
+func (s *Store) GetTraceSpans(ctx context.Context, params opensearch.TraceByIdAndServiceParams) ([]opensearch.Span, error) {
+	spans := s.filter(func(span opensearch.Span) bool {
+		return span.TraceID == params.TraceID &&
+			matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid)
+	})
+	sortByStartTime(spans, params.SortOrder != "desc")
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = 10000
+	}
+	return page(spans, 0, limit), nil
+}
+
+// GetTokenUsage sums GenAI token usage per project, component and model
+func (s *Store) GetTokenUsage(ctx context.Context, params opensearch.TokenUsageParams) ([]opensearch.TokenUsage, error) {
+	inRange, err := timeRangeFilter(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	spans := s.filter(func(span opensearch.Span) bool {
+		return matchesAnyResource(span, resourceProjectUid, params.ProjectUids) &&
+			matchesAnyResource(span, resourceComponentUid, params.ComponentUids) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid) &&
+			inRange(span) &&
+			hasTokenUsage(span)
+	})
+
+	type usageKey struct{ projectUid, componentUid, model string }
+	buckets := map[usageKey]*opensearch.TokenUsage{}
+	keys := []usageKey{}
+	for _, span := range spans {
+		key := usageKey{
+			projectUid:   stringValue(span.Resource[resourceProjectUid]),
+			componentUid: stringValue(span.Resource[resourceComponentUid]),
+			model:        stringValue(span.Attributes[opensearch.AttributeGenAIRequestModel]),
+		}
+		bucket, ok := buckets[key]
+		if !ok {
+			bucket = &opensearch.TokenUsage{ProjectUid: key.projectUid, ComponentUid: key.componentUid, Model: key.model}
+			buckets[key] = bucket
+			keys = append(keys, key)
+		}
+		inputTokens, outputTokens := tokenCounts(span)
+		bucket.InputTokens += inputTokens
+		bucket.OutputTokens += outputTokens
+		bucket.TotalTokens += inputTokens + outputTokens
+		bucket.SpanCount++
+	}
+
+	// Composite aggregations return buckets in key order
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].projectUid != keys[j].projectUid {
+			return keys[i].projectUid < keys[j].projectUid
+		}
+		if keys[i].componentUid != keys[j].componentUid {
+			return keys[i].componentUid < keys[j].componentUid
+		}
+		return keys[i].model < keys[j].model
+	})
+	usage := make([]opensearch.TokenUsage, 0, len(keys))
+	for _, key := range keys {
+		usage = append(usage, *buckets[key])
+	}
+	return usage, nil
+}
+
+// ListSessions retrieves all sessions of a component ordered by last activity, along with the total number of sessions.
+// Every span of a session's traces is counted, whether or not it carries the session key attribute.
+func (s *Store) ListSessions(ctx context.Context, params opensearch.SessionQueryParams, sessionKeyAttribute string) ([]opensearch.SessionOverview, int, error) {
+	inRange, err := timeRangeFilter(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	spans := s.filter(func(span opensearch.Span) bool {
+		_, ok := span.Attributes[sessionKeyAttribute]
+		return ok &&
+			matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid) &&
+			inRange(span)
+	})
+
+	type sessionState struct {
+		session      opensearch.SessionTraceIds
+		traces       map[string]bool
+		lastActivity time.Time
+	}
+	states := map[string]*sessionState{}
+	for _, span := range spans {
+		sessionID := fmt.Sprint(span.Attributes[sessionKeyAttribute])
+		state, ok := states[sessionID]
+		if !ok {
+			state = &sessionState{
+				session: opensearch.SessionTraceIds{SessionID: sessionID},
+				traces:  map[string]bool{},
+			}
+			states[sessionID] = state
+		}
+		if !state.traces[span.TraceID] {
+			state.traces[span.TraceID] = true
+			state.session.TraceIDs = append(state.session.TraceIDs, span.TraceID)
+		}
+		if span.StartTime.After(state.lastActivity) {
+			state.lastActivity = span.StartTime
+		}
+	}
+
+	ordered := make([]*sessionState, 0, len(states))
+	for _, state := range states {
+		ordered = append(ordered, state)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].lastActivity.After(ordered[j].lastActivity)
+	})
+
+	stats := s.traceStats(params.ComponentUid, params.EnvironmentUid)
+	sessions := make([]opensearch.SessionOverview, 0, len(ordered))
+	for _, state := range ordered {
+		sessions = append(sessions, opensearch.BuildSessionOverview(state.session, stats))
+	}
+	return sessions, len(sessions), nil
+}
+
+// GetSessionSpans retrieves all spans of the traces of a session in the given time range
+func (s *Store) GetSessionSpans(ctx context.Context, params opensearch.SessionTracesParams, sessionKeyAttribute string) ([]opensearch.Span, bool, error) {
+	inRange, err := timeRangeFilter(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, false, err
+	}
+
+	traceIDs := map[string]bool{}
+	for _, span := range s.filter(func(span opensearch.Span) bool {
+		value, ok := span.Attributes[sessionKeyAttribute]
+		return ok && fmt.Sprint(value) == params.SessionID &&
+			matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid) &&
+			inRange(span)
+	}) {
+		traceIDs[span.TraceID] = true
+	}
+
+	spans := s.filter(func(span opensearch.Span) bool {
+		return traceIDs[span.TraceID] &&
+			matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid)
+	})
+	sortByStartTime(spans, true)
+	return spans, false, nil
+}
+
+// GetSpansOfTraces retrieves all spans of the given traces of a component in chronological order
+func (s *Store) GetSpansOfTraces(ctx context.Context, params opensearch.TraceSpansParams) ([]opensearch.Span, bool, error) {
+	inRange, err := timeRangeFilter(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, false, err
+	}
+
+	traceIDs := make(map[string]bool, len(params.TraceIDs))
+	for _, traceID := range params.TraceIDs {
+		traceIDs[traceID] = true
+	}
+
+	spans := s.filter(func(span opensearch.Span) bool {
+		return traceIDs[span.TraceID] &&
+			matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid) &&
+			inRange(span)
+	})
+	sortByStartTime(spans, true)
+	return spans, false, nil
+}
+
+// traceStats sums the span count, time range and token usage of each trace of a component
+func (s *Store) traceStats(componentUid string, environmentUid string) map[string]opensearch.TraceStats {
+	spans := s.filter(func(span opensearch.Span) bool {
+		return matchesResource(span, resourceComponentUid, componentUid) &&
+			matchesResource(span, resourceEnvironmentUid, environmentUid)
+	})
+
+	stats := map[string]opensearch.TraceStats{}
+	for _, span := range spans {
+		trace, ok := stats[span.TraceID]
+		if !ok || span.StartTime.Before(trace.StartTime) {
+			trace.StartTime = span.StartTime
+		}
+		if span.EndTime.After(trace.EndTime) {
+			trace.EndTime = span.EndTime
+		}
+		inputTokens, outputTokens := tokenCounts(span)
+		trace.SpanCount++
+		trace.InputTokens += inputTokens
+		trace.OutputTokens += outputTokens
+		stats[span.TraceID] = trace
+	}
+	return stats
+}
+
+// ScanSpans calls fn for each span of a component in a time range, ordered by traceId and spanId
+func (s *Store) ScanSpans(ctx context.Context, params opensearch.ExportTracesParams, fn func(span opensearch.Span) error) error {
+	inRange, err := timeRangeFilter(params.StartTime, params.EndTime)
+	if err != nil {
+		return err
+	}
+
+	spans := s.filter(func(span opensearch.Span) bool {
+		return matchesResource(span, resourceComponentUid, params.ComponentUid) &&
+			matchesResource(span, resourceEnvironmentUid, params.EnvironmentUid) &&
+			inRange(span)
+	})
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].TraceID != spans[j].TraceID {
+			return spans[i].TraceID < spans[j].TraceID
+		}
+		return spans[i].SpanID < spans[j].SpanID
+	})
+
+	for _, span := range spans {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(span); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteSpans adds span documents to the store
+func (s *Store) WriteSpans(ctx context.Context, documents []map[string]interface{}) error {
+	return s.AddDocuments(documents)
+}
+
+// HealthCheck always succeeds, the in-memory store has no external dependencies
+func (s *Store) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// filter returns a copy of the stored spans that match
+func (s *Store) filter(match func(span opensearch.Span) bool) []opensearch.Span {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	spans := []opensearch.Span{}
+	for _, span := range s.spans {
+		if match(span) {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+// timeRangeFilter returns a filter for spans starting within the time range. Like the OpenSearch queries,
+// the range only applies when both ends are given.
+func timeRangeFilter(startTime, endTime string) (func(span opensearch.Span) bool, error) {
+	if startTime == "" || endTime == "" {
+		return func(opensearch.Span) bool { return true }, nil
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time format: %w", err)
+	}
+	end, err := time.Parse(time.RFC3339Nano, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time format: %w", err)
+	}
+
+	return func(span opensearch.Span) bool {
+		return !span.StartTime.Before(start) && !span.StartTime.After(end)
+	}, nil
+}
+
+// matchesResource reports whether a resource attribute of the span equals value, or value is empty
+func matchesResource(span opensearch.Span, key, value string) bool {
+	return value == "" || stringValue(span.Resource[key]) == value
+}
+
+// matchesAnyResource reports whether a resource attribute of the span is one of values, or values is empty
+func matchesAnyResource(span opensearch.Span, key string, values []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	actual := stringValue(span.Resource[key])
+	for _, value := range values {
+		if actual == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sortByStartTime sorts spans by start time in place
+func sortByStartTime(spans []opensearch.Span, ascending bool) {
+	sort.SliceStable(spans, func(i, j int) bool {
+		if ascending {
+			return spans[i].StartTime.Before(spans[j].StartTime)
+		}
+		return spans[i].StartTime.After(spans[j].StartTime)
+	})
+}
+
+// page returns the spans between offset and offset+limit
+func page(spans []opensearch.Span, offset, limit int) []opensearch.Span {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > len(spans) {
+		offset = len(spans)
+	}
+	end := offset + limit
+	if end > len(spans) {
+		end = len(spans)
+	}
+	return spans[offset:end]
+}
+
+// hasTokenUsage reports whether the span carries any GenAI token count
+func hasTokenUsage(span opensearch.Span) bool {
+	for _, attribute := range opensearch.TokenUsageAttributes {
+		if _, ok := span.Attributes[attribute]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenCounts returns the input and output tokens of a span. The prompt/completion tokens of older instrumentations
+// are only counted when the span has no input/output tokens, as some SDKs report both.
+func tokenCounts(span opensearch.Span) (int64, int64) {
+	return tokenCount(span, opensearch.AttributeGenAIInputTokens, opensearch.AttributeGenAIPromptTokens),
+		tokenCount(span, opensearch.AttributeGenAIOutputTokens, opensearch.AttributeGenAICompletionTokens)
+}
+
+func tokenCount(span opensearch.Span, attribute string, legacyAttribute string) int64 {
+	if value, ok := span.Attributes[attribute]; ok {
+		return numberValue(value)
+	}
+	return numberValue(span.Attributes[legacyAttribute])
+}
+
+// numberValue converts a numeric attribute value to int64, treating anything else as zero
+func numberValue(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+// stringValue returns a string attribute value, or an empty string for anything else
+func stringValue(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
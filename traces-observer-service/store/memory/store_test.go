@@ -0,0 +1,155 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+func TestTokenCounts(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		wantInput  int64
+		wantOutput int64
+	}{
+		{
+			name: "input and output tokens",
+			attributes: map[string]interface{}{
+				opensearch.AttributeGenAIInputTokens:  float64(120),
+				opensearch.AttributeGenAIOutputTokens: float64(30),
+			},
+			wantInput:  120,
+			wantOutput: 30,
+		},
+		{
+			name: "prompt and completion tokens of older instrumentations",
+			attributes: map[string]interface{}{
+				opensearch.AttributeGenAIPromptTokens:     float64(80),
+				opensearch.AttributeGenAICompletionTokens: float64(20),
+			},
+			wantInput:  80,
+			wantOutput: 20,
+		},
+		{
+			name: "spans reporting both attribute names are counted once",
+			attributes: map[string]interface{}{
+				opensearch.AttributeGenAIInputTokens:      float64(120),
+				opensearch.AttributeGenAIOutputTokens:     float64(30),
+				opensearch.AttributeGenAIPromptTokens:     float64(120),
+				opensearch.AttributeGenAICompletionTokens: float64(30),
+			},
+			wantInput:  120,
+			wantOutput: 30,
+		},
+		{
+			name: "legacy attribute only fills in the missing count",
+			attributes: map[string]interface{}{
+				opensearch.AttributeGenAIInputTokens:      float64(120),
+				opensearch.AttributeGenAICompletionTokens: float64(30),
+			},
+			wantInput:  120,
+			wantOutput: 30,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, output := tokenCounts(opensearch.Span{Attributes: tt.attributes})
+			if input != tt.wantInput || output != tt.wantOutput {
+				t.Errorf("expected %d input and %d output tokens, got %d and %d", tt.wantInput, tt.wantOutput, input, output)
+			}
+		})
+	}
+}
+
+// newSessionTestStore seeds a store with two single-turn sessions: one whose key is only set on the root span,
+// and one whose key is only set on a child LLM span
+func newSessionTestStore() *Store {
+	start := time.Date(2025, time.January, 10, 12, 0, 0, 0, time.UTC)
+	span := func(traceID, spanID, parentSpanID string, offset time.Duration, attributes map[string]interface{}) opensearch.Span {
+		return opensearch.Span{
+			TraceID:      traceID,
+			SpanID:       spanID,
+			ParentSpanID: parentSpanID,
+			Name:         spanID,
+			StartTime:    start.Add(offset),
+			EndTime:      start.Add(offset + time.Second),
+			Attributes:   attributes,
+			Resource:     map[string]interface{}{resourceComponentUid: "component-uid-1"},
+		}
+	}
+
+	store := NewStore()
+	store.Add(
+		span("trace-1", "root-1", "", 0, map[string]interface{}{"session.id": "root-keyed"}),
+		span("trace-1", "llm-1", "root-1", 100*time.Millisecond, map[string]interface{}{
+			opensearch.AttributeGenAIInputTokens:  float64(100),
+			opensearch.AttributeGenAIOutputTokens: float64(20),
+		}),
+		span("trace-2", "root-2", "", time.Minute, map[string]interface{}{}),
+		span("trace-2", "llm-2", "root-2", time.Minute+100*time.Millisecond, map[string]interface{}{
+			"session.id":                          "child-keyed",
+			opensearch.AttributeGenAIInputTokens:  float64(50),
+			opensearch.AttributeGenAIOutputTokens: float64(10),
+		}),
+	)
+	return store
+}
+
+func TestListSessionsCountsAllSpansOfSessionTraces(t *testing.T) {
+	store := newSessionTestStore()
+
+	sessions, totalCount, err := store.ListSessions(context.Background(), opensearch.SessionQueryParams{ComponentUid: "component-uid-1"}, "session.id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totalCount != 2 || len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d of %d", len(sessions), totalCount)
+	}
+
+	want := map[string]int64{"root-keyed": 120, "child-keyed": 60}
+	for _, session := range sessions {
+		if session.TurnCount != 1 || session.SpanCount != 2 || session.TotalTokens != want[session.SessionID] {
+			t.Errorf("unexpected session %+v", session)
+		}
+	}
+}
+
+func TestGetSessionSpansIncludesSpansWithoutTheSessionKey(t *testing.T) {
+	store := newSessionTestStore()
+
+	for _, sessionID := range []string{"root-keyed", "child-keyed"} {
+		spans, truncated, err := store.GetSessionSpans(context.Background(), opensearch.SessionTracesParams{
+			SessionID:    sessionID,
+			ComponentUid: "component-uid-1",
+		}, "session.id")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(spans) != 2 || truncated {
+			t.Errorf("expected both spans of the %s session trace, got %d (truncated: %t)", sessionID, len(spans), truncated)
+			continue
+		}
+		if spans[0].ParentSpanID != "" {
+			t.Errorf("expected the root span of the %s session first, got %s", sessionID, spans[0].SpanID)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// TraceStore is the storage backend that trace queries are served from
+type TraceStore interface {
+	// SearchSpans retrieves the spans of a component in a time range, honouring the limit, offset and sort order
+	SearchSpans(ctx context.Context, params opensearch.TraceQueryParams) ([]opensearch.Span, error)
+	// GetTraceSpans retrieves the spans of a trace, or no spans if the trace does not exist
+	GetTraceSpans(ctx context.Context, params opensearch.TraceByIdAndServiceParams) ([]opensearch.Span, error)
+	// GetTokenUsage sums GenAI token usage per project, component and model
+	GetTokenUsage(ctx context.Context, params opensearch.TokenUsageParams) ([]opensearch.TokenUsage, error)
+	// ListSessions retrieves at least offset+limit sessions ordered by last activity, along with the total number of sessions
+	ListSessions(ctx context.Context, params opensearch.SessionQueryParams, sessionKeyAttribute string) ([]opensearch.SessionOverview, int, error)
+	// GetSessionSpans retrieves all spans of the traces of a session in the given time range,
+	// and reports whether the session has more traces or spans than were returned
+	GetSessionSpans(ctx context.Context, params opensearch.SessionTracesParams, sessionKeyAttribute string) ([]opensearch.Span, bool, error)
+	// GetSpansOfTraces retrieves all spans of the given traces of a component in chronological order,
+	// and reports whether the traces have more spans than were returned
+	GetSpansOfTraces(ctx context.Context, params opensearch.TraceSpansParams) ([]opensearch.Span, bool, error)
+	// ScanSpans calls fn for each span of a component in a time range, ordered by traceId and spanId,
+	// and stops at the first error returned by fn
+	ScanSpans(ctx context.Context, params opensearch.ExportTracesParams, fn func(span opensearch.Span) error) error
+	// WriteSpans stores span documents in the layout stored in OpenSearch, as produced by export.DocumentsFromOTLP
+	WriteSpans(ctx context.Context, documents []map[string]interface{}) error
+	// HealthCheck checks if the backend is reachable
+	HealthCheck(ctx context.Context) error
+}
+
+var _ TraceStore = (*opensearch.Store)(nil)
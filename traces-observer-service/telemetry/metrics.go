@@ -0,0 +1,132 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package telemetry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "traces_observer"
+
+// Registry holds the service metrics, exposed on /metrics
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of HTTP requests by handler, method and status code. Streamed responses are measured until the stream ends.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method", "code"})
+
+	httpRequestsInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_in_flight",
+		Help:      "Number of HTTP requests being served by handler, including open exports and live tails.",
+	}, []string{"handler"})
+
+	opensearchRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "opensearch_request_duration_seconds",
+		Help:      "Duration of OpenSearch calls by operation, number of indices queried, number of hits returned and outcome.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"operation", "index_count", "result_size", "outcome"})
+
+	opensearchSearchHits = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "opensearch_search_hits",
+		Help:      "Number of hits returned by OpenSearch searches by number of indices queried.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+	}, []string{"index_count"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		httpRequestsInFlight,
+		opensearchRequestDuration,
+		opensearchSearchHits,
+	)
+}
+
+// MetricsHandler returns the handler that serves the service metrics in the Prometheus exposition format
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// InstrumentHandler records request metrics and traces for a handler, labelled with the given handler name
+func InstrumentHandler(name string, handler http.Handler) http.Handler {
+	instrumented := promhttp.InstrumentHandlerInFlight(
+		httpRequestsInFlight.WithLabelValues(name),
+		promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(prometheus.Labels{"handler": name}), handler),
+	)
+	return traceHandler(name, instrumented)
+}
+
+// ObserveOpenSearchRequest records the duration of an OpenSearch call that queried indexCount indices and returned
+// resultSize hits. resultSize is negative for calls that do not return hits.
+func ObserveOpenSearchRequest(operation string, indexCount int, resultSize int, duration time.Duration, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	opensearchRequestDuration.WithLabelValues(operation, indexCountLabel(indexCount), resultSizeLabel(resultSize), outcome).
+		Observe(duration.Seconds())
+	if operation == "search" && err == nil && resultSize >= 0 {
+		opensearchSearchHits.WithLabelValues(indexCountLabel(indexCount)).Observe(float64(resultSize))
+	}
+}
+
+// indexCountLabel buckets the number of indices a call named, to keep label cardinality bounded
+func indexCountLabel(indexCount int) string {
+	switch {
+	case indexCount <= 1:
+		return strconv.Itoa(max(indexCount, 0))
+	case indexCount <= 7:
+		return "2-7"
+	case indexCount <= 31:
+		return "8-31"
+	default:
+		return "32+"
+	}
+}
+
+// resultSizeLabel buckets the number of hits a call returned, to keep label cardinality bounded
+func resultSizeLabel(resultSize int) string {
+	switch {
+	case resultSize < 0:
+		return "none"
+	case resultSize == 0:
+		return "0"
+	case resultSize <= 100:
+		return "1-100"
+	case resultSize <= 1000:
+		return "101-1000"
+	case resultSize <= 10000:
+		return "1001-10000"
+	default:
+		return "10000+"
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package telemetry
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInstrumentHandler(t *testing.T) {
+	handler := InstrumentHandler("test_handler", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	expected := `traces_observer_http_request_duration_seconds_count{code="418",handler="test_handler",method="get"} 1`
+	if !strings.Contains(scrape(t), expected) {
+		t.Errorf("expected metrics to contain %q", expected)
+	}
+}
+
+func TestObserveOpenSearchRequest(t *testing.T) {
+	ObserveOpenSearchRequest("search", 3, 250, 20*time.Millisecond, nil)
+	ObserveOpenSearchRequest("bulk", 1, -1, time.Millisecond, errors.New("unavailable"))
+
+	metrics := scrape(t)
+	for _, expected := range []string{
+		`traces_observer_opensearch_request_duration_seconds_count{index_count="2-7",operation="search",outcome="success",result_size="101-1000"} 1`,
+		`traces_observer_opensearch_request_duration_seconds_count{index_count="1",operation="bulk",outcome="error",result_size="none"} 1`,
+		`traces_observer_opensearch_search_hits_count{index_count="2-7"} 1`,
+	} {
+		if !strings.Contains(metrics, expected) {
+			t.Errorf("expected metrics to contain %q", expected)
+		}
+	}
+}
+
+// scrape returns the metrics served by the metrics handler
+func scrape(t *testing.T) string {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200 from the metrics handler, got %d", recorder.Code)
+	}
+	return recorder.Body.String()
+}